@@ -81,6 +81,37 @@ func NewKeyedTransactor(key *ecdsa.PrivateKey) *TransactOpts {
 	}
 }
 
+// NewKeyStoreFeePayerSigner is a utility method to easily create a fee payer signer of
+// fee-delegated transactions from an decrypted key from a keystore.
+func NewKeyStoreFeePayerSigner(keystore *keystore.KeyStore, account accounts.Account) SignerFn {
+	return func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != account.Address {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		hash, err := signer.HashFeePayer(tx)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := keystore.SignHash(account, hash.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return tx.WithFeePayerSignature(signer, signature)
+	}
+}
+
+// NewKeyedFeePayerSigner is a utility method to easily create a fee payer signer of
+// fee-delegated transactions from a single private key.
+func NewKeyedFeePayerSigner(key *ecdsa.PrivateKey) SignerFn {
+	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
+	return func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != keyAddr {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		return types.SignTxAsFeePayer(tx, signer, key)
+	}
+}
+
 // TODO-klaytn: clef related code
 /*
 // NewClefTransactor is a utility method to easily create a transaction signer
@@ -32,6 +32,12 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/params"
+)
+
+var (
+	errNoFeePayerSigner = errors.New("no fee payer signer to authorize the fee-delegated transaction with")
+	errInvalidFeeRatio  = errors.New("fee ratio should be in the range [1, 99]")
 )
 
 // SignerFn is a signer function callback when a contract requires a method to
@@ -57,7 +63,30 @@ type TransactOpts struct {
 	GasPrice *big.Int // Gas price to use for the transaction execution (nil = gas price oracle)
 	GasLimit uint64   // Gas limit to set for the transaction execution (0 = estimate)
 
+	FeePayer       common.Address // Fee payer of a fee-delegated transaction (zero = not fee-delegated)
+	FeeRatio       types.FeeRatio // Ratio of the fee paid by the fee payer in percentage (0 = 100%)
+	FeePayerSigner SignerFn       // Method to use for signing the transaction as the fee payer (nil = NoSend only)
+
 	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
+
+	NoSend bool // Do all transact steps but do not send the transaction
+}
+
+// IsFeeDelegated returns true if the transaction is created as a fee-delegated transaction.
+func (opts *TransactOpts) IsFeeDelegated() bool {
+	return opts.FeePayer != (common.Address{})
+}
+
+// WithFeePayer returns a copy of the options which creates fee-delegated transactions
+// co-signed by the fee payer. If feePayerSigner is nil, the sender-signed transactions
+// are returned without being sent, so that they can be passed to the fee payer.
+func (opts *TransactOpts) WithFeePayer(feePayer common.Address, feeRatio types.FeeRatio, feePayerSigner SignerFn) *TransactOpts {
+	cpy := *opts
+	cpy.FeePayer = feePayer
+	cpy.FeeRatio = feeRatio
+	cpy.FeePayerSigner = feePayerSigner
+	cpy.NoSend = opts.NoSend || feePayerSigner == nil
+	return &cpy
 }
 
 // FilterOpts is the collection of options to fine tune filtering for events
@@ -243,17 +272,32 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate gas needed: %v", err)
 		}
+		if opts.IsFeeDelegated() {
+			extraGas, err := feeDelegationExtraGas(opts, contract == nil, input)
+			if err != nil {
+				return nil, err
+			}
+			gasLimit += extraGas
+		}
+	}
+	if opts.Signer == nil {
+		return nil, errors.New("no signer to authorize the transaction with")
+	}
+	if opts.IsFeeDelegated() && opts.FeePayerSigner == nil && !opts.NoSend {
+		return nil, errNoFeePayerSigner
 	}
 	// Create the transaction, sign it and schedule it for execution
 	var rawTx *types.Transaction
-	if contract == nil {
+	if opts.IsFeeDelegated() {
+		rawTx, err = newFeeDelegatedTransaction(opts, nonce, contract, value, gasLimit, gasPrice, input)
+		if err != nil {
+			return nil, err
+		}
+	} else if contract == nil {
 		rawTx = types.NewContractCreation(nonce, value, gasLimit, gasPrice, input)
 	} else {
 		rawTx = types.NewTransaction(nonce, c.address, value, gasLimit, gasPrice, input)
 	}
-	if opts.Signer == nil {
-		return nil, errors.New("no signer to authorize the transaction with")
-	}
 
 	chainId, err := c.transactor.ChainID(ensureContext(opts.Context))
 	if err != nil {
@@ -265,12 +309,86 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if err != nil {
 		return nil, err
 	}
+	// The fee payer signs the transaction signed by the sender.
+	if opts.IsFeeDelegated() && opts.FeePayerSigner != nil {
+		signedTx, err = opts.FeePayerSigner(signer, opts.FeePayer, signedTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.NoSend {
+		return signedTx, nil
+	}
 	if err := c.transactor.SendTransaction(ensureContext(opts.Context), signedTx); err != nil {
 		return nil, err
 	}
 	return signedTx, nil
 }
 
+// feeDelegationExtraGas returns the intrinsic gas of a fee-delegated transaction exceeding
+// that of a legacy transaction, since the gas is estimated as a legacy transaction.
+// The intrinsic gas of the legacy transaction is calculated with the cheapest data gas
+// before the Istanbul hardfork, so that the returned gas is never insufficient.
+func feeDelegationExtraGas(opts *TransactOpts, contractCreation bool, input []byte) (uint64, error) {
+	gas := params.TxGasContractExecution
+	if contractCreation {
+		gas = params.TxGasContractCreation
+	}
+	if opts.FeeRatio == 0 {
+		gas += params.TxGasFeeDelegated
+	} else {
+		gas += params.TxGasFeeDelegatedWithRatio
+	}
+	feeDelegatedGas, err := types.IntrinsicGasPayload(gas, input)
+	if err != nil {
+		return 0, err
+	}
+	legacyGas, err := types.IntrinsicGas(input, nil, contractCreation, params.Rules{})
+	if err != nil {
+		return 0, err
+	}
+	return feeDelegatedGas - legacyGas, nil
+}
+
+// newFeeDelegatedTransaction creates a fee-delegated transaction deploying or executing a contract.
+// A transaction with the fee ratio is created if the fee ratio of the options is set.
+func newFeeDelegatedTransaction(opts *TransactOpts, nonce uint64, contract *common.Address, value *big.Int, gasLimit uint64, gasPrice *big.Int, input []byte) (*types.Transaction, error) {
+	if opts.FeeRatio != 0 && !opts.FeeRatio.IsValid() {
+		return nil, errInvalidFeeRatio
+	}
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    nonce,
+		types.TxValueKeyAmount:   value,
+		types.TxValueKeyGasLimit: gasLimit,
+		types.TxValueKeyGasPrice: gasPrice,
+		types.TxValueKeyFrom:     opts.From,
+		types.TxValueKeyData:     common.CopyBytes(input),
+		types.TxValueKeyFeePayer: opts.FeePayer,
+	}
+	withRatio := opts.FeeRatio != 0
+	if withRatio {
+		values[types.TxValueKeyFeeRatioOfFeePayer] = opts.FeeRatio
+	}
+
+	var txType types.TxType
+	if contract == nil {
+		values[types.TxValueKeyTo] = (*common.Address)(nil)
+		values[types.TxValueKeyHumanReadable] = false
+		values[types.TxValueKeyCodeFormat] = params.CodeFormatEVM
+		txType = types.TxTypeFeeDelegatedSmartContractDeploy
+		if withRatio {
+			txType = types.TxTypeFeeDelegatedSmartContractDeployWithRatio
+		}
+	} else {
+		values[types.TxValueKeyTo] = *contract
+		txType = types.TxTypeFeeDelegatedSmartContractExecution
+		if withRatio {
+			txType = types.TxTypeFeeDelegatedSmartContractExecutionWithRatio
+		}
+	}
+	return types.NewTransactionWithMap(txType, values)
+}
+
 // FilterLogs filters contract logs for past blocks, returning the necessary
 // channels to construct a strongly typed bound iterator on top of them.
 func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]interface{}) (chan types.Log, event.Subscription, error) {
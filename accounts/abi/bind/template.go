@@ -204,6 +204,29 @@ var (
 	  TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
 	}
 
+	// WithFeePayer returns a copy of the session whose transactions are sent as fee-delegated
+	// transactions co-signed by the fee payer. feeRatio is the ratio of the fee paid by the
+	// fee payer in percentage (0 = 100%). If feePayerSigner is nil, the sender-signed
+	// transactions are returned without being sent.
+	func (_{{.Type}} *{{.Type}}Session) WithFeePayer(feePayer common.Address, feeRatio types.FeeRatio, feePayerSigner bind.SignerFn) *{{.Type}}Session {
+	  return &{{.Type}}Session{
+	    Contract:     _{{.Type}}.Contract,
+	    CallOpts:     _{{.Type}}.CallOpts,
+	    TransactOpts: *_{{.Type}}.TransactOpts.WithFeePayer(feePayer, feeRatio, feePayerSigner),
+	  }
+	}
+
+	// WithFeePayer returns a copy of the session whose transactions are sent as fee-delegated
+	// transactions co-signed by the fee payer. feeRatio is the ratio of the fee paid by the
+	// fee payer in percentage (0 = 100%). If feePayerSigner is nil, the sender-signed
+	// transactions are returned without being sent.
+	func (_{{.Type}} *{{.Type}}TransactorSession) WithFeePayer(feePayer common.Address, feeRatio types.FeeRatio, feePayerSigner bind.SignerFn) *{{.Type}}TransactorSession {
+	  return &{{.Type}}TransactorSession{
+	    Contract:     _{{.Type}}.Contract,
+	    TransactOpts: *_{{.Type}}.TransactOpts.WithFeePayer(feePayer, feeRatio, feePayerSigner),
+	  }
+	}
+
 	// {{.Type}}Raw is an auto generated low-level Go binding around a Klaytn contract.
 	type {{.Type}}Raw struct {
 	  Contract *{{.Type}} // Generic contract binding to access the raw methods on
@@ -29,6 +29,7 @@ import (
 
 	"github.com/klaytn/klaytn/node/cn/filters"
 
+	"github.com/klaytn/klaytn/accounts/abi"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/blockchain/types/account"
@@ -371,6 +372,158 @@ func (s *PublicBlockChainAPI) Call(ctx context.Context, args CallArgs, blockNrOr
 	return common.CopyBytes(result), err
 }
 
+// BundleCall is an item of a call bundle. If RawTx is given, the signed
+// transaction is executed instead of the call specified by CallArgs, so that
+// fee-delegated transactions can be simulated along with plain calls.
+type BundleCall struct {
+	CallArgs
+	RawTx hexutil.Bytes `json:"rawTx"`
+}
+
+// BundleCallResult is the execution result of an item of a call bundle.
+type BundleCallResult struct {
+	ReturnData   hexutil.Bytes  `json:"returnData"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Status       hexutil.Uint   `json:"status"`
+	Logs         []*types.Log   `json:"logs"`
+	Error        string         `json:"error,omitempty"`
+	RevertReason string         `json:"revertReason,omitempty"`
+}
+
+// CallBundle executes the given calls sequentially on top of the state of the given
+// block number or hash, so that each call sees the state changes of the previous ones.
+// Additionally, the caller can specify a batch of contract for fields overriding.
+// It doesn't make any changes in the state/blockchain and returns the result of each call.
+func (s *PublicBlockChainAPI) CallBundle(ctx context.Context, calls []BundleCall, blockNrOrHash rpc.BlockNumberOrHash, overrides *EthStateOverride) ([]*BundleCallResult, error) {
+	if len(calls) == 0 {
+		return nil, errors.New("empty call bundle")
+	}
+	gasCap := uint64(0)
+	if rpcGasCap := s.b.RPCGasCap(); rpcGasCap != nil {
+		gasCap = rpcGasCap.Uint64()
+	}
+	return DoCallBundle(ctx, s.b, calls, blockNrOrHash, overrides, localTxExecutionTime, gasCap)
+}
+
+// DoCallBundle executes the given calls sequentially on a single state of the given block.
+// The timeout is applied to the whole bundle.
+func DoCallBundle(ctx context.Context, b Backend, calls []BundleCall, blockNrOrHash rpc.BlockNumberOrHash, overrides *EthStateOverride, timeout time.Duration, globalGasCap uint64) ([]*BundleCallResult, error) {
+	defer func(start time.Time) { logger.Debug("Executing EVM call bundle finished", "runtime", time.Since(start)) }(time.Now())
+
+	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	if err := overrides.Apply(state); err != nil {
+		return nil, err
+	}
+	// Setup context so it may be cancelled the bundle has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	// header.BaseFee != nil means magma hardforked
+	var baseFee *big.Int
+	if header.BaseFee != nil {
+		baseFee = header.BaseFee
+	} else {
+		baseFee = new(big.Int).SetUint64(params.ZeroBaseFee)
+	}
+	var (
+		rules       = b.ChainConfig().Rules(header.Number)
+		signer      = types.MakeSigner(b.ChainConfig(), header.Number)
+		blockNumber = header.Number.Uint64()
+		results     = make([]*BundleCallResult, 0, len(calls))
+	)
+	for i, call := range calls {
+		var msg *types.Transaction
+		if len(call.RawTx) > 0 {
+			tx := new(types.Transaction)
+			if err := rlp.DecodeBytes(call.RawTx, tx); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+			if err := tx.Validate(state, blockNumber); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+			if msg, err = tx.AsMessageWithAccountKeyPicker(signer, state, blockNumber); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		} else {
+			intrinsicGas, err := types.IntrinsicGas(call.data(), nil, call.To == nil, rules)
+			if err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+			if msg, err = call.ToMessage(globalGasCap, baseFee, intrinsicGas); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+			if msg.Gas() < intrinsicGas {
+				return nil, fmt.Errorf("call %d: %w: msg.gas %d, want %d", i, blockchain.ErrIntrinsicGas, msg.Gas(), intrinsicGas)
+			}
+			// Add gas fee to sender for calling a function by insufficient balance sender.
+			state.AddBalance(msg.ValidatedSender(), new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice()))
+		}
+
+		// Logs of the same call are collected under the same hash, so only the new ones are taken.
+		txHash := msg.Hash()
+		state.Prepare(txHash, header.Hash(), i)
+		prevLogs := len(state.GetLogs(txHash))
+
+		evm, vmError, err := b.GetEVM(ctx, msg, state, header, vm.Config{})
+		if err != nil {
+			return nil, err
+		}
+		// Wait for the context to be done and cancel the evm. Even if the
+		// EVM has finished, cancelling may be done (repeatedly)
+		done := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				evm.Cancel(vm.CancelByCtxDone)
+			case <-done:
+			}
+		}()
+		res, gas, kerr := blockchain.ApplyMessage(evm, msg)
+		close(done)
+
+		if err := vmError(); err != nil {
+			return nil, err
+		}
+		// If the timer caused an abort, return an appropriate error message
+		if evm.Cancelled() {
+			return nil, fmt.Errorf("execution aborted (timeout = %v)", timeout)
+		}
+		if kerr.ErrTxInvalid != nil {
+			return nil, fmt.Errorf("call %d: err: %w (supplied gas %d)", i, kerr.ErrTxInvalid, msg.Gas())
+		}
+		state.Finalise(true, false)
+
+		result := &BundleCallResult{
+			ReturnData: common.CopyBytes(res),
+			GasUsed:    hexutil.Uint64(gas),
+			Status:     hexutil.Uint(kerr.Status),
+			Logs:       state.GetLogs(txHash)[prevLogs:],
+		}
+		if result.Logs == nil {
+			result.Logs = []*types.Log{}
+		}
+		if vmErr := blockchain.GetVMerrFromReceiptStatus(kerr.Status); vmErr != nil {
+			result.Error = vmErr.Error()
+			if isReverted(vmErr) && len(res) > 0 {
+				if reason, errUnpack := abi.UnpackRevert(res); errUnpack == nil {
+					result.RevertReason = reason
+				}
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 func (s *PublicBlockChainAPI) EstimateComputationCost(ctx context.Context, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
 	gasCap := big.NewInt(0)
 	if rpcGasCap := s.b.RPCGasCap(); rpcGasCap != nil {
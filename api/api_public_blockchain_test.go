@@ -0,0 +1,95 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicBlockChainAPI_CallBundle(t *testing.T) {
+	mockCtrl, mockBackend, api := testInitForEthApi(t)
+	defer mockCtrl.Finish()
+
+	var (
+		from     = common.HexToAddress("0x2eaad2bf70a070aaa2e007beee99c6148f47718e")
+		counter  = common.HexToAddress("0x9712f943b296758aaae79944ec975884188d3a96")
+		reverter = common.HexToAddress("0x9712f943b296758aaae79944ec975884188d3a97")
+
+		// counter increases the slot 0, logs and returns the increased value.
+		counterCode = hexutil.Bytes(common.FromHex("0x6000546001018060005560005260206000a060206000f3"))
+		// reverter always reverts without any data.
+		reverterCode = hexutil.Bytes(common.FromHex("0x60006000fd"))
+	)
+
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil)
+	require.NoError(t, err)
+	header := &types.Header{Number: big.NewInt(1), Time: big.NewInt(1), BlockScore: big.NewInt(1)}
+
+	mockBackend.EXPECT().RPCGasCap().Return(nil).AnyTimes()
+	mockBackend.EXPECT().ChainConfig().Return(dummyChainConfigForEthereumAPITest).AnyTimes()
+	mockBackend.EXPECT().StateAndHeaderByNumberOrHash(gomock.Any(), gomock.Any()).Return(statedb, header, nil).AnyTimes()
+	mockBackend.EXPECT().GetEVM(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, msg blockchain.Message, state *state.StateDB, header *types.Header, vmCfg vm.Config) (*vm.EVM, func() error, error) {
+			vmctx := blockchain.NewEVMContext(msg, header, nil, &common.Address{})
+			return vm.NewEVM(vmctx, state, dummyChainConfigForEthereumAPITest, &vmCfg), func() error { return nil }, nil
+		}).AnyTimes()
+
+	overrides := &EthStateOverride{
+		counter:  EthOverrideAccount{Code: &counterCode},
+		reverter: EthOverrideAccount{Code: &reverterCode},
+	}
+	gas := hexutil.Uint64(100000)
+	calls := []BundleCall{
+		{CallArgs: CallArgs{From: from, To: &counter, Gas: gas}},
+		{CallArgs: CallArgs{From: from, To: &counter, Gas: gas}},
+		{CallArgs: CallArgs{From: from, To: &reverter, Gas: gas}},
+	}
+	results, err := api.publicBlockChainAPI.CallBundle(context.Background(), calls, rpc.NewBlockNumberOrHashWithNumber(rpc.LatestBlockNumber), overrides)
+	require.NoError(t, err)
+	require.Len(t, results, len(calls))
+
+	// The second call should see the state changed by the first call.
+	for i, expected := range []int64{1, 2} {
+		assert.Equal(t, common.BigToHash(big.NewInt(expected)).Bytes(), []byte(results[i].ReturnData))
+		assert.Equal(t, hexutil.Uint(types.ReceiptStatusSuccessful), results[i].Status)
+		assert.Empty(t, results[i].Error)
+		require.Len(t, results[i].Logs, 1)
+		assert.Equal(t, counter, results[i].Logs[0].Address)
+		assert.Equal(t, common.BigToHash(big.NewInt(expected)).Bytes(), results[i].Logs[0].Data)
+	}
+	assert.Equal(t, hexutil.Uint(types.ReceiptStatusErrExecutionReverted), results[2].Status)
+	assert.Equal(t, vm.ErrExecutionReverted.Error(), results[2].Error)
+	assert.Empty(t, results[2].Logs)
+
+	// An empty bundle is not allowed.
+	_, err = api.publicBlockChainAPI.CallBundle(context.Background(), nil, rpc.NewBlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil)
+	assert.Error(t, err)
+}
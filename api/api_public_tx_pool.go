@@ -42,8 +42,10 @@ func (s *PublicTxPoolAPI) Content() map[string]map[string]map[string]map[string]
 	content := map[string]map[string]map[string]map[string]interface{}{
 		"pending": make(map[string]map[string]map[string]interface{}),
 		"queued":  make(map[string]map[string]map[string]interface{}),
+		"parked":  make(map[string]map[string]map[string]interface{}),
 	}
 	pending, queue := s.b.TxPoolContent()
+	parked := s.b.TxPoolParked()
 
 	// Flatten the pending transactions
 	for account, txs := range pending {
@@ -61,15 +63,28 @@ func (s *PublicTxPoolAPI) Content() map[string]map[string]map[string]map[string]
 		}
 		content["queued"][account.Hex()] = dump
 	}
+	// Flatten the parked transactions
+	for account, txs := range parked {
+		dump := make(map[string]map[string]interface{})
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = newRPCPendingTransaction(tx)
+		}
+		content["parked"][account.Hex()] = dump
+	}
 	return content
 }
 
-// Status returns the number of pending and queued transaction in the pool.
+// Status returns the number of pending, queued and parked transaction in the pool.
 func (s *PublicTxPoolAPI) Status() map[string]hexutil.Uint {
 	pending, queue := s.b.Stats()
+	parked := 0
+	for _, txs := range s.b.TxPoolParked() {
+		parked += len(txs)
+	}
 	return map[string]hexutil.Uint{
 		"pending": hexutil.Uint(pending),
 		"queued":  hexutil.Uint(queue),
+		"parked":  hexutil.Uint(parked),
 	}
 }
 
@@ -79,8 +94,10 @@ func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
+		"parked":  make(map[string]map[string]string),
 	}
 	pending, queue := s.b.TxPoolContent()
+	parked := s.b.TxPoolParked()
 
 	// Define a formatter to flatten a transaction into a string
 	format := func(tx *types.Transaction) string {
@@ -105,5 +122,13 @@ func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
 		}
 		content["queued"][account.Hex()] = dump
 	}
+	// Flatten the parked transactions
+	for account, txs := range parked {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = format(tx)
+		}
+		content["parked"][account.Hex()] = dump
+	}
 	return content
 }
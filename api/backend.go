@@ -85,6 +85,7 @@ type Backend interface {
 	GetPoolNonce(ctx context.Context, addr common.Address) uint64
 	Stats() (pending int, queued int)
 	TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	TxPoolParked() map[common.Address]types.Transactions
 	SubscribeNewTxsEvent(chan<- blockchain.NewTxsEvent) event.Subscription
 
 	ChainConfig() *params.ChainConfig
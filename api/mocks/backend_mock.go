@@ -667,6 +667,20 @@ func (mr *MockBackendMockRecorder) TxPoolContent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolContent", reflect.TypeOf((*MockBackend)(nil).TxPoolContent))
 }
 
+// TxPoolParked mocks base method.
+func (m *MockBackend) TxPoolParked() map[common.Address]types.Transactions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPoolParked")
+	ret0, _ := ret[0].(map[common.Address]types.Transactions)
+	return ret0
+}
+
+// TxPoolParked indicates an expected call of TxPoolParked.
+func (mr *MockBackendMockRecorder) TxPoolParked() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPoolParked", reflect.TypeOf((*MockBackend)(nil).TxPoolParked))
+}
+
 // UpperBoundGasPrice mocks base method.
 func (m *MockBackend) UpperBoundGasPrice(ctx context.Context) *big.Int {
 	m.ctrl.T.Helper()
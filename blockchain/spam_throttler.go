@@ -41,6 +41,22 @@ var (
 	allowedSizeGauge         = metrics.NewRegisteredGauge("txpool/throttler/allowed/size", nil)
 	throttlerUpdateTimeGauge = metrics.NewRegisteredGauge("txpool/throttler/update/time", nil)
 	throttlerDropCount       = metrics.NewRegisteredCounter("txpool/throttler/dropped/count", nil)
+
+	throttlerAccountDropCount = metrics.NewRegisteredCounter("txpool/throttler/dropped/account", nil)
+	throttlerPeerDropCount    = metrics.NewRegisteredCounter("txpool/throttler/dropped/peer", nil)
+	throttlerIPDropCount      = metrics.NewRegisteredCounter("txpool/throttler/dropped/ip", nil)
+)
+
+// ErrTxRateLimited is returned if a transaction exceeds the rate limit of the spam throttler.
+var ErrTxRateLimited = errors.New("transaction rate limit exceeded")
+
+const (
+	// maxRateLimiterKeys is the maximum number of the token buckets of a rate limiter.
+	// The transactions of a new key are dropped if the rate limiter is full even after pruning.
+	maxRateLimiterKeys = 100000
+
+	// rateLimiterFullPruneInterval is the minimum interval to prune a full rate limiter.
+	rateLimiterFullPruneInterval = time.Second
 )
 
 type throttler struct {
@@ -49,7 +65,11 @@ type throttler struct {
 	candidates map[common.Address]int  // throttle candidates with spam weight. Not for concurrent use
 	throttled  map[common.Address]int  // throttled addresses with throttle time. Requires mu.lock for concurrent use
 	allowed    map[common.Address]bool // white listed addresses. Requires mu.lock for concurrent use
-	mu         *sync.RWMutex           // mutex for throttled and allowed
+	mu         *sync.RWMutex           // mutex for config, throttled, allowed and rate limiters
+
+	accountLimiter *txRateLimiter // token buckets per sender address. Requires mu.lock for concurrent use
+	peerLimiter    *txRateLimiter // token buckets per peer ID. Requires mu.lock for concurrent use
+	ipLimiter      *txRateLimiter // token buckets per RPC client IP. Requires mu.lock for concurrent use
 
 	threshold  int
 	throttleCh chan *types.Transaction
@@ -68,6 +88,16 @@ type ThrottlerConfig struct {
 	MinimumThreshold    int `json:"minimum_threshold"`
 	ThresholdAdjustment int `json:"threshold_adjustment"`
 	ThrottleSeconds     int `json:"throttle_seconds"`
+
+	// Token-bucket admission limits applied regardless of the tx pool size.
+	// TPS is the refill rate of a bucket and Burst is the size of a bucket.
+	// A zero TPS disables the limit.
+	AccountTPS   uint `json:"account_tps"`
+	AccountBurst uint `json:"account_burst"`
+	PeerTPS      uint `json:"peer_tps"`
+	PeerBurst    uint `json:"peer_burst"`
+	IPTPS        uint `json:"ip_tps"`
+	IPBurst      uint `json:"ip_burst"`
 }
 
 var DefaultSpamThrottlerConfig = &ThrottlerConfig{
@@ -82,6 +112,14 @@ var DefaultSpamThrottlerConfig = &ThrottlerConfig{
 	MinimumThreshold:    100,
 	ThresholdAdjustment: 5,
 	ThrottleSeconds:     300,
+
+	// Rate limits are disabled by default
+	AccountTPS:   0,
+	AccountBurst: 0,
+	PeerTPS:      0,
+	PeerBurst:    0,
+	IPTPS:        0,
+	IPBurst:      0,
 }
 
 func GetSpamThrottler() *throttler {
@@ -101,29 +139,38 @@ func validateConfig(conf *ThrottlerConfig) error {
 	if conf.InitialThreshold < conf.MinimumThreshold {
 		return errors.New("invalid ThrottlerConfig. MinimumThreshold <= InitialThreshold")
 	}
+	if conf.AccountTPS > 0 && conf.AccountBurst == 0 {
+		return errors.New("invalid ThrottlerConfig. 0 < AccountBurst if 0 < AccountTPS")
+	}
+	if conf.PeerTPS > 0 && conf.PeerBurst == 0 {
+		return errors.New("invalid ThrottlerConfig. 0 < PeerBurst if 0 < PeerTPS")
+	}
+	if conf.IPTPS > 0 && conf.IPBurst == 0 {
+		return errors.New("invalid ThrottlerConfig. 0 < IPBurst if 0 < IPTPS")
+	}
 
 	return nil
 }
 
 // adjustThreshold adjusts the spam weight threshold of throttler in an adaptive way.
-func (t *throttler) adjustThreshold(ratio uint) {
+func (t *throttler) adjustThreshold(config *ThrottlerConfig, ratio uint) {
 	var newThreshold int
 	// Decrease threshold if a fail ratio is bigger than target value to put more addresses in throttled map
-	if ratio > t.config.TargetFailRatio {
-		if t.threshold-t.config.ThresholdAdjustment > t.config.MinimumThreshold {
-			newThreshold = t.threshold - t.config.ThresholdAdjustment
+	if ratio > config.TargetFailRatio {
+		if t.threshold-config.ThresholdAdjustment > config.MinimumThreshold {
+			newThreshold = t.threshold - config.ThresholdAdjustment
 		} else {
 			// Set minimum threshold
-			newThreshold = t.config.MinimumThreshold
+			newThreshold = config.MinimumThreshold
 		}
 
 		// Increase threshold if a fail ratio is smaller than target ratio until it exceeds InitialThreshold
 	} else {
-		if t.threshold+t.config.ThresholdAdjustment < t.config.InitialThreshold {
-			newThreshold = t.threshold + t.config.ThresholdAdjustment
+		if t.threshold+config.ThresholdAdjustment < config.InitialThreshold {
+			newThreshold = t.threshold + config.ThresholdAdjustment
 		} else {
 			// Set maximum threshold
-			newThreshold = t.config.InitialThreshold
+			newThreshold = config.InitialThreshold
 		}
 	}
 
@@ -178,6 +225,7 @@ func (t *throttler) updateThrottlerState(txs types.Transactions, receipts types.
 	var removeCandidate []common.Address
 	var newThrottled []common.Address
 
+	config := t.GetConfig()
 	startTime := time.Now()
 	numFailed := 0
 	failRatio := uint(0)
@@ -195,19 +243,19 @@ func (t *throttler) updateThrottlerState(txs types.Transactions, receipts types.
 
 			weight := t.candidates[*toAddr]
 			if weight == 0 {
-				if mapSize >= t.config.MaxCandidates {
+				if mapSize >= config.MaxCandidates {
 					continue
 				}
 				mapSize++
 			}
 
-			t.candidates[*toAddr] = weight + t.config.IncreaseWeight
+			t.candidates[*toAddr] = weight + config.IncreaseWeight
 		}
 	}
 
 	// Decrease spam weight for all candidates and update throttle lists in throttled.
 	for addr, weight := range t.candidates {
-		newWeight := weight - t.config.DecreaseWeight
+		newWeight := weight - config.DecreaseWeight
 
 		switch {
 		case newWeight <= 0:
@@ -233,7 +281,7 @@ func (t *throttler) updateThrottlerState(txs types.Transactions, receipts types.
 
 	// Update throttled and threshold
 	t.updateThrottled(newThrottled)
-	t.adjustThreshold(failRatio)
+	t.adjustThreshold(config, failRatio)
 
 	// Update metrics
 	candidateSizeGauge.Update(int64(len(t.candidates)))
@@ -300,5 +348,206 @@ func (t *throttler) GetCandidates() map[common.Address]int {
 }
 
 func (t *throttler) GetConfig() *ThrottlerConfig {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return t.config
 }
+
+// setConfig replaces the config of a running throttler. The throttle candidates, the throttled
+// and allowed addresses are kept, while the rate limiters restart with full buckets.
+// The size of the throttled tx queue is not changed.
+func (t *throttler) setConfig(config *ThrottlerConfig) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.config = config
+	t.accountLimiter = newTxRateLimiter(config.AccountTPS, config.AccountBurst)
+	t.peerLimiter = newTxRateLimiter(config.PeerTPS, config.PeerBurst)
+	t.ipLimiter = newTxRateLimiter(config.IPTPS, config.IPBurst)
+}
+
+// admitPeerTxs returns the txs within the rate limits of the given peer and the senders of the txs.
+// The txs exceeding the limits are dropped.
+func (t *throttler) admitPeerTxs(signer types.Signer, peerID string, txs types.Transactions) types.Transactions {
+	t.mu.RLock()
+	accountLimiter, peerLimiter := t.accountLimiter, t.peerLimiter
+	t.mu.RUnlock()
+
+	if accountLimiter == nil && peerLimiter == nil {
+		return txs
+	}
+
+	now := time.Now()
+	admitted := txs[:0]
+	for _, tx := range txs {
+		if !peerLimiter.allowed(peerID, now) {
+			logger.Trace("drop a tx exceeding the peer rate limit", "txHash", tx.Hash(), "peer", peerID)
+			throttlerPeerDropCount.Inc(1)
+			continue
+		}
+		from, limited := senderKey(accountLimiter, signer, tx)
+		if limited && !accountLimiter.allowed(from, now) {
+			logger.Trace("drop a tx exceeding the account rate limit", "txHash", tx.Hash())
+			throttlerAccountDropCount.Inc(1)
+			continue
+		}
+		// The tokens are consumed only if the tx is within all the limits
+		peerLimiter.take(peerID, now)
+		if limited {
+			accountLimiter.take(from, now)
+		}
+		admitted = append(admitted, tx)
+	}
+	return admitted
+}
+
+// AdmitRPCTx returns ErrTxRateLimited if the tx exceeds the rate limits of the given RPC client IP
+// or its sender. An empty ip, e.g. of the IPC clients, is not limited by the IP.
+func (t *throttler) AdmitRPCTx(signer types.Signer, ip string, tx *types.Transaction) error {
+	t.mu.RLock()
+	accountLimiter, ipLimiter := t.accountLimiter, t.ipLimiter
+	t.mu.RUnlock()
+
+	now := time.Now()
+	if ip != "" && !ipLimiter.allowed(ip, now) {
+		throttlerIPDropCount.Inc(1)
+		return ErrTxRateLimited
+	}
+	from, limited := senderKey(accountLimiter, signer, tx)
+	if limited && !accountLimiter.allowed(from, now) {
+		throttlerAccountDropCount.Inc(1)
+		return ErrTxRateLimited
+	}
+	// The tokens are consumed only if the tx is within all the limits
+	if ip != "" {
+		ipLimiter.take(ip, now)
+	}
+	if limited {
+		accountLimiter.take(from, now)
+	}
+	return nil
+}
+
+// senderKey returns the key of the sender of the tx in the account rate limiter, and whether the tx
+// is limited by its sender. The tx of an unknown sender is not limited, as it will be rejected by the tx pool.
+func senderKey(limiter *txRateLimiter, signer types.Signer, tx *types.Transaction) (string, bool) {
+	if limiter == nil {
+		return "", false
+	}
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return "", false
+	}
+	return string(from.Bytes()), true
+}
+
+// pruneLimiters removes the full token buckets which are the same as the new ones.
+func (t *throttler) pruneLimiters(now time.Time) {
+	t.mu.RLock()
+	limiters := []*txRateLimiter{t.accountLimiter, t.peerLimiter, t.ipLimiter}
+	t.mu.RUnlock()
+
+	for _, l := range limiters {
+		l.prune(now)
+	}
+}
+
+// txRateLimiter limits the number of txs per key with token buckets. A bucket of a key
+// is refilled by rate tokens per second up to burst tokens, and a tx consumes a token.
+// A nil txRateLimiter allows all txs.
+type txRateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newTxRateLimiter returns a new txRateLimiter, or nil if the rate is zero.
+func newTxRateLimiter(rate, burst uint) *txRateLimiter {
+	if rate == 0 {
+		return nil
+	}
+	return &txRateLimiter{
+		rate:    float64(rate),
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// refill adds the tokens generated since the last update to the bucket.
+func (l *txRateLimiter) refill(b *tokenBucket, now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * l.rate
+		if b.tokens > l.burst {
+			b.tokens = l.burst
+		}
+		b.last = now
+	}
+}
+
+// allowed returns true if the bucket of the key has a token, without consuming it.
+// A new key is not allowed if the rate limiter is full even after pruning.
+func (l *txRateLimiter) allowed(key string, now time.Time) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxRateLimiterKeys && now.Sub(l.lastPrune) > rateLimiterFullPruneInterval {
+			l.pruneLocked(now)
+		}
+		return len(l.buckets) < maxRateLimiterKeys && l.burst >= 1
+	}
+	l.refill(b, now)
+	return b.tokens >= 1
+}
+
+// take consumes a token of the key.
+func (l *txRateLimiter) take(key string, now time.Time) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	l.refill(b, now)
+	b.tokens--
+}
+
+// prune removes the full buckets to bound the memory usage.
+func (l *txRateLimiter) prune(now time.Time) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pruneLocked(now)
+}
+
+// pruneLocked is the same as prune, but it should be called with mu locked.
+func (l *txRateLimiter) pruneLocked(now time.Time) {
+	for key, b := range l.buckets {
+		l.refill(b, now)
+		if b.tokens >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastPrune = now
+}
@@ -2,11 +2,14 @@ package blockchain
 
 import (
 	"math/big"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/params"
 	"github.com/stretchr/testify/assert"
 )
@@ -95,3 +98,138 @@ func TestThrottler_updateThrottlerState(t *testing.T) {
 		assert.Equal(t, tc.throttledWeight, th.throttled[toFail])
 	}
 }
+
+func TestTxRateLimiter(t *testing.T) {
+	// A nil limiter allows all txs
+	var nilLimiter *txRateLimiter
+	assert.Nil(t, newTxRateLimiter(0, 10))
+	assert.True(t, nilLimiter.allowed("key", time.Now()))
+	nilLimiter.take("key", time.Now())
+
+	l := newTxRateLimiter(2, 3)
+	now := time.Now()
+
+	// A token is not consumed until it is taken
+	assert.True(t, l.allowed("a", now))
+	assert.True(t, l.allowed("a", now))
+	assert.Equal(t, 0, len(l.buckets))
+
+	// The burst is allowed at once, and each key has its own bucket
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.allowed("a", now))
+		l.take("a", now)
+	}
+	assert.False(t, l.allowed("a", now))
+	assert.True(t, l.allowed("b", now))
+	l.take("b", now)
+
+	// Tokens are refilled by the rate
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, l.allowed("a", now))
+	l.take("a", now)
+	assert.False(t, l.allowed("a", now))
+
+	// Only the full buckets are pruned. The bucket of "b" is refilled to the burst
+	l.prune(now)
+	assert.Equal(t, 1, len(l.buckets))
+	l.prune(now.Add(2 * time.Second))
+	assert.Equal(t, 0, len(l.buckets))
+}
+
+func TestTxRateLimiter_Full(t *testing.T) {
+	l := newTxRateLimiter(1, 1)
+	now := time.Now()
+	l.lastPrune = now
+	for i := 0; i < maxRateLimiterKeys; i++ {
+		l.take(strconv.Itoa(i), now)
+	}
+
+	// A new key is dropped while no bucket can be pruned
+	assert.False(t, l.allowed("new", now.Add(rateLimiterFullPruneInterval/2)))
+
+	// The full buckets are pruned to accept a new key
+	assert.True(t, l.allowed("new", now.Add(rateLimiterFullPruneInterval+time.Second)))
+	assert.Equal(t, 0, len(l.buckets))
+}
+
+func TestThrottler_admitPeerTxs(t *testing.T) {
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+
+	config := *DefaultSpamThrottlerConfig
+	config.AccountTPS, config.AccountBurst = 1, 2
+	config.PeerTPS, config.PeerBurst = 1, 3
+
+	th := newTestThrottler(&config)
+	th.setConfig(&config)
+
+	// The account limit drops the third tx of key1
+	txs := types.Transactions{transaction(0, 100000, key1), transaction(1, 100000, key1), transaction(2, 100000, key1)}
+	admitted := th.admitPeerTxs(signer, "peer1", txs)
+	assert.Equal(t, 2, len(admitted))
+
+	// The tx dropped by the account limit does not consume the peer limit,
+	// so only the second tx of key2 exceeds the peer limit
+	txs = types.Transactions{transaction(0, 100000, key2), transaction(1, 100000, key2)}
+	admitted = th.admitPeerTxs(signer, "peer1", txs)
+	assert.Equal(t, 1, len(admitted))
+
+	// Each peer has its own bucket
+	key3, _ := crypto.GenerateKey()
+	txs = types.Transactions{transaction(0, 100000, key3), transaction(1, 100000, key3)}
+	admitted = th.admitPeerTxs(signer, "peer2", txs)
+	assert.Equal(t, 2, len(admitted))
+}
+
+func TestThrottler_AdmitRPCTx(t *testing.T) {
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+
+	config := *DefaultSpamThrottlerConfig
+	config.AccountTPS, config.AccountBurst = 1, 1
+	config.IPTPS, config.IPBurst = 1, 2
+
+	th := newTestThrottler(&config)
+	th.setConfig(&config)
+
+	assert.NoError(t, th.AdmitRPCTx(signer, "127.0.0.1", transaction(0, 100000, key1)))
+	assert.Equal(t, ErrTxRateLimited, th.AdmitRPCTx(signer, "127.0.0.2", transaction(1, 100000, key1)))
+	// The tx dropped by the account limit does not consume the IP limit
+	keyA, _ := crypto.GenerateKey()
+	keyB, _ := crypto.GenerateKey()
+	assert.NoError(t, th.AdmitRPCTx(signer, "127.0.0.2", transaction(0, 100000, keyA)))
+	assert.NoError(t, th.AdmitRPCTx(signer, "127.0.0.2", transaction(0, 100000, keyB)))
+	assert.NoError(t, th.AdmitRPCTx(signer, "127.0.0.1", transaction(0, 100000, key2)))
+	assert.Equal(t, ErrTxRateLimited, th.AdmitRPCTx(signer, "127.0.0.1", transaction(1, 100000, key2)))
+
+	// The clients without an IP are limited by the sender only
+	key3, _ := crypto.GenerateKey()
+	assert.NoError(t, th.AdmitRPCTx(signer, "", transaction(0, 100000, key3)))
+}
+
+func TestTxPool_StartSpamThrottler(t *testing.T) {
+	pool, _ := setupTxPool()
+	defer pool.Stop()
+	defer pool.StopSpamThrottler()
+
+	assert.NoError(t, pool.StartSpamThrottler(nil))
+	th := GetSpamThrottler()
+	assert.NotNil(t, th)
+	assert.Nil(t, th.peerLimiter)
+
+	th.SetAllowed([]common.Address{{0x1}})
+
+	// A running throttler is updated with the new config keeping its state
+	config := *DefaultSpamThrottlerConfig
+	config.PeerTPS = 10
+	assert.Error(t, pool.StartSpamThrottler(&config))
+
+	config.PeerBurst = 10
+	assert.NoError(t, pool.StartSpamThrottler(&config))
+	assert.Equal(t, th, GetSpamThrottler())
+	assert.Equal(t, &config, th.GetConfig())
+	assert.NotNil(t, th.peerLimiter)
+	assert.Equal(t, []common.Address{{0x1}}, th.GetAllowed())
+}
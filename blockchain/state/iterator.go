@@ -26,6 +26,7 @@ import (
 
 	"github.com/klaytn/klaytn/blockchain/types/account"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/rlp"
 	"github.com/klaytn/klaytn/storage/statedb"
@@ -418,3 +419,56 @@ func CheckStateConsistency(oldDB Database, newDB Database, root common.Hash, map
 	logger.Info("CheckStateConsistency is completed", "cnt", cnt, "cnt without duplication", len(nodes))
 	return nil
 }
+
+// VerifyState iterates all state/storage trie nodes and codes of the given root, and
+// checks that the hash of every node and code stored in the database matches its key.
+func VerifyState(db Database, root common.Hash, quit chan struct{}) error {
+	state, err := New(root, db, nil)
+	if err != nil {
+		return err
+	}
+	it := NewNodeIterator(state)
+
+	cnt, codes := 0, 0
+	lastTime := time.Now()
+	for it.Next() {
+		cnt++
+		if time.Since(lastTime) > log.StatsReportLimit {
+			logger.Info("VerifyState in progress", "nodes", cnt, "codes", codes, "type", it.Type,
+				"hash", it.Hash.String(), "path", statedb.HexPathToString(it.Path))
+			lastTime = time.Now()
+		}
+
+		switch {
+		case it.Type == "code":
+			codes++
+			if hash := crypto.Keccak256Hash(it.Code); hash != it.Hash {
+				return fmt.Errorf("mismatched code hash : key(%v) hash(%v) parent(%v)", it.Hash.String(), hash.String(), it.Parent.String())
+			}
+		case !common.EmptyHash(it.Hash):
+			blob, err := db.TrieDB().Node(it.Hash)
+			if err != nil {
+				return fmt.Errorf("missing %v node : hash(%v) parent(%v) err(%v)", it.Type, it.Hash.String(), it.Parent.String(), err)
+			}
+			if hash := crypto.Keccak256Hash(blob); hash != it.Hash {
+				return fmt.Errorf("mismatched %v node hash : key(%v) hash(%v) parent(%v) path(%v)", it.Type,
+					it.Hash.String(), hash.String(), it.Parent.String(), statedb.HexPathToString(it.Path))
+			}
+		}
+
+		if quit != nil {
+			select {
+			case <-quit:
+				logger.Warn("VerifyState is stop", "cnt", cnt)
+				return errStopByQuit
+			default:
+			}
+		}
+	}
+	if it.Error != nil {
+		return fmt.Errorf("%w : %v", errIterator, it.Error)
+	}
+
+	logger.Info("VerifyState is completed", "root", root.String(), "cnt", cnt, "codes", codes)
+	return nil
+}
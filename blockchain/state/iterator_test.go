@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 )
 
 // Tests that the node iterator indeed walks over the entire database contents.
@@ -72,3 +73,23 @@ func TestNodeIteratorCoverage(t *testing.T) {
 	}
 	it.Release()
 }
+
+// Tests that VerifyState detects a state entry of which hash does not match its key.
+func TestVerifyState(t *testing.T) {
+	db, root, _ := makeTestState(t)
+	db.TrieDB().Commit(root, false, 0)
+	diskDB := db.TrieDB().DiskDB()
+
+	if err := VerifyState(NewDatabase(diskDB), root, nil); err != nil {
+		t.Fatalf("failed to verify a valid state: %v", err)
+	}
+
+	// Corrupt the code of an account
+	codeHash := crypto.Keccak256Hash([]byte{3, 3, 3, 3, 3})
+	if err := diskDB.GetStateTrieDB().Put(codeHash[:], []byte{9, 9}); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyState(NewDatabase(diskDB), root, nil); err == nil {
+		t.Fatal("corrupted code is not detected")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/snapshot"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/storage/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateBloom_CommitAndLoad(t *testing.T) {
+	dir, err := os.MkdirTemp("", "klaytn-statebloom")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bloom, err := newStateBloomWithSize(1)
+	require.NoError(t, err)
+
+	hash := common.HexToHash("0x1234")
+	codeHash := common.HexToHash("0x5678")
+	require.NoError(t, bloom.Put(hash.Bytes(), nil))
+	require.NoError(t, bloom.Put(database.CodeKey(codeHash), nil))
+	assert.Error(t, bloom.Put([]byte{0x01}, nil))
+
+	root := common.HexToHash("0xabcd")
+	filename := bloomFilterName(dir, root)
+	require.NoError(t, bloom.Commit(filename, filename+stateBloomFileTempSuffix))
+
+	path, bloomRoot, err := findBloomFilter(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filename, path)
+	assert.Equal(t, root, bloomRoot)
+
+	// The filters in the subdirectories are not the marker of the pruning
+	other := filepath.Join(dir, "chaindata")
+	require.NoError(t, os.Mkdir(other, 0o755))
+	require.NoError(t, bloom.Commit(bloomFilterName(other, common.HexToHash("0xef")), bloomFilterName(other, common.HexToHash("0xef"))+stateBloomFileTempSuffix))
+	path, bloomRoot, err = findBloomFilter(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filename, path)
+	assert.Equal(t, root, bloomRoot)
+
+	// A missing datadir has nothing to recover
+	path, _, err = findBloomFilter(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, path)
+
+	loaded, err := NewStateBloomFromDisk(filename)
+	require.NoError(t, err)
+	for _, key := range [][]byte{hash.Bytes(), codeHash.Bytes()} {
+		ok, err := loaded.Contain(key)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+}
+
+// TestPrune checks that the pruning deletes the stale state and keeps the
+// target state intact.
+func TestPrune(t *testing.T) {
+	dir, err := os.MkdirTemp("", "klaytn-pruner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db := database.NewMemoryDBManager()
+	sdb := state.NewDatabase(db)
+	rules := params.TestChainConfig.Rules(big.NewInt(0))
+
+	commit := func(s *state.StateDB) common.Hash {
+		root, err := s.Commit(true)
+		require.NoError(t, err)
+		require.NoError(t, sdb.TrieDB().Commit(root, false, 0))
+		return root
+	}
+
+	// Build a stale state and the target state on top of it.
+	eoa, contract := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	s, _ := state.New(common.Hash{}, sdb, nil)
+	s.AddBalance(eoa, big.NewInt(1))
+	s.CreateSmartContractAccount(contract, params.CodeFormatEVM, rules)
+	s.SetCode(contract, []byte{0x60, 0x00})
+	s.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0x01"))
+	staleRoot := commit(s)
+
+	s, _ = state.New(staleRoot, sdb, nil)
+	s.AddBalance(eoa, big.NewInt(1))
+	s.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0x02"))
+	root := commit(s)
+
+	snaptree, err := snapshot.New(db, statedb.NewDatabase(db), 256, root, false, true, false)
+	require.NoError(t, err)
+
+	bloom, err := newStateBloomWithSize(1)
+	require.NoError(t, err)
+	require.NoError(t, snapshot.GenerateTrie(snaptree, root, db, bloom))
+
+	filename := bloomFilterName(dir, root)
+	require.NoError(t, bloom.Commit(filename, filename+stateBloomFileTempSuffix))
+	require.NoError(t, prune(snaptree, root, db, bloom, filename, nil, time.Now()))
+
+	ok, _ := db.HasStateTrieNode(staleRoot[:])
+	assert.False(t, ok)
+	ok, _ = db.HasStateTrieNode(root[:])
+	assert.True(t, ok)
+	assert.False(t, common.FileExist(filepath.Join(dir, filepath.Base(filename))))
+
+	s, err = state.New(root, state.NewDatabase(db), nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), s.GetBalance(eoa))
+	assert.Equal(t, common.HexToHash("0x02"), s.GetState(contract, common.HexToHash("0x01")))
+	assert.Equal(t, []byte{0x60, 0x00}, s.GetCode(contract))
+}
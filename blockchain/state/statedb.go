@@ -92,6 +92,9 @@ type StateDB struct {
 
 	preimages map[common.Hash][]byte
 
+	// Transient storage of EIP-1153, which is discarded at the end of a transaction.
+	transientStorage map[common.Address]Storage
+
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
 	journal        *journal
@@ -128,6 +131,7 @@ func New(root common.Hash, db Database, snaps *snapshot.Tree) (*StateDB, error)
 		stateObjectsDirtyStorage: make(map[common.Address]struct{}),
 		stateObjectsDirty:        make(map[common.Address]struct{}),
 		logs:                     make(map[common.Hash][]*types.Log),
+		transientStorage:         make(map[common.Address]Storage),
 		preimages:                make(map[common.Hash][]byte),
 		journal:                  newJournal(),
 	}
@@ -155,6 +159,7 @@ func NewForPrefetching(root common.Hash, db Database, snaps *snapshot.Tree) (*St
 		stateObjectsDirtyStorage: make(map[common.Address]struct{}),
 		stateObjectsDirty:        make(map[common.Address]struct{}),
 		logs:                     make(map[common.Hash][]*types.Log),
+		transientStorage:         make(map[common.Address]Storage),
 		preimages:                make(map[common.Hash][]byte),
 		journal:                  newJournal(),
 		prefetching:              true,
@@ -206,6 +211,7 @@ func (self *StateDB) Reset(root common.Hash) error {
 	self.logs = make(map[common.Hash][]*types.Log)
 	self.logSize = 0
 	self.preimages = make(map[common.Hash][]byte)
+	self.transientStorage = make(map[common.Address]Storage)
 	self.clearJournalAndRefund()
 	return nil
 }
@@ -493,6 +499,44 @@ func (self *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// GetTransientState retrieves a value from the transient storage of the given account.
+func (self *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return self.transientStorage[addr][key]
+}
+
+// SetTransientState sets a value in the transient storage of the given account.
+func (self *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := self.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	self.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	self.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for the transient storage. It is
+// called during a revert to prevent modifications to the journal.
+func (self *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	storage, ok := self.transientStorage[addr]
+	if !ok {
+		storage = make(Storage)
+		self.transientStorage[addr] = storage
+	}
+	storage[key] = value
+}
+
+// ResetTransientStorage discards the transient storage. It is called at the
+// beginning of every transaction.
+func (self *StateDB) ResetTransientStorage() {
+	if len(self.transientStorage) > 0 {
+		self.transientStorage = make(map[common.Address]Storage)
+	}
+}
+
 // SetStorage replaces the entire storage for the specified account with given
 // storage. This function should only be used for debugging.
 func (self *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
@@ -807,6 +851,7 @@ func (self *StateDB) Copy() *StateDB {
 		logs:              make(map[common.Hash][]*types.Log, len(self.logs)),
 		logSize:           self.logSize,
 		preimages:         make(map[common.Hash][]byte),
+		transientStorage:  make(map[common.Address]Storage, len(self.transientStorage)),
 		journal:           newJournal(),
 	}
 	// Copy the dirty states, logs, and preimages
@@ -835,6 +880,9 @@ func (self *StateDB) Copy() *StateDB {
 	for hash, preimage := range self.preimages {
 		state.preimages[hash] = preimage
 	}
+	for addr, storage := range self.transientStorage {
+		state.transientStorage[addr] = storage.Copy()
+	}
 
 	if self.snaps != nil {
 		// In order for the miner to be able to use and make additions
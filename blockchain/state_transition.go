@@ -28,6 +28,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain/vm"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
 )
 
 var (
@@ -170,6 +171,11 @@ func (st *StateTransition) to() common.Address {
 	return *st.msg.To()
 }
 
+// isContractCreation returns whether the message deploys a contract.
+func isContractCreation(msg Message) bool {
+	return msg.To() == nil || msg.Type().IsContractDeploy()
+}
+
 func (st *StateTransition) useGas(amount uint64) error {
 	if st.gas < amount {
 		return kerrors.ErrOutOfGas
@@ -255,12 +261,23 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, kerr kerr
 
 	msg := st.msg
 
+	// Check whether the init code size has been exceeded.
+	if st.evm.ChainConfig().IsCancunForkEnabled(st.evm.BlockNumber) && isContractCreation(msg) && len(st.data) > params.MaxInitCodeSize {
+		logger.Debug(ErrMaxInitCodeSizeExceeded.Error(), "size", len(st.data), "limit", params.MaxInitCodeSize, "txHash", msg.Hash().String())
+		kerr.ErrTxInvalid = ErrMaxInitCodeSizeExceeded
+		kerr.Status = getReceiptStatusFromErrTxFailed(nil)
+		return nil, 0, kerr
+	}
+
 	// Pay intrinsic gas.
 	if kerr.ErrTxInvalid = st.useGas(msg.ValidatedIntrinsicGas()); kerr.ErrTxInvalid != nil {
 		kerr.Status = getReceiptStatusFromErrTxFailed(nil)
 		return nil, 0, kerr
 	}
 
+	// The transient storage of EIP-1153 lives only during a transaction.
+	st.state.ResetTransientStorage()
+
 	// vm errors do not effect consensus and are therefor
 	// not assigned to err, except for insufficient balance
 	// error and total time limit reached error.
@@ -114,7 +114,7 @@ func (journal *txJournal) load(add func([]*types.Transaction) []error) error {
 			batch = batch[:0]
 		}
 	}
-	logger.Info("Loaded local transaction journal", "transactions", total, "dropped", dropped)
+	logger.Info("Loaded transaction journal", "path", journal.path, "transactions", total, "dropped", dropped)
 
 	return failure
 }
@@ -168,7 +168,7 @@ func (journal *txJournal) rotate(all map[common.Address]types.Transactions, sign
 		return err
 	}
 	journal.writer = sink
-	logger.Info("Regenerated local transaction journal", "transactions", journaled, "accounts", len(all))
+	logger.Info("Regenerated transaction journal", "path", journal.path, "transactions", journaled, "accounts", len(all))
 
 	return nil
 }
@@ -0,0 +1,136 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+)
+
+// txParkedList holds the transactions which are priced between the lower bound
+// base fee and the current base fee after the Magma hardfork. They are not in
+// the pending or queued pool, and re-added to the pool when the base fee falls.
+type txParkedList struct {
+	accounts map[common.Address]*txSortedMap // Parked transactions grouped by sender
+	senders  map[common.Hash]common.Address  // Sender of each parked transaction
+	times    map[common.Hash]time.Time       // Time when each transaction was parked
+}
+
+func newTxParkedList() *txParkedList {
+	return &txParkedList{
+		accounts: make(map[common.Address]*txSortedMap),
+		senders:  make(map[common.Hash]common.Address),
+		times:    make(map[common.Hash]time.Time),
+	}
+}
+
+// Len returns the number of the parked transactions.
+func (l *txParkedList) Len() int {
+	return len(l.senders)
+}
+
+// AccountLen returns the number of the parked transactions of the given account.
+func (l *txParkedList) AccountLen(addr common.Address) int {
+	if txs := l.accounts[addr]; txs != nil {
+		return txs.Len()
+	}
+	return 0
+}
+
+// Contains returns whether the transaction of the given hash is parked.
+func (l *txParkedList) Contains(hash common.Hash) bool {
+	_, ok := l.senders[hash]
+	return ok
+}
+
+// Add parks a transaction. If a transaction of the same nonce is already parked,
+// it is replaced only if the new one has a higher gas fee cap.
+// It returns whether the transaction is inserted and the replaced transaction if any.
+func (l *txParkedList) Add(addr common.Address, tx *types.Transaction, now time.Time) (bool, *types.Transaction) {
+	txs := l.accounts[addr]
+	if txs == nil {
+		txs = newTxSortedMap()
+		l.accounts[addr] = txs
+	}
+	old := txs.Get(tx.Nonce())
+	if old != nil {
+		if old.GasFeeCap().Cmp(tx.GasFeeCap()) >= 0 {
+			return false, nil
+		}
+		delete(l.senders, old.Hash())
+		delete(l.times, old.Hash())
+	}
+	txs.Put(tx)
+	l.senders[tx.Hash()] = addr
+	l.times[tx.Hash()] = now
+	return true, old
+}
+
+// Remove removes a parked transaction.
+func (l *txParkedList) Remove(tx *types.Transaction) {
+	hash := tx.Hash()
+	addr, ok := l.senders[hash]
+	if !ok {
+		return
+	}
+	delete(l.senders, hash)
+	delete(l.times, hash)
+	if txs := l.accounts[addr]; txs != nil {
+		txs.Remove(tx.Nonce())
+		if txs.Len() == 0 {
+			delete(l.accounts, addr)
+		}
+	}
+}
+
+// Cheapest returns the parked transaction with the lowest gas fee cap, or nil if
+// no transaction is parked.
+func (l *txParkedList) Cheapest() *types.Transaction {
+	var cheapest *types.Transaction
+	for _, txs := range l.accounts {
+		for _, tx := range txs.items {
+			if cheapest == nil || tx.GasFeeCap().Cmp(cheapest.GasFeeCap()) < 0 {
+				cheapest = tx
+			}
+		}
+	}
+	return cheapest
+}
+
+// Expired returns the transactions parked before the given deadline.
+func (l *txParkedList) Expired(deadline time.Time) types.Transactions {
+	var expired types.Transactions
+	for _, txs := range l.accounts {
+		for _, tx := range txs.items {
+			if l.times[tx.Hash()].Before(deadline) {
+				expired = append(expired, tx)
+			}
+		}
+	}
+	return expired
+}
+
+// Content returns the parked transactions grouped by account and sorted by nonce.
+func (l *txParkedList) Content() map[common.Address]types.Transactions {
+	content := make(map[common.Address]types.Transactions, len(l.accounts))
+	for addr, txs := range l.accounts {
+		content[addr] = txs.Flatten()
+	}
+	return content
+}
@@ -58,6 +58,10 @@ var (
 	txPoolIsFullErr = fmt.Errorf("txpool is full")
 
 	errNotAllowedAnchoringTx = errors.New("locally anchoring chaindata tx is not allowed in this node")
+
+	errParkingDisabled       = errors.New("parking underpriced transactions is disabled")
+	errParkedAccountSlotFull = errors.New("no parked transaction slot is left for the account")
+	errParkedSlotFull        = errors.New("no parked transaction slot is left")
 )
 
 var (
@@ -73,6 +77,13 @@ var (
 	queuedRateLimitCounter = metrics.NewRegisteredCounter("txpool/queued/ratelimit", nil) // Dropped due to rate limiting
 	queuedNofundsCounter   = metrics.NewRegisteredCounter("txpool/queued/nofunds", nil)   // Dropped due to out-of-funds
 
+	// Metrics for the parked pool
+	parkedDiscardCounter   = metrics.NewRegisteredCounter("txpool/parked/discard", nil)
+	parkedReplaceCounter   = metrics.NewRegisteredCounter("txpool/parked/replace", nil)
+	parkedRateLimitCounter = metrics.NewRegisteredCounter("txpool/parked/ratelimit", nil) // Dropped due to slot limits
+	parkedTimeoutCounter   = metrics.NewRegisteredCounter("txpool/parked/timeout", nil)   // Dropped due to lifetime
+	parkedPromoteCounter   = metrics.NewRegisteredCounter("txpool/parked/promote", nil)   // Re-added to the pool
+
 	// General tx metrics
 	invalidTxCounter     = metrics.NewRegisteredCounter("txpool/invalid", nil)
 	underpricedTxCounter = metrics.NewRegisteredCounter("txpool/underpriced", nil)
@@ -107,6 +118,8 @@ type TxPoolConfig struct {
 	DenyRemoteTx       bool          // Denies remote transactions receiving from other peers
 	Journal            string        // Journal of local transactions to survive node restarts
 	JournalInterval    time.Duration // Time interval to regenerate the local transaction journal
+	JournalRemotes     bool          // Whether remote transactions are also snapshotted to survive node restarts
+	RemoteJournal      string        // Snapshot of remote pending and queued transactions to survive node restarts
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
@@ -119,6 +132,10 @@ type TxPoolConfig struct {
 	KeepLocals bool          // Disables removing timed-out local transactions
 	Lifetime   time.Duration // Maximum amount of time non-executable transaction are queued
 
+	ParkedSlotsAccount uint64        // Maximum number of parked transaction slots permitted per account
+	ParkedSlotsAll     uint64        // Maximum number of parked transaction slots for all accounts (0 = parking disabled)
+	ParkedLifetime     time.Duration // Maximum amount of time underpriced transactions are parked
+
 	NoAccountCreation            bool // Whether account creation transactions should be disabled
 	EnableSpamThrottlerAtRuntime bool // Enable txpool spam throttler at runtime
 }
@@ -128,6 +145,7 @@ type TxPoolConfig struct {
 var DefaultTxPoolConfig = TxPoolConfig{
 	Journal:         "transactions.rlp",
 	JournalInterval: time.Hour,
+	RemoteJournal:   "remote_transactions.rlp",
 
 	PriceLimit: 1,
 	PriceBump:  10,
@@ -139,6 +157,10 @@ var DefaultTxPoolConfig = TxPoolConfig{
 
 	KeepLocals: false,
 	Lifetime:   5 * time.Minute,
+
+	ParkedSlotsAccount: 16,
+	ParkedSlotsAll:     1024,
+	ParkedLifetime:     10 * time.Minute,
 }
 
 // sanitize checks the provided user configurations and changes anything that's
@@ -183,8 +205,9 @@ type TxPool struct {
 	currentState       *state.StateDB            // Current state in the blockchain head
 	pendingNonce       map[common.Address]uint64 // Pending nonce tracking virtual nonces
 
-	locals  *accountSet // Set of local transaction to exempt from eviction rules
-	journal *txJournal  // Journal of local transaction to back up to disk
+	locals        *accountSet // Set of local transaction to exempt from eviction rules
+	journal       *txJournal  // Journal of local transaction to back up to disk
+	remoteJournal *txJournal  // Snapshot of remote transactions to back up to disk
 
 	// TODO-Klaytn
 	txMu sync.RWMutex
@@ -194,6 +217,7 @@ type TxPool struct {
 	beats   map[common.Address]time.Time       // Last heartbeat from each known account
 	all     map[common.Hash]*types.Transaction // All transactions to allow lookups
 	priced  *txPricedList                      // All transactions sorted by price
+	parked  *txParkedList                      // Underpriced transactions waiting for the base fee to fall
 
 	wg sync.WaitGroup // for shutdown sync
 
@@ -202,6 +226,7 @@ type TxPool struct {
 	eip2718 bool // Fork indicator whether we are using EIP-2718 type transactions.
 	eip1559 bool // Fork indicator whether we are using EIP-1559 type transactions.
 	magma   bool // Fork indicator whether we are using Magma type transactions.
+	cancun  bool // Fork indicator whether the initcode size of contract creations is limited.
 }
 
 // NewTxPool creates a new transaction pool to gather, sort and filter inbound
@@ -220,6 +245,7 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		queue:        make(map[common.Address]*txList),
 		beats:        make(map[common.Address]time.Time),
 		all:          make(map[common.Hash]*types.Transaction),
+		parked:       newTxParkedList(),
 		pendingNonce: make(map[common.Address]uint64),
 		chainHeadCh:  make(chan ChainHeadEvent, chainHeadChanSize),
 		gasPrice:     new(big.Int).SetUint64(chainconfig.UnitPrice),
@@ -240,6 +266,16 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 			logger.Error("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	// If remote transaction journaling is enabled, re-validate and re-inject the
+	// snapshot from disk. The slot limits are applied like received transactions.
+	if config.JournalRemotes && config.RemoteJournal != "" {
+		pool.remoteJournal = newTxJournal(config.RemoteJournal)
+
+		if err := pool.remoteJournal.load(pool.AddRemotes); err != nil {
+			logger.Error("Failed to load remote transaction journal", "err", err)
+		}
+		pool.snapshotRemotes()
+	}
 	// Subscribe events from blockchain
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
 
@@ -264,7 +300,7 @@ func (pool *TxPool) loop() {
 	defer pool.wg.Done()
 
 	// Start the stats reporting and transaction eviction tickers
-	var prevPending, prevQueued, prevStales int
+	var prevPending, prevQueued, prevStales, prevParked int
 
 	report := time.NewTicker(statsReportInterval)
 	defer report.Stop()
@@ -306,13 +342,15 @@ func (pool *TxPool) loop() {
 			pool.mu.RLock()
 			pending, queued := pool.stats()
 			stales := pool.priced.stales
+			parked := pool.parked.Len()
 			pool.mu.RUnlock()
 
-			if pending != prevPending || queued != prevQueued || stales != prevStales {
-				logger.Debug("Transaction pool status report", "executable", pending, "queued", queued, "stales", stales)
-				prevPending, prevQueued, prevStales = pending, queued, stales
+			if pending != prevPending || queued != prevQueued || stales != prevStales || parked != prevParked {
+				logger.Debug("Transaction pool status report", "executable", pending, "queued", queued, "stales", stales, "parked", parked)
+				prevPending, prevQueued, prevStales, prevParked = pending, queued, stales, parked
 				txPoolPendingGauge.Update(int64(pending))
 				txPoolQueueGauge.Update(int64(queued))
+				txPoolParkedGauge.Update(int64(parked))
 			}
 
 		// Handle inactive account transaction eviction
@@ -335,6 +373,7 @@ func (pool *TxPool) loop() {
 					delete(pool.beats, addr)
 				}
 			}
+			pool.evictParked(time.Now())
 			pool.mu.Unlock()
 
 		// Handle local transaction journal rotation
@@ -346,6 +385,9 @@ func (pool *TxPool) loop() {
 				}
 				pool.mu.Unlock()
 			}
+			if pool.remoteJournal != nil {
+				pool.snapshotRemotes()
+			}
 		}
 	}
 }
@@ -477,10 +519,13 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.eip1559 = pool.chainconfig.IsEthTxTypeForkEnabled(next)
 	// Enable dynamic base fee
 	pool.magma = pool.chainconfig.IsMagmaForkEnabled(next)
+	// Enable the initcode size limit
+	pool.cancun = pool.chainconfig.IsCancunForkEnabled(next)
 
 	// It need to update gas price of tx pool after magma hardfork
 	if pool.magma {
 		pool.gasPrice = misc.NextMagmaBlockBaseFee(newHead, pool.chainconfig.Governance.KIP71)
+		pool.unparkTxs()
 	}
 }
 
@@ -496,6 +541,10 @@ func (pool *TxPool) Stop() {
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.remoteJournal != nil {
+		pool.snapshotRemotes()
+		pool.remoteJournal.close()
+	}
 
 	pool.StopSpamThrottler()
 	logger.Info("Transaction pool stopped")
@@ -581,6 +630,15 @@ func (pool *TxPool) Content() (map[common.Address]types.Transactions, map[common
 	return pending, queued
 }
 
+// Parked retrieves the underpriced transactions parked until the base fee falls,
+// grouped by account and sorted by nonce.
+func (pool *TxPool) Parked() map[common.Address]types.Transactions {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return pool.parked.Content()
+}
+
 // Pending retrieves all currently processable transactions, groupped by origin
 // account and sorted by nonce. The returned transaction set is a copy and can be
 // freely modified by calling code.
@@ -651,13 +709,54 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 		if queued := pool.queue[addr]; queued != nil {
 			txs[addr] = append(txs[addr], queued.Flatten()...)
 		}
+		if parked := pool.parked.accounts[addr]; parked != nil {
+			txs[addr] = append(txs[addr], parked.Flatten()...)
+		}
 	}
 	return txs
 }
 
+// remote retrieves all currently known remote transactions, grouped by origin
+// account and sorted by nonce. The returned transaction set is a copy and can be
+// freely modified by calling code.
+func (pool *TxPool) remote() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions)
+	for addr, pending := range pool.pending {
+		if !pool.locals.contains(addr) {
+			txs[addr] = append(txs[addr], pending.Flatten()...)
+		}
+	}
+	for addr, queued := range pool.queue {
+		if !pool.locals.contains(addr) {
+			txs[addr] = append(txs[addr], queued.Flatten()...)
+		}
+	}
+	return txs
+}
+
+// snapshotRemotes regenerates the remote transaction journal with the current
+// remote pending and queued transactions.
+func (pool *TxPool) snapshotRemotes() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.txMu.Lock()
+	defer pool.txMu.Unlock()
+
+	if err := pool.remoteJournal.rotate(pool.remote(), pool.signer); err != nil {
+		logger.Error("Failed to rotate remote tx journal", "err", err)
+	}
+}
+
 // validateTx checks whether a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction) error {
+	return pool.validateTxWithBaseFee(tx, pool.gasPrice)
+}
+
+// validateTxWithBaseFee checks whether a transaction is valid like validateTx,
+// but it compares the gas price of the transaction with the given base fee after
+// the Magma hardfork instead of the gas price of the transaction pool.
+func (pool *TxPool) validateTxWithBaseFee(tx *types.Transaction, baseFee *big.Int) error {
 	// Accept only legacy transactions until EIP-2718/2930 activates.
 	if !pool.eip2718 && tx.IsEthTypedTransaction() {
 		return ErrTxTypeNotSupported
@@ -693,8 +792,8 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 
 		if pool.magma {
 			// Ensure transaction's gasFeeCap is greater than or equal to transaction pool's gasPrice(baseFee).
-			if pool.gasPrice.Cmp(tx.GasFeeCap()) > 0 {
-				logger.Trace("fail to validate maxFeePerGas", "pool.gasPrice", pool.gasPrice, "maxFeePerGas", tx.GasFeeCap())
+			if baseFee.Cmp(tx.GasFeeCap()) > 0 {
+				logger.Trace("fail to validate maxFeePerGas", "baseFee", baseFee, "maxFeePerGas", tx.GasFeeCap())
 				return ErrFeeCapBelowBaseFee
 			}
 		} else {
@@ -712,9 +811,9 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 
 	} else {
 		if pool.magma {
-			if pool.gasPrice.Cmp(tx.GasPrice()) > 0 {
+			if baseFee.Cmp(tx.GasPrice()) > 0 {
 				// Ensure transaction's gasPrice is greater than or equal to transaction pool's gasPrice(baseFee).
-				logger.Trace("fail to validate gasprice", "pool.gasPrice", pool.gasPrice, "tx.gasPrice", tx.GasPrice())
+				logger.Trace("fail to validate gasprice", "baseFee", baseFee, "tx.gasPrice", tx.GasPrice())
 				return ErrGasPriceBelowBaseFee
 			}
 		} else {
@@ -726,6 +825,11 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 		}
 	}
 
+	// Reject contract creations whose initcode exceeds the limit after the Cancun hardfork
+	if pool.cancun && (tx.To() == nil || tx.Type().IsContractDeploy()) && len(tx.Data()) > params.MaxInitCodeSize {
+		return ErrMaxInitCodeSizeExceeded
+	}
+
 	// Heuristic limit, reject transactions over 32KB to prevent DOS attacks
 	if tx.Size() > MaxTxDataSize {
 		return ErrOversizedData
@@ -851,15 +955,26 @@ func (pool *TxPool) getMaxTxFromQueueWhenNonceIsMissing(tx *types.Transaction, f
 // If a newly added transaction is marked as local, its sending account will be
 // whitelisted, preventing any associated transaction from being dropped out of
 // the pool due to pricing constraints.
+//
+// A transaction priced below the base fee is parked without an error until the
+// base fee falls. The parked transactions are reported by Parked.
 func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 	// If the transaction is already known, discard it
 	hash := tx.Hash()
-	if pool.all[hash] != nil {
+	if pool.all[hash] != nil || pool.parked.Contains(hash) {
 		logger.Trace("Discarding already known transaction", "hash", hash)
 		return false, fmt.Errorf("known transaction: %x", hash)
 	}
 	// If the transaction fails basic validation, discard it
 	if err := pool.validateTx(tx); err != nil {
+		// Park the transaction priced below the base fee instead of discarding it
+		if err == ErrFeeCapBelowBaseFee || err == ErrGasPriceBelowBaseFee {
+			parkErr := pool.parkTx(tx, local)
+			if parkErr == nil {
+				return false, nil
+			}
+			logger.Trace("Failed to park underpriced transaction", "hash", hash, "err", parkErr)
+		}
 		logger.Trace("Discarding invalid transaction", "hash", hash, "err", err)
 		invalidTxCounter.Inc(1)
 		return false, err
@@ -989,6 +1104,115 @@ func (pool *TxPool) journalTx(from common.Address, tx *types.Transaction) {
 	}
 }
 
+// parkTx parks a transaction priced below the current base fee until the base fee
+// falls, if it is priced above the lower bound base fee and valid otherwise.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) parkTx(tx *types.Transaction, local bool) error {
+	if !pool.magma || pool.config.ParkedSlotsAll == 0 {
+		return errParkingDisabled
+	}
+	lowerBound := new(big.Int).SetUint64(pool.chainconfig.Governance.KIP71.LowerBoundBaseFee)
+	if err := pool.validateTxWithBaseFee(tx, lowerBound); err != nil {
+		return err
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+
+	// A replacement of a parked transaction does not need a new slot
+	if replaced := pool.parked.accounts[from]; replaced == nil || replaced.Get(tx.Nonce()) == nil {
+		if !local && !pool.locals.contains(from) && uint64(pool.parked.AccountLen(from)) >= pool.config.ParkedSlotsAccount {
+			parkedRateLimitCounter.Inc(1)
+			return errParkedAccountSlotFull
+		}
+		if uint64(pool.parked.Len()) >= pool.config.ParkedSlotsAll {
+			// Make room for the new transaction if it pays more than the cheapest one
+			cheapest := pool.parked.Cheapest()
+			if cheapest.GasFeeCap().Cmp(tx.GasFeeCap()) >= 0 {
+				parkedRateLimitCounter.Inc(1)
+				return errParkedSlotFull
+			}
+			logger.Trace("Discarding cheapest parked transaction", "hash", cheapest.Hash(), "price", cheapest.GasFeeCap())
+			pool.parked.Remove(cheapest)
+			parkedRateLimitCounter.Inc(1)
+		}
+	}
+	inserted, old := pool.parked.Add(from, tx, time.Now())
+	if !inserted {
+		parkedDiscardCounter.Inc(1)
+		return ErrAlreadyNonceExistInPool
+	}
+	if old != nil {
+		parkedReplaceCounter.Inc(1)
+	}
+	// Mark local addresses and journal local transactions
+	if local {
+		pool.locals.add(from)
+	}
+	pool.journalTx(from, tx)
+
+	logger.Trace("Parked underpriced transaction", "hash", tx.Hash(), "from", from, "price", tx.GasFeeCap(), "baseFee", pool.gasPrice)
+	return nil
+}
+
+// unparkTxs re-adds the parked transactions priced above the current base fee
+// to the pool, and drops the parked transactions whose nonce is too low.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) unparkTxs() {
+	if pool.parked.Len() == 0 {
+		return
+	}
+	var accounts []common.Address
+	for addr, txs := range pool.parked.Content() {
+		nonce := pool.getNonce(addr)
+		unparked := false
+		for _, tx := range txs {
+			if tx.Nonce() < nonce {
+				logger.Trace("Removed old parked transaction", "hash", tx.Hash())
+				pool.parked.Remove(tx)
+				continue
+			}
+			if tx.GasFeeCap().Cmp(pool.gasPrice) < 0 {
+				continue
+			}
+			pool.parked.Remove(tx)
+			if _, err := pool.add(tx, pool.locals.contains(addr)); err != nil {
+				logger.Trace("Discarding parked transaction", "hash", tx.Hash(), "err", err)
+				parkedDiscardCounter.Inc(1)
+				continue
+			}
+			if pool.parked.Contains(tx.Hash()) {
+				continue
+			}
+			parkedPromoteCounter.Inc(1)
+			unparked = true
+		}
+		if unparked {
+			accounts = append(accounts, addr)
+		}
+	}
+	if len(accounts) > 0 {
+		pool.promoteExecutables(accounts)
+	}
+}
+
+// evictParked drops the transactions parked for longer than the parked lifetime.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) evictParked(now time.Time) {
+	for _, tx := range pool.parked.Expired(now.Add(-pool.config.ParkedLifetime)) {
+		// Skip local transactions from the eviction mechanism
+		if pool.config.KeepLocals {
+			if from, _ := types.Sender(pool.signer, tx); pool.locals.contains(from) {
+				continue
+			}
+		}
+		logger.Trace("Evicted parked transaction", "hash", tx.Hash())
+		pool.parked.Remove(tx)
+		parkedTimeoutCounter.Inc(1)
+	}
+}
+
 // promoteTx adds a transaction to the pending (processable) list of transactions
 // and returns whether it was inserted or an older was better.
 //
@@ -1028,9 +1252,10 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 	return true
 }
 
-// HandleTxMsg transfers transactions to a channel where handleTxMsg calls AddRemotes
-// to handle them. This is made not to wait from the results from TxPool.AddRemotes.
-func (pool *TxPool) HandleTxMsg(txs types.Transactions) {
+// HandleTxMsg transfers transactions received from the given peer to a channel where
+// handleTxMsg calls AddRemotes to handle them. This is made not to wait from the results
+// from TxPool.AddRemotes.
+func (pool *TxPool) HandleTxMsg(peerID string, txs types.Transactions) {
 	if pool.config.DenyRemoteTx {
 		return
 	}
@@ -1038,12 +1263,15 @@ func (pool *TxPool) HandleTxMsg(txs types.Transactions) {
 	// Filter spam txs based on to-address of failed txs
 	spamThrottler := GetSpamThrottler()
 	if spamThrottler != nil {
+		// Drop txs exceeding the rate limits of the peer and the senders
+		txs = spamThrottler.admitPeerTxs(pool.signer, peerID, txs)
+
 		pool.mu.RLock()
 		poolSize := uint64(len(pool.all))
 		pool.mu.RUnlock()
 
 		// Activate spam throttler when pool has enough txs
-		if poolSize > uint64(spamThrottler.config.ActivateTxPoolSize) {
+		if poolSize > uint64(spamThrottler.GetConfig().ActivateTxPoolSize) {
 			allowTxs, throttleTxs := spamThrottler.classifyTxs(txs)
 
 			for _, tx := range throttleTxs {
@@ -1066,7 +1294,6 @@ func (pool *TxPool) HandleTxMsg(txs types.Transactions) {
 
 func (pool *TxPool) throttleLoop(spamThrottler *throttler) {
 	ticker := time.Tick(time.Second)
-	throttleNum := int(spamThrottler.config.ThrottleTPS)
 
 	for {
 		select {
@@ -1074,8 +1301,11 @@ func (pool *TxPool) throttleLoop(spamThrottler *throttler) {
 			logger.Info("Stop spam throttler loop")
 			return
 
-		case <-ticker:
+		case now := <-ticker:
+			spamThrottler.pruneLimiters(now)
+
 			txs := types.Transactions{}
+			throttleNum := int(spamThrottler.GetConfig().ThrottleTPS)
 
 			iterNum := len(spamThrottler.throttleCh)
 			if iterNum > throttleNum {
@@ -1094,14 +1324,12 @@ func (pool *TxPool) throttleLoop(spamThrottler *throttler) {
 	}
 }
 
+// StartSpamThrottler starts the spam throttler with the given config.
+// If the spam throttler is already running, its config is updated.
 func (pool *TxPool) StartSpamThrottler(conf *ThrottlerConfig) error {
 	spamThrottlerMu.Lock()
 	defer spamThrottlerMu.Unlock()
 
-	if spamThrottler != nil {
-		return errors.New("spam throttler was already running")
-	}
-
 	if conf == nil {
 		conf = DefaultSpamThrottlerConfig
 	}
@@ -1110,15 +1338,24 @@ func (pool *TxPool) StartSpamThrottler(conf *ThrottlerConfig) error {
 		return err
 	}
 
+	if spamThrottler != nil {
+		spamThrottler.setConfig(conf)
+		logger.Info("Update spam throttler", "config", *conf)
+		return nil
+	}
+
 	t := &throttler{
-		config:     conf,
-		candidates: make(map[common.Address]int),
-		throttled:  make(map[common.Address]int),
-		allowed:    make(map[common.Address]bool),
-		mu:         new(sync.RWMutex),
-		threshold:  conf.InitialThreshold,
-		throttleCh: make(chan *types.Transaction, conf.ThrottleTPS*5),
-		quitCh:     make(chan struct{}),
+		config:         conf,
+		candidates:     make(map[common.Address]int),
+		throttled:      make(map[common.Address]int),
+		allowed:        make(map[common.Address]bool),
+		mu:             new(sync.RWMutex),
+		accountLimiter: newTxRateLimiter(conf.AccountTPS, conf.AccountBurst),
+		peerLimiter:    newTxRateLimiter(conf.PeerTPS, conf.PeerBurst),
+		ipLimiter:      newTxRateLimiter(conf.IPTPS, conf.IPBurst),
+		threshold:      conf.InitialThreshold,
+		throttleCh:     make(chan *types.Transaction, conf.ThrottleTPS*5),
+		quitCh:         make(chan struct{}),
 	}
 
 	go pool.throttleLoop(t)
@@ -1142,6 +1379,9 @@ func (pool *TxPool) StopSpamThrottler() {
 	allowedSizeGauge.Update(0)
 	throttlerUpdateTimeGauge.Update(0)
 	throttlerDropCount.Clear()
+	throttlerAccountDropCount.Clear()
+	throttlerPeerDropCount.Clear()
+	throttlerIPDropCount.Clear()
 }
 
 // handleTxMsg calls TxPool.AddRemotes by retrieving transactions from TxPool.txMsgCh.
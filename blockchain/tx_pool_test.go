@@ -444,6 +444,31 @@ func TestInvalidTransactionsMagma(t *testing.T) {
 	}
 }
 
+func TestInvalidTransactionsInitCodeSize(t *testing.T) {
+	t.Parallel()
+
+	cancunConfig := params.TestChainConfig.Copy()
+	cancunConfig.CancunCompatibleBlock = common.Big0
+
+	data := make([]byte, params.MaxInitCodeSize+1)
+	for _, tc := range []struct {
+		config   *params.ChainConfig
+		expected error
+	}{
+		{params.TestChainConfig, ErrOversizedData},
+		{cancunConfig, ErrMaxInitCodeSizeExceeded},
+	} {
+		pool, key := setupTxPoolWithConfig(tc.config)
+
+		tx, _ := types.SignTx(types.NewContractCreation(0, big.NewInt(0), 10000000, big.NewInt(1), data),
+			types.LatestSignerForChainID(params.TestChainConfig.ChainID), key)
+		if err := pool.AddRemote(tx); err != tc.expected {
+			t.Error("expected", tc.expected, "got", err)
+		}
+		pool.Stop()
+	}
+}
+
 func genAnchorTx(nonce uint64) *types.Transaction {
 	key, _ := crypto.HexToECDSA("45a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8")
 	from := crypto.PubkeyToAddress(key.PublicKey)
@@ -1952,6 +1977,82 @@ func testTransactionJournaling(t *testing.T, nolocals bool) {
 	pool.Stop()
 }
 
+// TestTransactionRemoteJournaling tests that remote pending and queued transactions
+// are snapshotted on shutdown and re-injected within the slot limits on start.
+func TestTransactionRemoteJournaling(t *testing.T) {
+	t.Parallel()
+
+	// Create a temporary file for the remote journal
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary journal: %v", err)
+	}
+	journal := file.Name()
+	defer os.Remove(journal)
+
+	// Clean up the temporary file, we only need the path for now
+	file.Close()
+	os.Remove(journal)
+
+	// Create the original pool to inject transaction into the journal
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil)
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.JournalRemotes = true
+	config.RemoteJournal = journal
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	local, _ := crypto.GenerateKey()
+	remote, _ := crypto.GenerateKey()
+
+	testAddBalance(pool, crypto.PubkeyToAddress(local.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(remote.PublicKey), big.NewInt(1000000000))
+
+	// Add a local transaction, and two pending and a queued remote transactions
+	if err := pool.AddLocal(pricedTransaction(0, 100000, big.NewInt(1), local)); err != nil {
+		t.Fatalf("failed to add local transaction: %v", err)
+	}
+	for _, nonce := range []uint64{0, 1, 3} {
+		if err := pool.AddRemote(pricedTransaction(nonce, 100000, big.NewInt(1), remote)); err != nil {
+			t.Fatalf("failed to add remote transaction: %v", err)
+		}
+	}
+	pending, queued := pool.Stats()
+	assert.Equal(t, 3, pending)
+	assert.Equal(t, 1, queued)
+
+	// Terminate the old pool, bump the remote nonce, and ensure only the valid remote transactions survive
+	pool.Stop()
+	statedb.SetNonce(crypto.PubkeyToAddress(remote.PublicKey), 1)
+	blockchain = &testBlockChain{statedb, 1000000, new(event.Feed)}
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	pending, queued = pool.Stats()
+	assert.Equal(t, 1, pending)
+	assert.Equal(t, 1, queued)
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+
+	// Terminate the pool, and ensure the re-injected transactions are bounded by the slot limits
+	pool.Stop()
+	blockchain = &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config.ExecSlotsAll = 1
+	config.NonExecSlotsAll = 0
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	pending, queued = pool.Stats()
+	assert.Equal(t, 1, pending)
+	assert.Equal(t, 0, queued)
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // TestTransactionStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestTransactionStatusCheck(t *testing.T) {
@@ -2734,3 +2835,132 @@ func benchmarkPoolBatchInsert(b *testing.B, size int) {
 		pool.AddRemotes(batch)
 	}
 }
+
+// setupParkingTxPool creates a transaction pool with Magma enabled, whose lower bound
+// base fee is 10 and the current base fee is 30.
+func setupParkingTxPool(config TxPoolConfig) *TxPool {
+	chainConfig := kip71Config.Copy()
+	chainConfig.Governance = &params.GovernanceConfig{KIP71: params.GetDefaultKIP71Config()}
+	chainConfig.Governance.KIP71.LowerBoundBaseFee = 10
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil)
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	pool := NewTxPool(config, chainConfig, blockchain)
+	pool.SetBaseFee(big.NewInt(30))
+	return pool
+}
+
+// TestTransactionParking tests that transactions priced between the lower bound base fee
+// and the current base fee are parked, and re-added to the pool when the base fee falls.
+func TestTransactionParking(t *testing.T) {
+	t.Parallel()
+
+	pool := setupParkingTxPool(testTxPoolConfig)
+	defer pool.Stop()
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	// Transactions priced below the lower bound base fee are rejected as before
+	assert.Equal(t, ErrGasPriceBelowBaseFee, pool.AddRemote(pricedTransaction(0, 100000, big.NewInt(5), key)))
+	assert.Equal(t, ErrFeeCapBelowBaseFee, pool.AddRemote(dynamicFeeTx(0, 100000, big.NewInt(5), big.NewInt(1), key)))
+
+	// Transactions priced between the lower bound and the base fee are parked
+	txs := types.Transactions{
+		pricedTransaction(0, 100000, big.NewInt(20), key),
+		dynamicFeeTx(1, 100000, big.NewInt(25), big.NewInt(1), key),
+		pricedTransaction(2, 100000, big.NewInt(15), key),
+	}
+	for _, tx := range txs {
+		assert.NoError(t, pool.AddRemote(tx))
+	}
+	pending, queued := pool.Stats()
+	assert.Equal(t, 0, pending)
+	assert.Equal(t, 0, queued)
+	assert.Equal(t, map[common.Address]types.Transactions{from: txs}, pool.Parked())
+
+	// A known parked transaction and a cheaper replacement are rejected
+	assert.Error(t, pool.AddRemote(txs[0]))
+	assert.Equal(t, ErrGasPriceBelowBaseFee, pool.AddRemote(pricedTransaction(0, 100000, big.NewInt(19), key)))
+
+	// A more expensive replacement replaces the parked transaction
+	replacement := pricedTransaction(0, 100000, big.NewInt(21), key)
+	assert.NoError(t, pool.AddRemote(replacement))
+	assert.Equal(t, 3, pool.parked.Len())
+	assert.False(t, pool.parked.Contains(txs[0].Hash()))
+
+	// The transactions priced above the fallen base fee are re-added to the pool
+	pool.mu.Lock()
+	pool.SetBaseFee(big.NewInt(20))
+	pool.unparkTxs()
+	pool.mu.Unlock()
+
+	pending, queued = pool.Stats()
+	assert.Equal(t, 2, pending)
+	assert.Equal(t, 0, queued)
+	assert.Equal(t, 1, pool.parked.Len())
+	assert.True(t, pool.parked.Contains(txs[2].Hash()))
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+
+	// The parked transactions whose nonce is too low are dropped
+	testSetNonce(pool, from, 3)
+	pool.mu.Lock()
+	pool.unparkTxs()
+	pool.mu.Unlock()
+	assert.Equal(t, 0, pool.parked.Len())
+}
+
+// TestTransactionParkingLimiting tests that the parked transactions are limited by
+// the slots and the lifetime of the parked pool.
+func TestTransactionParkingLimiting(t *testing.T) {
+	t.Parallel()
+
+	config := testTxPoolConfig
+	config.ParkedSlotsAccount = 2
+	config.ParkedSlotsAll = 3
+
+	pool := setupParkingTxPool(config)
+	defer pool.Stop()
+
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key1.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(key2.PublicKey), big.NewInt(1000000000))
+
+	// The number of the parked transactions of an account is limited
+	assert.NoError(t, pool.AddRemote(pricedTransaction(0, 100000, big.NewInt(20), key1)))
+	assert.NoError(t, pool.AddRemote(pricedTransaction(1, 100000, big.NewInt(20), key1)))
+	assert.Equal(t, ErrGasPriceBelowBaseFee, pool.AddRemote(pricedTransaction(2, 100000, big.NewInt(20), key1)))
+
+	// The cheapest parked transaction is discarded for a more expensive one if all slots are used
+	cheap := pricedTransaction(0, 100000, big.NewInt(12), key2)
+	assert.NoError(t, pool.AddRemote(cheap))
+	assert.Equal(t, ErrGasPriceBelowBaseFee, pool.AddRemote(pricedTransaction(1, 100000, big.NewInt(11), key2)))
+
+	expensive := pricedTransaction(1, 100000, big.NewInt(25), key2)
+	assert.NoError(t, pool.AddRemote(expensive))
+	assert.Equal(t, 3, pool.parked.Len())
+	assert.False(t, pool.parked.Contains(cheap.Hash()))
+	assert.True(t, pool.parked.Contains(expensive.Hash()))
+
+	// The parked transactions are evicted after the lifetime
+	pool.mu.Lock()
+	pool.evictParked(time.Now().Add(config.ParkedLifetime / 2))
+	assert.Equal(t, 3, pool.parked.Len())
+	pool.evictParked(time.Now().Add(config.ParkedLifetime + time.Second))
+	assert.Equal(t, 0, pool.parked.Len())
+	pool.mu.Unlock()
+
+	// Parking is disabled if there is no parked transaction slot
+	config.ParkedSlotsAll = 0
+	disabled := setupParkingTxPool(config)
+	defer disabled.Stop()
+
+	testAddBalance(disabled, crypto.PubkeyToAddress(key1.PublicKey), big.NewInt(1000000000))
+	assert.Equal(t, ErrGasPriceBelowBaseFee, disabled.AddRemote(pricedTransaction(0, 100000, big.NewInt(20), key1)))
+	assert.Equal(t, 0, disabled.parked.Len())
+}
@@ -593,14 +593,15 @@ func TestIntrinsicGas(t *testing.T) {
 		expectGas2  uint64 // contractCreate - false, isIstanbul - true
 		expectGas3  uint64 // contractCreate - true,  isIstanbul - false
 		expectGas4  uint64 // contractCreate - true,  isIstanbul - true
+		expectGas5  uint64 // contractCreate - true,  isIstanbul - true, isCancun - true
 	}{
-		{"0000", 21008, 21200, 53008, 53200},
-		{"1000", 21072, 21200, 53072, 53200},
-		{"0100", 21072, 21200, 53072, 53200},
-		{"ff3d", 21136, 21200, 53136, 53200},
-		{"0000a6bc", 21144, 21400, 53144, 53400},
-		{"fd00fd00", 21144, 21400, 53144, 53400},
-		{"", 21000, 21000, 53000, 53000},
+		{"0000", 21008, 21200, 53008, 53200, 53202},
+		{"1000", 21072, 21200, 53072, 53200, 53202},
+		{"0100", 21072, 21200, 53072, 53200, 53202},
+		{"ff3d", 21136, 21200, 53136, 53200, 53202},
+		{"0000a6bc", 21144, 21400, 53144, 53400, 53402},
+		{"fd00fd00", 21144, 21400, 53144, 53400, 53402},
+		{"", 21000, 21000, 53000, 53000, 53000},
 	}
 	for _, tc := range testData {
 		var (
@@ -627,6 +628,15 @@ func TestIntrinsicGas(t *testing.T) {
 		gas, err = IntrinsicGas(data, nil, true, params.Rules{IsIstanbul: true})
 		assert.Equal(t, tc.expectGas4, gas)
 		assert.Equal(t, nil, err)
+
+		gas, err = IntrinsicGas(data, nil, true, params.Rules{IsIstanbul: true, IsCancun: true})
+		assert.Equal(t, tc.expectGas5, gas)
+		assert.Equal(t, nil, err)
+
+		// The init code is charged only for contract creation
+		gas, err = IntrinsicGas(data, nil, false, params.Rules{IsIstanbul: true, IsCancun: true})
+		assert.Equal(t, tc.expectGas2, gas)
+		assert.Equal(t, nil, err)
 	}
 }
 
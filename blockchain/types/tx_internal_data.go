@@ -577,6 +577,19 @@ func IntrinsicGasPayloadLegacy(gas uint64, data []byte) (uint64, error) {
 	return gas, nil
 }
 
+// IntrinsicGasInitCode charges the gas for every word of the init code of a contract creation
+// since the Cancun hardfork (EIP-3860).
+func IntrinsicGasInitCode(gas uint64, data []byte, r params.Rules) (uint64, error) {
+	if !r.IsCancun {
+		return gas, nil
+	}
+	words := (uint64(len(data)) + 31) / 32
+	if (math.MaxUint64-gas)/params.InitCodeWordGas < words {
+		return 0, kerrors.ErrOutOfGas
+	}
+	return gas + words*params.InitCodeWordGas, nil
+}
+
 // IntrinsicGas computes the 'intrinsic gas' for a message with the given data.
 func IntrinsicGas(data []byte, accessList AccessList, contractCreation bool, r params.Rules) (uint64, error) {
 	// Set the starting gas for the raw transaction
@@ -599,6 +612,12 @@ func IntrinsicGas(data []byte, accessList AccessList, contractCreation bool, r p
 		return 0, err
 	}
 
+	if contractCreation {
+		if gasPayloadWithGas, err = IntrinsicGasInitCode(gasPayloadWithGas, data, r); err != nil {
+			return 0, err
+		}
+	}
+
 	// We charge additional gas for the accessList:
 	// ACCESS_LIST_ADDRESS_COST : gas per address in AccessList
 	// ACCESS_LIST_STORAGE_KEY_COST : gas per storage key in AccessList
@@ -28,6 +28,7 @@ import (
 	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/crypto/sha3"
+	"github.com/klaytn/klaytn/fork"
 	"github.com/klaytn/klaytn/kerrors"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
@@ -332,7 +333,7 @@ func (t *TxInternalDataFeeDelegatedSmartContractDeployWithRatio) IntrinsicGas(cu
 		return 0, err
 	}
 
-	return gasPayloadWithGas, nil
+	return IntrinsicGasInitCode(gasPayloadWithGas, t.Payload, *fork.Rules(big.NewInt(int64(currentBlockNumber))))
 }
 
 func (t *TxInternalDataFeeDelegatedSmartContractDeployWithRatio) SerializeForSignToBytes() []byte {
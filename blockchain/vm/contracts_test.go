@@ -34,6 +34,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/kerrors"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
 	"github.com/stretchr/testify/assert"
@@ -104,8 +105,8 @@ func prepare(reqGas uint64) (*Contract, *EVM, error) {
 	return contract, evm, err
 }
 
-func testPrecompiled(addr string, test precompiledTest, t *testing.T) {
-	p := PrecompiledContractsIstanbul[common.HexToAddress(addr)]
+func testPrecompiled(precompiles map[common.Address]PrecompiledContract, addr string, test precompiledTest, t *testing.T) {
+	p := precompiles[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.Input)
 	reqGas, _ := p.GetRequiredGasAndComputationCost(in)
 
@@ -121,8 +122,8 @@ func testPrecompiled(addr string, test precompiledTest, t *testing.T) {
 	})
 }
 
-func testPrecompiledOOG(addr string, test precompiledTest, t *testing.T) {
-	p := PrecompiledContractsIstanbul[common.HexToAddress(addr)]
+func testPrecompiledOOG(precompiles map[common.Address]PrecompiledContract, addr string, test precompiledTest, t *testing.T) {
+	p := precompiles[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.Input)
 	reqGas, _ := p.GetRequiredGasAndComputationCost(in)
 	reqGas -= 1
@@ -142,8 +143,8 @@ func testPrecompiledOOG(addr string, test precompiledTest, t *testing.T) {
 	})
 }
 
-func testPrecompiledFailure(addr string, test precompiledFailureTest, t *testing.T) {
-	p := PrecompiledContractsIstanbul[common.HexToAddress(addr)]
+func testPrecompiledFailure(precompiles map[common.Address]PrecompiledContract, addr string, test precompiledFailureTest, t *testing.T) {
+	p := precompiles[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.Input)
 	reqGas, _ := p.GetRequiredGasAndComputationCost(in)
 
@@ -162,11 +163,11 @@ func testPrecompiledFailure(addr string, test precompiledFailureTest, t *testing
 	})
 }
 
-func benchmarkPrecompiled(addr string, test precompiledTest, bench *testing.B) {
+func benchmarkPrecompiled(precompiles map[common.Address]PrecompiledContract, addr string, test precompiledTest, bench *testing.B) {
 	if test.NoBenchmark {
 		return
 	}
-	p := PrecompiledContractsIstanbul[common.HexToAddress(addr)]
+	p := precompiles[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.Input)
 	reqGas, _ := p.GetRequiredGasAndComputationCost(in)
 
@@ -199,49 +200,179 @@ func benchmarkPrecompiled(addr string, test precompiledTest, bench *testing.B) {
 }
 
 // Tests the sample inputs of the ecrecover
-func TestPrecompiledEcrecover(t *testing.T)      { testJson("ecRecover", "01", t) }
-func BenchmarkPrecompiledEcrecover(b *testing.B) { benchJson("ecRecover", "01", b) }
+func TestPrecompiledEcrecover(t *testing.T) {
+	testJson(PrecompiledContractsIstanbul, "ecRecover", "01", t)
+}
+func BenchmarkPrecompiledEcrecover(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "ecRecover", "01", b)
+}
 
 // Benchmarks the sample inputs from the SHA256 precompile.
-func BenchmarkPrecompiledSha256(b *testing.B) { benchJson("sha256", "02", b) }
+func BenchmarkPrecompiledSha256(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "sha256", "02", b)
+}
 
 // Benchmarks the sample inputs from the RIPEMD precompile.
-func BenchmarkPrecompiledRipeMD(b *testing.B) { benchJson("ripeMD", "03", b) }
+func BenchmarkPrecompiledRipeMD(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "ripeMD", "03", b)
+}
 
 // Benchmarks the sample inputs from the identity precompile.
-func BenchmarkPrecompiledIdentity(b *testing.B) { benchJson("identity", "04", b) }
+func BenchmarkPrecompiledIdentity(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "identity", "04", b)
+}
 
 // Tests the sample inputs from the ModExp EIP 198.
-func TestPrecompiledModExp(t *testing.T)      { testJson("modexp", "05", t) }
-func BenchmarkPrecompiledModExp(b *testing.B) { benchJson("modexp", "05", b) }
+func TestPrecompiledModExp(t *testing.T) { testJson(PrecompiledContractsIstanbul, "modexp", "05", t) }
+func BenchmarkPrecompiledModExp(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "modexp", "05", b)
+}
 
 // Tests the sample inputs from the elliptic curve addition EIP 213.
-func TestPrecompiledBn256Add(t *testing.T)      { testJson("bn256Add", "06", t) }
-func BenchmarkPrecompiledBn256Add(b *testing.B) { benchJson("bn256Add", "06", b) }
+func TestPrecompiledBn256Add(t *testing.T) {
+	testJson(PrecompiledContractsIstanbul, "bn256Add", "06", t)
+}
+func BenchmarkPrecompiledBn256Add(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "bn256Add", "06", b)
+}
 
 // Tests the sample inputs from the elliptic curve scalar multiplication EIP 213.
-func TestPrecompiledBn256ScalarMul(t *testing.T)      { testJson("bn256ScalarMul", "07", t) }
-func BenchmarkPrecompiledBn256ScalarMul(b *testing.B) { benchJson("bn256ScalarMul", "07", b) }
+func TestPrecompiledBn256ScalarMul(t *testing.T) {
+	testJson(PrecompiledContractsIstanbul, "bn256ScalarMul", "07", t)
+}
+func BenchmarkPrecompiledBn256ScalarMul(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "bn256ScalarMul", "07", b)
+}
 
 // Tests the sample inputs from the elliptic curve pairing check EIP 197.
-func TestPrecompiledBn256Pairing(t *testing.T)      { testJson("bn256Pairing", "08", t) }
-func BenchmarkPrecompiledBn256Pairing(b *testing.B) { benchJson("bn256Pairing", "08", b) }
+func TestPrecompiledBn256Pairing(t *testing.T) {
+	testJson(PrecompiledContractsIstanbul, "bn256Pairing", "08", t)
+}
+func BenchmarkPrecompiledBn256Pairing(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "bn256Pairing", "08", b)
+}
+
+func TestPrecompiledBlake2F(t *testing.T) { testJson(PrecompiledContractsIstanbul, "blake2F", "09", t) }
+func BenchmarkPrecompiledBlake2F(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "blake2F", "09", b)
+}
+func TestPrecompileBlake2FMalformedInput(t *testing.T) {
+	testJsonFail(PrecompiledContractsIstanbul, "blake2F", "09", t)
+}
+
+// Tests the sample inputs of the p256Verify
+func TestPrecompiledP256Verify(t *testing.T) {
+	testJson(PrecompiledContractsRIP7212, "p256Verify", "100", t)
+}
+func BenchmarkPrecompiledP256Verify(b *testing.B) {
+	benchJson(PrecompiledContractsRIP7212, "p256Verify", "100", b)
+}
+
+// Tests the sample inputs of the BLS12-381 precompiled contracts of EIP-2537.
+// The inputs of the blsG1Mul and blsG2Mul are the multi-scalar multiplications of a single pair.
+func TestPrecompiledBLS12381G1Add(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsG1Add", "0b", t)
+}
+func TestPrecompiledBLS12381G1Mul(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsG1Mul", "0c", t)
+}
+func TestPrecompiledBLS12381G1MultiExp(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsG1MultiExp", "0c", t)
+}
+func TestPrecompiledBLS12381G2Add(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsG2Add", "0d", t)
+}
+func TestPrecompiledBLS12381G2Mul(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsG2Mul", "0e", t)
+}
+func TestPrecompiledBLS12381G2MultiExp(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsG2MultiExp", "0e", t)
+}
+func TestPrecompiledBLS12381Pairing(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsPairing", "0f", t)
+}
+func TestPrecompiledBLS12381MapG1(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsMapG1", "10", t)
+}
+func TestPrecompiledBLS12381MapG2(t *testing.T) {
+	testJson(PrecompiledContractsPrague, "blsMapG2", "11", t)
+}
+
+func BenchmarkPrecompiledBLS12381G1Add(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsG1Add", "0b", b)
+}
+func BenchmarkPrecompiledBLS12381G1Mul(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsG1Mul", "0c", b)
+}
+func BenchmarkPrecompiledBLS12381G1MultiExp(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsG1MultiExp", "0c", b)
+}
+func BenchmarkPrecompiledBLS12381G2Add(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsG2Add", "0d", b)
+}
+func BenchmarkPrecompiledBLS12381G2Mul(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsG2Mul", "0e", b)
+}
+func BenchmarkPrecompiledBLS12381G2MultiExp(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsG2MultiExp", "0e", b)
+}
+func BenchmarkPrecompiledBLS12381Pairing(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsPairing", "0f", b)
+}
+func BenchmarkPrecompiledBLS12381MapG1(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsMapG1", "10", b)
+}
+func BenchmarkPrecompiledBLS12381MapG2(b *testing.B) {
+	benchJson(PrecompiledContractsPrague, "blsMapG2", "11", b)
+}
 
-func TestPrecompiledBlake2F(t *testing.T)              { testJson("blake2F", "09", t) }
-func BenchmarkPrecompiledBlake2F(b *testing.B)         { benchJson("blake2F", "09", b) }
-func TestPrecompileBlake2FMalformedInput(t *testing.T) { testJsonFail("blake2F", "09", t) }
+func TestPrecompiledBLS12381G1AddFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsG1Add", "0b", t)
+}
+func TestPrecompiledBLS12381G1MulFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsG1Mul", "0c", t)
+}
+func TestPrecompiledBLS12381G1MultiExpFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsG1MultiExp", "0c", t)
+}
+func TestPrecompiledBLS12381G2AddFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsG2Add", "0d", t)
+}
+func TestPrecompiledBLS12381G2MulFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsG2Mul", "0e", t)
+}
+func TestPrecompiledBLS12381G2MultiExpFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsG2MultiExp", "0e", t)
+}
+func TestPrecompiledBLS12381PairingFail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsPairing", "0f", t)
+}
+func TestPrecompiledBLS12381MapG1Fail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsMapG1", "10", t)
+}
+func TestPrecompiledBLS12381MapG2Fail(t *testing.T) {
+	testJsonFail(PrecompiledContractsPrague, "blsMapG2", "11", t)
+}
 
 // Tests the sample inputs of the vmLog
-func TestPrecompiledVmLog(t *testing.T)      { testJson("vmLog", "3fd", t) }
-func BenchmarkPrecompiledVmLog(b *testing.B) { benchJson("vmLog", "3fd", b) }
+func TestPrecompiledVmLog(t *testing.T) { testJson(PrecompiledContractsIstanbul, "vmLog", "3fd", t) }
+func BenchmarkPrecompiledVmLog(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "vmLog", "3fd", b)
+}
 
 // Tests the sample inputs of the feePayer
-func TestFeePayerContract(t *testing.T)         { testJson("feePayer", "3fe", t) }
-func BenchmarkPrecompiledFeePayer(b *testing.B) { benchJson("feePayer", "3fe", b) }
+func TestFeePayerContract(t *testing.T) { testJson(PrecompiledContractsIstanbul, "feePayer", "3fe", t) }
+func BenchmarkPrecompiledFeePayer(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "feePayer", "3fe", b)
+}
 
 // Tests the sample inputs of the validateSender
-func TestValidateSenderContract(t *testing.T)         { testJson("validateSender", "3ff", t) }
-func BenchmarkPrecompiledValidateSender(b *testing.B) { benchJson("validateSender", "3ff", b) }
+func TestValidateSenderContract(t *testing.T) {
+	testJson(PrecompiledContractsIstanbul, "validateSender", "3ff", t)
+}
+func BenchmarkPrecompiledValidateSender(b *testing.B) {
+	benchJson(PrecompiledContractsIstanbul, "validateSender", "3ff", b)
+}
 
 // Tests OOG (out-of-gas) of modExp
 func TestPrecompiledModExpOOG(t *testing.T) {
@@ -250,7 +381,7 @@ func TestPrecompiledModExpOOG(t *testing.T) {
 		t.Fatal(err)
 	}
 	for _, test := range modexpTests {
-		testPrecompiledOOG("05", test, t)
+		testPrecompiledOOG(PrecompiledContractsIstanbul, "05", test, t)
 	}
 }
 
@@ -274,33 +405,33 @@ func loadJsonFail(name string) ([]precompiledFailureTest, error) {
 	return testcases, err
 }
 
-func testJson(name, addr string, t *testing.T) {
+func testJson(precompiles map[common.Address]PrecompiledContract, name, addr string, t *testing.T) {
 	tests, err := loadJson(name)
 	if err != nil {
 		t.Fatal(err)
 	}
 	for _, test := range tests {
-		testPrecompiled(addr, test, t)
+		testPrecompiled(precompiles, addr, test, t)
 	}
 }
 
-func testJsonFail(name, addr string, t *testing.T) {
+func testJsonFail(precompiles map[common.Address]PrecompiledContract, name, addr string, t *testing.T) {
 	tests, err := loadJsonFail(name)
 	if err != nil {
 		t.Fatal(err)
 	}
 	for _, test := range tests {
-		testPrecompiledFailure(addr, test, t)
+		testPrecompiledFailure(precompiles, addr, test, t)
 	}
 }
 
-func benchJson(name, addr string, b *testing.B) {
+func benchJson(precompiles map[common.Address]PrecompiledContract, name, addr string, b *testing.B) {
 	tests, err := loadJson(name)
 	if err != nil {
 		b.Fatal(err)
 	}
 	for _, test := range tests {
-		benchmarkPrecompiled(addr, test, b)
+		benchmarkPrecompiled(precompiles, addr, test, b)
 	}
 }
 
@@ -396,3 +527,72 @@ func TestEVM_CVE_2021_39137(t *testing.T) {
 		assert.Equal(t, tc.expectedResult, ret[12:32])
 	}
 }
+
+// newPrecompileTestEVM returns an EVM of the given hardfork configuration at the genesis block.
+func newPrecompileTestEVM(t *testing.T, config *params.ChainConfig) *EVM {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil)
+	require.NoError(t, err)
+
+	vmctx := Context{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(0),
+	}
+	return NewEVM(vmctx, statedb, config, &Config{})
+}
+
+// TestP256VerifyHardFork checks that the p256Verify precompiled contract is only available
+// after the rip7212Compatible hardfork.
+func TestP256VerifyHardFork(t *testing.T) {
+	var (
+		addr   = common.BytesToAddress([]byte{1, 0})
+		input  = common.Hex2Bytes("4cee90eb86eaa050036147a12d49004b6b9c72bd725d39d4785011fe190f0b4da73bd4903f0ce3b639bbbf6e8e80d16931ff4bcf5993d58468e8fb19086e8cac36dbcd03009df8c59286b162af3bd7fcc0450c9aa81be5d10d312af6c66b1d604aebd3099c618202fcfe16ae7770b0c49ab5eadf74b754204a3bb6060e44eff37618b065f9832de4ca6ca971a7a1adc826d0f7c00181a5fb2ddf79ae00b4e10e")
+		caller = common.BytesToAddress([]byte("caller"))
+		config = &params.ChainConfig{
+			ChainID:                 big.NewInt(1),
+			IstanbulCompatibleBlock: big.NewInt(0),
+			LondonCompatibleBlock:   big.NewInt(0),
+			CancunCompatibleBlock:   big.NewInt(0),
+		}
+	)
+
+	_, _, err := newPrecompileTestEVM(t, config).Call(AccountRef(caller), addr, input, 100000, new(big.Int))
+	assert.Equal(t, kerrors.ErrPrecompiledContractAddress, err)
+
+	config.RIP7212CompatibleBlock = big.NewInt(0)
+	ret, gas, err := newPrecompileTestEVM(t, config).Call(AccountRef(caller), addr, input, 100000, new(big.Int))
+	require.NoError(t, err)
+	assert.Equal(t, common.LeftPadBytes([]byte{1}, 32), ret)
+	assert.Equal(t, params.P256VerifyGas, 100000-gas)
+}
+
+// TestBLS12381HardFork checks that the BLS12-381 precompiled contracts are only available
+// after the pragueCompatible hardfork.
+func TestBLS12381HardFork(t *testing.T) {
+	var (
+		addr   = common.BytesToAddress([]byte{11})
+		input  = make([]byte, 256) // G1 point at infinity + G1 point at infinity
+		caller = common.BytesToAddress([]byte("caller"))
+		config = &params.ChainConfig{
+			ChainID:                 big.NewInt(1),
+			IstanbulCompatibleBlock: big.NewInt(0),
+			LondonCompatibleBlock:   big.NewInt(0),
+			CancunCompatibleBlock:   big.NewInt(0),
+			RIP7212CompatibleBlock:  big.NewInt(0),
+		}
+	)
+
+	_, _, err := newPrecompileTestEVM(t, config).Call(AccountRef(caller), addr, input, 100000, new(big.Int))
+	assert.Equal(t, kerrors.ErrPrecompiledContractAddress, err)
+
+	config.PragueCompatibleBlock = big.NewInt(0)
+	ret, gas, err := newPrecompileTestEVM(t, config).Call(AccountRef(caller), addr, input, 100000, new(big.Int))
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 128), ret)
+	assert.Equal(t, params.Bls12381G1AddGas, 100000-gas)
+
+	// A failed call consumes all the given gas
+	_, gas, err = newPrecompileTestEVM(t, config).Call(AccountRef(caller), addr, input[:255], 100000, new(big.Int))
+	assert.Equal(t, errBLS12381InvalidInputLength, err)
+	assert.Equal(t, uint64(0), gas)
+}
@@ -19,6 +19,7 @@ package vm
 import (
 	"fmt"
 
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/params"
 )
 
@@ -33,6 +34,14 @@ func EnableEIP(eipNum int, jt *JumpTable) error {
 		enable1884(jt)
 	case 1344:
 		enable1344(jt)
+	case 3855:
+		enable3855(jt)
+	case 3860:
+		enable3860(jt)
+	case 1153:
+		enable1153(jt)
+	case 5656:
+		enable5656(jt)
 	default:
 		return fmt.Errorf("undefined eip %d", eipNum)
 	}
@@ -123,3 +132,98 @@ func opBaseFee(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *
 	stack.push(baseFee)
 	return nil, nil
 }
+
+// enable3855 applies EIP-3855 (PUSH0 opcode)
+// - Adds an opcode that pushes the constant value 0 onto the stack.
+func enable3855(jt *JumpTable) {
+	// New opcode
+	jt[PUSH0] = &operation{
+		execute:         opPush0,
+		constantGas:     GasQuickStep,
+		minStack:        minStack(0, 1),
+		maxStack:        maxStack(0, 1),
+		computationCost: params.Push0ComputationCost,
+	}
+}
+
+// opPush0 implements the PUSH0 opcode
+func opPush0(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	stack.push(evm.interpreter.intPool.getZero())
+	return nil, nil
+}
+
+// enable3860 applies EIP-3860 (Limit and meter initcode)
+// - Limits the size of the initcode of CREATE and CREATE2 and charges for every word of it.
+// The limit and the charge of creation transactions are handled by the transaction processing.
+func enable3860(jt *JumpTable) {
+	jt[CREATE].dynamicGas = gasCreateEip3860
+	jt[CREATE2].dynamicGas = gasCreate2Eip3860
+}
+
+// enable1153 applies EIP-1153 (Transient storage opcodes)
+// - Adds TLOAD and TSTORE which read and write the storage discarded at the end of a transaction.
+func enable1153(jt *JumpTable) {
+	// New opcodes
+	jt[TLOAD] = &operation{
+		execute:         opTload,
+		constantGas:     params.TloadGas,
+		minStack:        minStack(1, 1),
+		maxStack:        maxStack(1, 1),
+		computationCost: params.TloadComputationCost,
+	}
+	jt[TSTORE] = &operation{
+		execute:         opTstore,
+		constantGas:     params.TstoreGas,
+		minStack:        minStack(2, 0),
+		maxStack:        maxStack(2, 0),
+		writes:          true,
+		computationCost: params.TstoreComputationCost,
+	}
+}
+
+// opTload implements the TLOAD opcode
+func opTload(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	loc := stack.Peek()
+	val := evm.StateDB.GetTransientState(contract.Address(), common.BigToHash(loc))
+	loc.SetBytes(val.Bytes())
+	return nil, nil
+}
+
+// opTstore implements the TSTORE opcode
+func opTstore(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	loc := stack.pop()
+	val := stack.pop()
+	evm.StateDB.SetTransientState(contract.Address(), common.BigToHash(loc), common.BigToHash(val))
+
+	evm.interpreter.intPool.put(loc, val)
+	return nil, nil
+}
+
+// enable5656 applies EIP-5656 (MCOPY opcode)
+// - Adds an opcode that copies an area of the memory to another, possibly overlapping, area.
+func enable5656(jt *JumpTable) {
+	// New opcode
+	jt[MCOPY] = &operation{
+		execute:         opMcopy,
+		constantGas:     GasFastestStep,
+		dynamicGas:      gasMcopy,
+		minStack:        minStack(3, 0),
+		maxStack:        maxStack(3, 0),
+		memorySize:      memoryMcopy,
+		computationCost: params.McopyComputationCost,
+	}
+}
+
+// opMcopy implements the MCOPY opcode
+func opMcopy(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	var (
+		dst    = stack.pop()
+		src    = stack.pop()
+		length = stack.pop()
+	)
+	// The memory has already been expanded to fit both areas
+	memory.Copy(dst.Uint64(), src.Uint64(), length.Uint64())
+
+	evm.interpreter.intPool.put(dst, src, length)
+	return nil, nil
+}
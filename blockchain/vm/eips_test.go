@@ -0,0 +1,185 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCancunTestEVM returns an EVM running the given code at the contract address.
+func newCancunTestEVM(t *testing.T, cancun bool, code string) (*EVM, common.Address) {
+	address := common.BytesToAddress([]byte("contract"))
+
+	config := &params.ChainConfig{
+		ChainID:                 big.NewInt(1),
+		IstanbulCompatibleBlock: big.NewInt(0),
+		LondonCompatibleBlock:   big.NewInt(0),
+	}
+	if cancun {
+		config.CancunCompatibleBlock = big.NewInt(0)
+	}
+
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil)
+	require.NoError(t, err)
+	statedb.CreateSmartContractAccount(address, params.CodeFormatEVM, config.Rules(big.NewInt(0)))
+	statedb.SetCode(address, hexutil.MustDecode(code))
+
+	vmctx := Context{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(0),
+	}
+	return NewEVM(vmctx, statedb, config, &Config{}), address
+}
+
+func TestEIP3855(t *testing.T) {
+	// PUSH1 1, PUSH0, MSTORE, PUSH1 32, PUSH0, RETURN
+	code := "0x60015f5260205ff3"
+
+	vmenv, address := newCancunTestEVM(t, false, code)
+	_, _, err := vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+	assert.Error(t, err, "PUSH0 should be an invalid opcode before the cancun hardfork")
+
+	vmenv, address = newCancunTestEVM(t, true, code)
+	ret, gas, err := vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	assert.Equal(t, common.BigToHash(common.Big1).Bytes(), ret)
+	assert.Equal(t, uint64(16), 100000-gas)
+}
+
+func TestEIP1153(t *testing.T) {
+	// PUSH1 2, PUSH1 1, TSTORE, PUSH1 1, TLOAD, PUSH0, MSTORE, PUSH1 32, PUSH0, RETURN
+	code := "0x600260015d60015c5f5260205ff3"
+
+	vmenv, address := newCancunTestEVM(t, false, code)
+	_, _, err := vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+	assert.Error(t, err, "TSTORE should be an invalid opcode before the cancun hardfork")
+
+	vmenv, address = newCancunTestEVM(t, true, code)
+	ret, gas, err := vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(2)).Bytes(), ret)
+	assert.Equal(t, uint64(3+3+params.TstoreGas+3+params.TloadGas+2+6+3+2), 100000-gas)
+
+	// The transient storage is kept until it is reset at the end of the transaction
+	key := common.BigToHash(common.Big1)
+	assert.Equal(t, common.BigToHash(big.NewInt(2)), vmenv.StateDB.GetTransientState(address, key))
+	assert.Equal(t, common.Hash{}, vmenv.StateDB.GetState(address, key))
+	vmenv.StateDB.ResetTransientStorage()
+	assert.Equal(t, common.Hash{}, vmenv.StateDB.GetTransientState(address, key))
+
+	// TSTORE is not allowed in a static call
+	_, _, err = vmenv.StaticCall(AccountRef(common.Address{}), address, nil, 100000)
+	assert.Equal(t, ErrWriteProtection, err)
+
+	// TSTORE is reverted with the call
+	// PUSH1 2, PUSH1 1, TSTORE, PUSH0, PUSH0, REVERT
+	vmenv, address = newCancunTestEVM(t, true, "0x600260015d5f5ffd")
+	_, _, err = vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+	assert.Equal(t, ErrExecutionReverted, err)
+	assert.Equal(t, common.Hash{}, vmenv.StateDB.GetTransientState(address, key))
+}
+
+func TestEIP5656(t *testing.T) {
+	// The test vectors of EIP-5656
+	tests := []struct {
+		dst, src, length uint64
+		pre, post        string
+	}{
+		{
+			0, 32, 32,
+			"0000000000000000000000000000000000000000000000000000000000000000000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		},
+		{
+			0, 0, 32,
+			"0101010101010101010101010101010101010101010101010101010101010101",
+			"0101010101010101010101010101010101010101010101010101010101010101",
+		},
+		{
+			0, 1, 8,
+			"000102030405060708000000000000000000000000000000000000000000000000",
+			"010203040506070808000000000000000000000000000000000000000000000000",
+		},
+		{
+			1, 0, 8,
+			"000102030405060708000000000000000000000000000000000000000000000000",
+			"000001020304050607000000000000000000000000000000000000000000000000",
+		},
+	}
+	var (
+		env            = NewEVM(Context{}, nil, params.TestChainConfig, &Config{})
+		evmInterpreter = NewEVMInterpreter(env, env.vmConfig)
+		pc             = uint64(0)
+	)
+	env.interpreter = evmInterpreter
+	evmInterpreter.intPool = poolOfIntPools.get()
+	defer poolOfIntPools.put(evmInterpreter.intPool)
+
+	for i, tt := range tests {
+		stack := newstack()
+		mem := NewMemory()
+		pre := common.Hex2Bytes(tt.pre)
+		mem.Resize(uint64(len(pre)))
+		mem.Set(0, uint64(len(pre)), pre)
+
+		stack.pushN(new(big.Int).SetUint64(tt.length), new(big.Int).SetUint64(tt.src), new(big.Int).SetUint64(tt.dst))
+		opMcopy(&pc, env, nil, mem, stack)
+		assert.Equal(t, tt.post, common.Bytes2Hex(mem.Data()), "test %d", i)
+	}
+
+	// The memory is expanded to fit the destination
+	// PUSH1 32, PUSH1 0, PUSH1 32, MCOPY
+	vmenv, address := newCancunTestEVM(t, true, "0x6020600060205e")
+	_, gas, err := vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3+3+3+3+6+3), 100000-gas)
+}
+
+func TestEIP3860(t *testing.T) {
+	tests := []struct {
+		size      uint64
+		create    uint64
+		create2   uint64
+		overflown bool
+	}{
+		{0, 0, 0, false},
+		{1, params.InitCodeWordGas, params.InitCodeWordGas + params.Sha3WordGas, false},
+		{params.MaxInitCodeSize, 1536 * params.InitCodeWordGas, 1536 * (params.InitCodeWordGas + params.Sha3WordGas), false},
+		{params.MaxInitCodeSize + 1, 0, 0, true},
+	}
+	for i, tt := range tests {
+		stack := newstack()
+		stack.pushN(new(big.Int).SetUint64(tt.size), new(big.Int), new(big.Int))
+		gas, err := gasCreateEip3860(nil, nil, stack, NewMemory(), 0)
+		assert.Equal(t, tt.overflown, err == errGasUintOverflow, "test %d", i)
+		assert.Equal(t, tt.create, gas, "test %d", i)
+
+		gas, err = gasCreate2Eip3860(nil, nil, stack, NewMemory(), 0)
+		assert.Equal(t, tt.overflown, err == errGasUintOverflow, "test %d", i)
+		assert.Equal(t, tt.create2, gas, "test %d", i)
+	}
+}
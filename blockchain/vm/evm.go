@@ -228,7 +228,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 		if precompiles[addr] == nil || value.Sign() != 0 {
 			// Return an error if an enabled precompiled address is called or a value is transferred to a precompiled address.
 			if evm.vmConfig.Debug && evm.depth == 0 {
-				evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
+				evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 				evm.vmConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
 			}
 			return nil, gas, kerrors.ErrPrecompiledContractAddress
@@ -245,7 +245,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 		if value.Sign() == 0 {
 			// Calling a non-existing account (probably contract), don't do anything, but ping the tracer
 			if evm.vmConfig.Debug && evm.depth == 0 {
-				evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
+				evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 				evm.vmConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
 			}
 			return nil, gas, nil
@@ -256,6 +256,11 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 	evm.Transfer(evm.StateDB, caller.Address(), to.Address(), value)
 
 	if !isProgramAccount(evm, caller.Address(), addr, evm.StateDB) {
+		// Transferring a value to an account without code, don't run the interpreter, but ping the tracer
+		if evm.vmConfig.Debug && evm.depth == 0 {
+			evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
+			evm.vmConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
+		}
 		return ret, gas, nil
 	}
 
@@ -268,7 +273,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 
 	// Capture the tracer start/end events in debug mode
 	if evm.vmConfig.Debug && evm.depth == 0 {
-		evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
+		evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 
 		defer func() { // Lazy evaluation of the parameters
 			evm.vmConfig.Tracer.CaptureEnd(ret, gas-contract.Gas, time.Since(start), err)
@@ -471,7 +476,7 @@ func (evm *EVM) create(caller types.ContractRef, codeAndHash *codeAndHash, gas u
 	}
 
 	if evm.vmConfig.Debug && evm.depth == 0 {
-		evm.vmConfig.Tracer.CaptureStart(caller.Address(), address, true, codeAndHash.code, gas, value)
+		evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), address, true, codeAndHash.code, gas, value)
 	}
 	start := time.Now()
 
@@ -563,6 +568,18 @@ func (evm *EVM) GetPrecompiledContractMap(addr common.Address) map[common.Addres
 	// There are contracts which uses latest precompiled contract map (regardless of deployment time)
 	// If new HF is added, please add new case below
 	switch {
+	case evm.chainRules.IsPrague:
+		if ok, mapWithVmVersion := getPrecompiledContractMapWithVmVersion(); ok {
+			return mapWithVmVersion
+		}
+		return PrecompiledContractsPrague
+	case evm.chainRules.IsRIP7212:
+		if ok, mapWithVmVersion := getPrecompiledContractMapWithVmVersion(); ok {
+			return mapWithVmVersion
+		}
+		return PrecompiledContractsRIP7212
+	case evm.chainRules.IsCancun:
+		fallthrough
 	case evm.chainRules.IsLondon:
 		fallthrough
 	case evm.chainRules.IsIstanbul:
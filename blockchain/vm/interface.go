@@ -58,6 +58,10 @@ type StateDB interface {
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
 
+	GetTransientState(addr common.Address, key common.Hash) common.Hash
+	SetTransientState(addr common.Address, key, value common.Hash)
+	ResetTransientStorage()
+
 	Suicide(common.Address) bool
 	HasSuicided(common.Address) bool
 
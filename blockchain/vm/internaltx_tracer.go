@@ -159,7 +159,7 @@ type RevertedInfo struct {
 }
 
 // CaptureStart implements the Tracer interface to initialize the tracing operation.
-func (this *InternalTxTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+func (this *InternalTxTracer) CaptureStart(env *EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
 	this.ctx["type"] = CALL.String()
 	if create {
 		this.ctx["type"] = CREATE.String()
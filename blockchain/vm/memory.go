@@ -66,6 +66,18 @@ func (m *Memory) Set32(offset uint64, val *big.Int) {
 	math.ReadBits(val, m.store[offset:offset+32])
 }
 
+// Copy copies size bytes from the src offset to the dst offset. The areas may overlap.
+func (m *Memory) Copy(dst, src, size uint64) {
+	if size > 0 {
+		// length of store may never be less than offset + size.
+		// The store should be resized PRIOR to copying the memory
+		if dst+size > uint64(len(m.store)) || src+size > uint64(len(m.store)) {
+			panic("invalid memory: store empty")
+		}
+		copy(m.store[dst:dst+size], m.store[src:src+size])
+	}
+}
+
 // Increase increases the memory with size bytes
 func (m *Memory) Increase(size uint64) {
 	m.store = append(m.store, make([]byte, size)...)
@@ -127,6 +127,9 @@ Args :
 		londonCompatibleBlockNumberFlag,
 		ethTxTypeCompatibleBlockNumberFlag,
 		magmaCompatibleBlockNumberFlag,
+		cancunCompatibleBlockNumberFlag,
+		rip7212CompatibleBlockNumberFlag,
+		pragueCompatibleBlockNumberFlag,
 	},
 	ArgsUsage: "type",
 }
@@ -528,6 +531,9 @@ func gen(ctx *cli.Context) error {
 	genesisJson.Config.LondonCompatibleBlock = big.NewInt(ctx.Int64(londonCompatibleBlockNumberFlag.Name))
 	genesisJson.Config.EthTxTypeCompatibleBlock = big.NewInt(ctx.Int64(ethTxTypeCompatibleBlockNumberFlag.Name))
 	genesisJson.Config.MagmaCompatibleBlock = big.NewInt(ctx.Int64(magmaCompatibleBlockNumberFlag.Name))
+	genesisJson.Config.CancunCompatibleBlock = big.NewInt(ctx.Int64(cancunCompatibleBlockNumberFlag.Name))
+	genesisJson.Config.RIP7212CompatibleBlock = big.NewInt(ctx.Int64(rip7212CompatibleBlockNumberFlag.Name))
+	genesisJson.Config.PragueCompatibleBlock = big.NewInt(ctx.Int64(pragueCompatibleBlockNumberFlag.Name))
 
 	genesisJsonBytes, _ = json.MarshalIndent(genesisJson, "", "    ")
 	genValidatorKeystore(privKeys)
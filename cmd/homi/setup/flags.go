@@ -379,4 +379,22 @@ var (
 		Usage: "magmaCompatible blockNumber",
 		Value: 0,
 	}
+
+	cancunCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "cancun-compatible-blocknumber",
+		Usage: "cancunCompatible blockNumber",
+		Value: 0,
+	}
+
+	rip7212CompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "rip7212-compatible-blocknumber",
+		Usage: "rip7212Compatible blockNumber",
+		Value: 0,
+	}
+
+	pragueCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "prague-compatible-blocknumber",
+		Usage: "pragueCompatible blockNumber",
+		Value: 0,
+	}
 )
@@ -58,6 +58,10 @@ func init() {
 		// See utils/nodecmd/chaincmd.go:
 		nodecmd.InitCommand,
 		nodecmd.DumpGenesisCommand,
+		nodecmd.ImportCommand,
+		nodecmd.ExportCommand,
+		nodecmd.InspectCommand,
+		nodecmd.VerifyStateCommand,
 
 		// See utils/nodecmd/accountcmd.go
 		nodecmd.AccountCommand,
@@ -71,6 +75,9 @@ func init() {
 
 		// See utils/nodecmd/dumpconfigcmd.go:
 		nodecmd.GetDumpConfigCommand(nodeFlags, rpcFlags),
+
+		// See utils/nodecmd/freezercmd.go:
+		nodecmd.FreezerCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -69,6 +69,8 @@ var FlagGroups = []FlagGroup{
 			TxPoolDenyRemoteTxFlag,
 			TxPoolJournalFlag,
 			TxPoolJournalIntervalFlag,
+			TxPoolJournalRemotesFlag,
+			TxPoolRemoteJournalFlag,
 			TxPoolPriceLimitFlag,
 			TxPoolPriceBumpFlag,
 			TxPoolExecSlotsAccountFlag,
@@ -76,6 +78,9 @@ var FlagGroups = []FlagGroup{
 			TxPoolNonExecSlotsAccountFlag,
 			TxPoolNonExecSlotsAllFlag,
 			TxPoolLifetimeFlag,
+			TxPoolParkedSlotsAccountFlag,
+			TxPoolParkedSlotsAllFlag,
+			TxPoolParkedLifetimeFlag,
 			TxPoolKeepLocalsFlag,
 			TxResendIntervalFlag,
 			TxResendCountFlag,
@@ -104,6 +109,7 @@ var FlagGroups = []FlagGroup{
 		Name: "DATABASE SYNCER",
 		Flags: []cli.Flag{
 			EnableDBSyncerFlag,
+			DBTypeFlag,
 			DBHostFlag,
 			DBPortFlag,
 			DBNameFlag,
@@ -152,6 +158,19 @@ var FlagGroups = []FlagGroup{
 			ChainDataFetcherKafkaProducerIdFlag,
 		},
 	},
+	{
+		Name: "FEEDELEGATION",
+		Flags: []cli.Flag{
+			EnableFeeDelegationFlag,
+			FeeDelegationFeePayerFlag,
+			FeeDelegationPolicyFileFlag,
+			FeeDelegationMaxFeeRatioFlag,
+			FeeDelegationMaxGasFlag,
+			FeeDelegationRateLimitFlag,
+			FeeDelegationRateLimitIntervalFlag,
+			FeeDelegationAuditLogFlag,
+		},
+	},
 	{
 		Name: "DATABASE MIGRATION",
 		Flags: []cli.Flag{
@@ -165,6 +184,8 @@ var FlagGroups = []FlagGroup{
 			DstDynamoDBIsProvisionedFlag,
 			DstDynamoDBReadCapacityFlag,
 			DstDynamoDBWriteCapacityFlag,
+			AncientThresholdFlag,
+			BloomFilterSizeFlag,
 		},
 	},
 	{
@@ -198,6 +219,9 @@ var FlagGroups = []FlagGroup{
 		Flags: []cli.Flag{
 			ServiceChainSignerFlag,
 			RewardbaseFlag,
+			IstanbulJournalFlag,
+			IstanbulJournalSizeFlag,
+			IstanbulValidatorStatsFlag,
 		},
 	},
 	{
@@ -251,6 +275,7 @@ var FlagGroups = []FlagGroup{
 			RPCGlobalEthTxFeeCapFlag,
 			RPCConcurrencyLimit,
 			RPCNonEthCompatibleFlag,
+			RPCLimitsFlag,
 			IPCDisabledFlag,
 			IPCPathFlag,
 			WSEnabledFlag,
@@ -261,6 +286,17 @@ var FlagGroups = []FlagGroup{
 			GRPCEnabledFlag,
 			GRPCListenAddrFlag,
 			GRPCPortFlag,
+			AuthRPCEnabledFlag,
+			AuthRPCListenAddrFlag,
+			AuthRPCPortFlag,
+			AuthRPCApiFlag,
+			AuthRPCVirtualHostsFlag,
+			AuthRPCJWTSecretFlag,
+			GraphQLEnabledFlag,
+			GraphQLListenAddrFlag,
+			GraphQLPortFlag,
+			GraphQLCORSDomainFlag,
+			GraphQLVirtualHostsFlag,
 			JSpathFlag,
 			ExecFlag,
 			PreloadJSFlag,
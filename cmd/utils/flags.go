@@ -37,6 +37,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/fdlimit"
+	"github.com/klaytn/klaytn/consensus/istanbul"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher/kafka"
@@ -52,6 +53,8 @@ import (
 	"github.com/klaytn/klaytn/node"
 	"github.com/klaytn/klaytn/node/cn"
 	"github.com/klaytn/klaytn/node/cn/filters"
+	"github.com/klaytn/klaytn/node/feedelegation"
+	"github.com/klaytn/klaytn/node/graphql"
 	"github.com/klaytn/klaytn/node/sc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
@@ -88,7 +91,7 @@ var (
 	}
 	DbTypeFlag = cli.StringFlag{
 		Name:  "dbtype",
-		Usage: `Blockchain storage database type ("LevelDB", "BadgerDB", "MemoryDB", "DynamoDBS3")`,
+		Usage: `Blockchain storage database type ("LevelDB", "BadgerDB", "PebbleDB", "MemoryDB", "DynamoDBS3")`,
 		Value: "LevelDB",
 	}
 	SrvTypeFlag = cli.StringFlag{
@@ -166,6 +169,15 @@ var (
 		Usage: "Time interval to regenerate the local transaction journal",
 		Value: blockchain.DefaultTxPoolConfig.JournalInterval,
 	}
+	TxPoolJournalRemotesFlag = cli.BoolFlag{
+		Name:  "txpool.journal-remotes",
+		Usage: "Snapshot remote pending and queued transactions on shutdown and every journal interval, and re-inject them on start",
+	}
+	TxPoolRemoteJournalFlag = cli.StringFlag{
+		Name:  "txpool.remote-journal",
+		Usage: "Disk journal for remote transactions to survive node restarts",
+		Value: blockchain.DefaultTxPoolConfig.RemoteJournal,
+	}
 	TxPoolPriceLimitFlag = cli.Uint64Flag{
 		Name:  "txpool.pricelimit",
 		Usage: "Minimum gas price limit to enforce for acceptance into the pool",
@@ -205,6 +217,21 @@ var (
 		Usage: "Maximum amount of time non-executable transaction are queued",
 		Value: cn.GetDefaultConfig().TxPool.Lifetime,
 	}
+	TxPoolParkedSlotsAccountFlag = cli.Uint64Flag{
+		Name:  "txpool.parked-slots.account",
+		Usage: "Maximum number of parked transaction slots permitted per account (parked transactions are priced below the base fee)",
+		Value: cn.GetDefaultConfig().TxPool.ParkedSlotsAccount,
+	}
+	TxPoolParkedSlotsAllFlag = cli.Uint64Flag{
+		Name:  "txpool.parked-slots.all",
+		Usage: "Maximum number of parked transaction slots for all accounts. 0 disables parking underpriced transactions",
+		Value: cn.GetDefaultConfig().TxPool.ParkedSlotsAll,
+	}
+	TxPoolParkedLifetimeFlag = cli.DurationFlag{
+		Name:  "txpool.parked-lifetime",
+		Usage: "Maximum amount of time transactions priced below the base fee are parked",
+		Value: cn.GetDefaultConfig().TxPool.ParkedLifetime,
+	}
 	// PN specific txpool settings
 	TxPoolSpamThrottlerDisableFlag = cli.BoolFlag{
 		Name:  "txpool.spamthrottler.disable",
@@ -240,6 +267,16 @@ var (
 		Name:  "db.leveldb.no-buffer-pool",
 		Usage: "Disables using buffer pool for LevelDB's block allocation",
 	}
+	AncientThresholdFlag = cli.Uint64Flag{
+		Name:  "db.ancient.threshold",
+		Usage: "Number of the most recent blocks not to be moved into the ancient block freezer",
+		Value: 90000,
+	}
+	BloomFilterSizeFlag = cli.Uint64Flag{
+		Name:  "bloomfilter.size",
+		Usage: "Megabytes of memory allocated to bloom-filter for pruning",
+		Value: 2048,
+	}
 	DynamoDBTableNameFlag = cli.StringFlag{
 		Name:  "db.dynamo.tablename",
 		Usage: "Specifies DynamoDB table name. This is mandatory to use dynamoDB. (Set dbtype to use DynamoDBS3)",
@@ -380,6 +417,19 @@ var (
 		Usage: "Public address for block consensus rewards (default = first account created)",
 		Value: "0",
 	}
+	IstanbulJournalFlag = cli.StringFlag{
+		Name:  "istanbul.journal",
+		Usage: "Disk journal of the consensus messages sent and received, for the round change analysis (disabled if empty)",
+	}
+	IstanbulJournalSizeFlag = cli.Uint64Flag{
+		Name:  "istanbul.journal-size",
+		Usage: "Maximum size of the consensus message journal in bytes. The previous journal is kept as a backup when the size is exceeded",
+		Value: istanbul.DefaultConfig.JournalSize,
+	}
+	IstanbulValidatorStatsFlag = cli.BoolFlag{
+		Name:  "istanbul.validator-stats",
+		Usage: "Index the consensus participation of the validators in new blocks and export it as metrics",
+	}
 	ExtraDataFlag = cli.StringFlag{
 		Name:  "extradata",
 		Usage: "Block extra data set by the work (default = client version)",
@@ -487,6 +537,10 @@ var (
 		Name:  "rpc.eth.noncompatible",
 		Usage: "Disables the eth namespace API return formatting for compatibility",
 	}
+	RPCLimitsFlag = cli.StringFlag{
+		Name:  "rpc.limits",
+		Usage: "JSON file of the access control and the per-method limits of the RPC servers",
+	}
 	WSEnabledFlag = cli.BoolFlag{
 		Name:  "ws",
 		Usage: "Enable the WS-RPC server",
@@ -545,6 +599,59 @@ var (
 		Usage: "gRPC server listening port",
 		Value: node.DefaultGRPCPort,
 	}
+	AuthRPCEnabledFlag = cli.BoolFlag{
+		Name:  "authrpc",
+		Usage: "Enable the JWT-authenticated HTTP and WebSocket RPC server",
+	}
+	AuthRPCListenAddrFlag = cli.StringFlag{
+		Name:  "authrpc.addr",
+		Usage: "Authenticated RPC server listening interface",
+		Value: node.DefaultAuthHost,
+	}
+	AuthRPCPortFlag = cli.IntFlag{
+		Name:  "authrpc.port",
+		Usage: "Authenticated RPC server listening port",
+		Value: node.DefaultAuthPort,
+	}
+	AuthRPCApiFlag = cli.StringFlag{
+		Name:  "authrpc.api",
+		Usage: "APIs offered over the authenticated RPC interface (all APIs if empty)",
+		Value: "",
+	}
+	AuthRPCVirtualHostsFlag = cli.StringFlag{
+		Name:  "authrpc.vhosts",
+		Usage: "Comma separated list of virtual hostnames from which to accept requests on the authenticated RPC server (server enforced). Accepts '*' wildcard.",
+		Value: strings.Join(node.DefaultConfig.AuthVirtualHosts, ","),
+	}
+	AuthRPCJWTSecretFlag = cli.StringFlag{
+		Name:  "authrpc.jwtsecret",
+		Usage: "Path to a hex-encoded 32-byte secret to verify the JWTs of the authenticated RPC requests (generated if missing)",
+		Value: "",
+	}
+	GraphQLEnabledFlag = cli.BoolFlag{
+		Name:  "graphql",
+		Usage: "Enable the GraphQL server",
+	}
+	GraphQLListenAddrFlag = cli.StringFlag{
+		Name:  "graphql.addr",
+		Usage: "GraphQL server listening interface",
+		Value: graphql.DefaultHost,
+	}
+	GraphQLPortFlag = cli.IntFlag{
+		Name:  "graphql.port",
+		Usage: "GraphQL server listening port",
+		Value: graphql.DefaultPort,
+	}
+	GraphQLCORSDomainFlag = cli.StringFlag{
+		Name:  "graphql.corsdomain",
+		Usage: "Comma separated list of domains from which to accept cross origin requests (browser enforced)",
+		Value: "",
+	}
+	GraphQLVirtualHostsFlag = cli.StringFlag{
+		Name:  "graphql.vhosts",
+		Usage: "Comma separated list of virtual hostnames from which to accept requests (server enforced). Accepts '*' wildcard.",
+		Value: strings.Join(graphql.DefaultConfig.VirtualHosts, ","),
+	}
 	IPCDisabledFlag = cli.BoolFlag{
 		Name:  "ipcdisable",
 		Usage: "Disable the IPC-RPC server",
@@ -922,13 +1029,18 @@ var (
 		Name:  "dbsyncer",
 		Usage: "Enable the DBSyncer",
 	}
+	DBTypeFlag = cli.StringFlag{
+		Name:  "dbsyncer.db.type",
+		Usage: "db.type in dbsyncer (mysql, postgres)",
+		Value: "mysql",
+	}
 	DBHostFlag = cli.StringFlag{
 		Name:  "dbsyncer.db.host",
 		Usage: "db.host in dbsyncer",
 	}
 	DBPortFlag = cli.StringFlag{
 		Name:  "dbsyncer.db.port",
-		Usage: "db.port in dbsyncer",
+		Usage: "db.port in dbsyncer (5432 is used for postgres if not set)",
 		Value: "3306",
 	}
 	DBNameFlag = cli.StringFlag{
@@ -997,6 +1109,44 @@ var (
 		Usage: "The maximum difference between current block and event block. 0 means off",
 		Value: 0,
 	}
+	// FeeDelegation
+	EnableFeeDelegationFlag = cli.BoolFlag{
+		Name:  "feedelegation",
+		Usage: "Enable the fee delegation service co-signing the fee-delegated transactions as the fee payer",
+	}
+	FeeDelegationFeePayerFlag = cli.StringFlag{
+		Name:  "feedelegation.feepayer",
+		Usage: "Address of the unlocked keystore account signing the transactions as the fee payer",
+	}
+	FeeDelegationPolicyFileFlag = cli.StringFlag{
+		Name:  "feedelegation.policy",
+		Usage: "JSON file of the contracts and methods allowed to be fee-delegated (any contract if not set)",
+	}
+	FeeDelegationMaxFeeRatioFlag = cli.UintFlag{
+		Name:  "feedelegation.maxfeeratio",
+		Usage: "Maximum ratio of the fee paid by the fee payer in percentage [1, 100]",
+		Value: uint(feedelegation.DefaultConfig.MaxFeeRatio),
+	}
+	FeeDelegationMaxGasFlag = cli.Uint64Flag{
+		Name:  "feedelegation.maxgas",
+		Usage: "Maximum gas limit of a fee-delegated transaction. 0 means no limit",
+		Value: 0,
+	}
+	FeeDelegationRateLimitFlag = cli.IntFlag{
+		Name:  "feedelegation.ratelimit",
+		Usage: "Maximum number of the transactions of a sender in the rate limit interval. 0 means no limit",
+		Value: feedelegation.DefaultSenderRateLimit,
+	}
+	FeeDelegationRateLimitIntervalFlag = cli.DurationFlag{
+		Name:  "feedelegation.ratelimit.interval",
+		Usage: "Interval in which the transactions of a sender are counted",
+		Value: feedelegation.DefaultRateLimitInterval,
+	}
+	FeeDelegationAuditLogFlag = cli.StringFlag{
+		Name:  "feedelegation.auditlog",
+		Usage: "File to which the fee delegation requests are appended (relative to the data directory)",
+		Value: feedelegation.DefaultAuditLogFile,
+	}
 	AutoRestartFlag = cli.BoolFlag{
 		Name:  "autorestart.enable",
 		Usage: "Node can restart itself when there is a problem in making consensus",
@@ -1015,7 +1165,7 @@ var (
 	// db migration vars
 	DstDbTypeFlag = cli.StringFlag{
 		Name:  "dst.dbtype",
-		Usage: `Blockchain storage database type ("LevelDB", "BadgerDB", "DynamoDBS3")`,
+		Usage: `Blockchain storage database type ("LevelDB", "BadgerDB", "PebbleDB", "DynamoDBS3")`,
 		Value: "LevelDB",
 	}
 	DstDataDirFlag = DirectoryFlag{
@@ -1308,6 +1458,42 @@ func setgRPC(ctx *cli.Context, cfg *node.Config) {
 	}
 }
 
+// setAuthRPC creates the JWT-authenticated RPC listener interface string from the set
+// command line flags, returning empty if the authenticated RPC endpoint is disabled.
+func setAuthRPC(ctx *cli.Context, cfg *node.Config) {
+	if ctx.GlobalBool(AuthRPCEnabledFlag.Name) && cfg.AuthHost == "" {
+		cfg.AuthHost = ctx.GlobalString(AuthRPCListenAddrFlag.Name)
+	}
+	if ctx.GlobalIsSet(AuthRPCPortFlag.Name) {
+		cfg.AuthPort = ctx.GlobalInt(AuthRPCPortFlag.Name)
+	}
+	if ctx.GlobalIsSet(AuthRPCApiFlag.Name) {
+		cfg.AuthModules = splitAndTrim(ctx.GlobalString(AuthRPCApiFlag.Name))
+	}
+	if ctx.GlobalIsSet(AuthRPCVirtualHostsFlag.Name) {
+		cfg.AuthVirtualHosts = splitAndTrim(ctx.GlobalString(AuthRPCVirtualHostsFlag.Name))
+	}
+	if ctx.GlobalIsSet(AuthRPCJWTSecretFlag.Name) {
+		cfg.JWTSecret = ctx.GlobalString(AuthRPCJWTSecretFlag.Name)
+	}
+}
+
+// setRPCLimits applies the access control and the per-method limits to the RPC servers.
+func setRPCLimits(ctx *cli.Context) {
+	if !ctx.GlobalIsSet(RPCLimitsFlag.Name) {
+		return
+	}
+	path := ctx.GlobalString(RPCLimitsFlag.Name)
+	cfg, err := rpc.LoadLimitConfig(path)
+	if err != nil {
+		log.Fatalf("Option %q: %v", RPCLimitsFlag.Name, err)
+	}
+	if err := rpc.SetLimits(cfg); err != nil {
+		log.Fatalf("Option %q: %v", RPCLimitsFlag.Name, err)
+	}
+	logger.Info("Set the RPC limits", "path", path, "rules", len(cfg.Rules))
+}
+
 // setAPIConfig sets configurations for specific APIs.
 func setAPIConfig(ctx *cli.Context) {
 	filters.GetLogsDeadline = ctx.GlobalDuration(APIFilterGetLogsDeadlineFlag.Name)
@@ -1452,6 +1638,8 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	setHTTP(ctx, cfg)
 	setWS(ctx, cfg)
 	setgRPC(ctx, cfg)
+	setAuthRPC(ctx, cfg)
+	setRPCLimits(ctx)
 	setAPIConfig(ctx)
 	setNodeUserIdent(ctx, cfg)
 
@@ -1473,6 +1661,18 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	}
 }
 
+func setIstanbul(ctx *cli.Context, cfg *istanbul.Config) {
+	if ctx.GlobalIsSet(IstanbulJournalFlag.Name) {
+		cfg.Journal = ctx.GlobalString(IstanbulJournalFlag.Name)
+	}
+	if ctx.GlobalIsSet(IstanbulJournalSizeFlag.Name) {
+		cfg.JournalSize = ctx.GlobalUint64(IstanbulJournalSizeFlag.Name)
+	}
+	if ctx.GlobalIsSet(IstanbulValidatorStatsFlag.Name) {
+		cfg.ValidatorStats = ctx.GlobalBool(IstanbulValidatorStatsFlag.Name)
+	}
+}
+
 func setTxPool(ctx *cli.Context, cfg *blockchain.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolNoLocalsFlag.Name) {
 		cfg.NoLocals = ctx.GlobalBool(TxPoolNoLocalsFlag.Name)
@@ -1489,6 +1689,12 @@ func setTxPool(ctx *cli.Context, cfg *blockchain.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolJournalIntervalFlag.Name) {
 		cfg.JournalInterval = ctx.GlobalDuration(TxPoolJournalIntervalFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolJournalRemotesFlag.Name) {
+		cfg.JournalRemotes = ctx.GlobalBool(TxPoolJournalRemotesFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolRemoteJournalFlag.Name) {
+		cfg.RemoteJournal = ctx.GlobalString(TxPoolRemoteJournalFlag.Name)
+	}
 	if ctx.GlobalIsSet(TxPoolPriceLimitFlag.Name) {
 		cfg.PriceLimit = ctx.GlobalUint64(TxPoolPriceLimitFlag.Name)
 	}
@@ -1513,6 +1719,15 @@ func setTxPool(ctx *cli.Context, cfg *blockchain.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolParkedSlotsAccountFlag.Name) {
+		cfg.ParkedSlotsAccount = ctx.GlobalUint64(TxPoolParkedSlotsAccountFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolParkedSlotsAllFlag.Name) {
+		cfg.ParkedSlotsAll = ctx.GlobalUint64(TxPoolParkedSlotsAllFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolParkedLifetimeFlag.Name) {
+		cfg.ParkedLifetime = ctx.GlobalDuration(TxPoolParkedLifetimeFlag.Name)
+	}
 
 	// PN specific txpool setting
 	if NodeTypeFlag.Value == "pn" {
@@ -1583,6 +1798,7 @@ func SetKlayConfig(ctx *cli.Context, stack *node.Node, cfg *cn.Config) {
 	setServiceChainSigner(ctx, ks, cfg)
 	setRewardbase(ctx, ks, cfg)
 	setTxPool(ctx, &cfg.TxPool)
+	setIstanbul(ctx, &cfg.Istanbul)
 
 	if ctx.GlobalIsSet(SyncModeFlag.Name) {
 		cfg.SyncMode = *GlobalTextMarshaler(ctx, SyncModeFlag.Name).(*downloader.SyncMode)
@@ -1819,6 +2035,32 @@ func RegisterDBSyncerService(stack *node.Node, cfg *dbsyncer.DBConfig) {
 	}
 }
 
+// RegisterFeeDelegationService adds a FeeDelegation to the stack
+func RegisterFeeDelegationService(stack *node.Node, cfg *feedelegation.Config) {
+	if cfg.EnabledFeeDelegation {
+		err := stack.RegisterSubService(func(ctx *node.ServiceContext) (node.Service, error) {
+			feeDelegation, err := feedelegation.NewFeeDelegation(ctx, cfg)
+			return feeDelegation, err
+		})
+		if err != nil {
+			log.Fatalf("Failed to register the service: %v", err)
+		}
+	}
+}
+
+// RegisterGraphQLService adds a GraphQL server to the stack
+func RegisterGraphQLService(stack *node.Node, cfg *graphql.Config) {
+	if cfg.EnabledGraphQL {
+		err := stack.RegisterSubService(func(ctx *node.ServiceContext) (node.Service, error) {
+			graphQL, err := graphql.NewGraphQL(ctx, cfg)
+			return graphQL, err
+		})
+		if err != nil {
+			log.Fatalf("Failed to register the GraphQL service: %v", err)
+		}
+	}
+}
+
 // SetupNetwork configures the system for either the main net or some test network.
 func SetupNetwork(ctx *cli.Context) {
 	// TODO(fjl): move target gas limit into config
@@ -23,14 +23,23 @@ package nodecmd
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/governance"
 	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/node"
+	"github.com/klaytn/klaytn/node/cn"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
 	"github.com/klaytn/klaytn/storage/database"
@@ -81,6 +90,74 @@ It expects the genesis file as argument.`,
 		Description: `
 The dumpgenesis command dumps the genesis block configuration in JSON format to stdout.`,
 	}
+
+	ImportCommand = cli.Command{
+		Action:    utils.MigrateFlags(importChain),
+		Name:      "import",
+		Usage:     "Import a blockchain file",
+		ArgsUsage: "<filename> (<filename 2> ... <filename N>) ",
+		Flags:     dbFlags,
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+The import command imports blocks from an RLP-encoded form. The form can be one file
+with several RLP-encoded blocks, or several files can be used. A file whose name
+ends with ".gz" is decompressed with gzip.
+
+The blocks already stored in the database are skipped, so an interrupted import
+is resumed by running the command again with the same files.
+
+If only one file is used, an import error will result in failure. If several files
+are used, processing will proceed even if an individual RLP-file import fails.
+Note: Do not use the import command while a node is executing.`,
+	}
+
+	ExportCommand = cli.Command{
+		Action:    utils.MigrateFlags(exportChain),
+		Name:      "export",
+		Usage:     "Export blockchain into file",
+		ArgsUsage: "<filename> [<blockNumFirst> <blockNumLast>]",
+		Flags:     dbFlags,
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+Requires a first argument of the file to write to.
+Optional second and third arguments control the first and
+last block to write. In this mode, the file will be appended
+if already existing. If the file ends with .gz, the output will
+be gzipped.
+Note: Do not use the export command while a node is executing.`,
+	}
+
+	InspectCommand = cli.Command{
+		Action:    utils.MigrateFlags(inspectChain),
+		Name:      "inspect",
+		Usage:     "Inspect the storage size for each type of data in the database",
+		ArgsUsage: "",
+		Flags:     dbFlags,
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+The inspect command iterates the entire database and shows the number and the size
+of the entries for each partitioned database and key category. The blocks moved
+into the ancient block freezer are shown per freezer table.
+
+Note: This feature is not provided for DynamoDB.
+Note: Do not use the inspect command while a node is executing.`,
+	}
+
+	VerifyStateCommand = cli.Command{
+		Action:    utils.MigrateFlags(verifyState),
+		Name:      "verify-state",
+		Usage:     "Verify the state trie of the given state root",
+		ArgsUsage: "[<root>]",
+		Flags:     dbFlags,
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+The verify-state command iterates all state trie nodes, storage trie nodes and
+contract codes of the given state root, and checks that every entry exists and
+its hash matches the key. The default state root is the one of the head block.
+
+Note: This feature is not provided for DynamoDB.
+Note: Do not use the verify-state command while a node is executing.`,
+	}
 )
 
 // initGenesis will initialise the given JSON format genesis file and writes it as
@@ -240,3 +317,165 @@ func ValidateGenesisConfig(g *blockchain.Genesis) error {
 	}
 	return nil
 }
+
+// makeChain creates the blockchain on the chain database without running a node.
+// The returned function stops the blockchain and closes the database.
+func makeChain(ctx *cli.Context) (*blockchain.BlockChain, func(), error) {
+	stack, cfg := makeConfigNode(ctx)
+	sctx := node.NewServiceContext(&cfg.Node, make(map[reflect.Type]node.Service), stack.EventMux(), stack.AccountManager())
+
+	chain, chainDB, err := cn.NewChain(sctx, &cfg.CN)
+	if err != nil {
+		return nil, nil, err
+	}
+	return chain, func() {
+		chain.Stop()
+		chainDB.Close()
+	}, nil
+}
+
+func importChain(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		return errors.New("this command requires an argument")
+	}
+	chain, closeChain, err := makeChain(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeChain()
+
+	start := time.Now()
+	if len(ctx.Args()) == 1 {
+		if err := utils.ImportChain(chain, ctx.Args().First()); err != nil {
+			logger.Error("Import error", "err", err)
+			return err
+		}
+	} else {
+		for _, arg := range ctx.Args() {
+			if err := utils.ImportChain(chain, arg); err != nil {
+				logger.Error("Import error", "file", arg, "err", err)
+			}
+		}
+	}
+	head := chain.CurrentBlock()
+	logger.Info("Import done", "number", head.NumberU64(), "hash", head.Hash(), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+func exportChain(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		return errors.New("this command requires an argument")
+	}
+	chain, closeChain, err := makeChain(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeChain()
+
+	start := time.Now()
+	fp := ctx.Args().First()
+	if len(ctx.Args()) < 3 {
+		err = utils.ExportChain(chain, fp)
+	} else {
+		// This can be improved to allow for numbers larger than 9223372036854775807
+		first, ferr := strconv.ParseInt(ctx.Args().Get(1), 10, 64)
+		last, lerr := strconv.ParseInt(ctx.Args().Get(2), 10, 64)
+		if ferr != nil || lerr != nil {
+			return errors.New("export error in parsing parameters: block number not an integer")
+		}
+		if first < 0 || last < 0 {
+			return errors.New("export error: block number must be greater than 0")
+		}
+		if head := chain.CurrentBlock().NumberU64(); uint64(last) > head {
+			return fmt.Errorf("export error: block number %d larger than head block %d", last, head)
+		}
+		err = utils.ExportAppendChain(chain, fp, uint64(first), uint64(last))
+	}
+	if err != nil {
+		logger.Error("Export error", "err", err)
+		return err
+	}
+	logger.Info("Export done", "file", fp, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// openChainDB opens the chain database for the commands not supported for DynamoDB.
+func openChainDB(ctx *cli.Context, command string) (database.DBManager, error) {
+	dbtype := database.DBType(ctx.GlobalString(utils.DbTypeFlag.Name)).ToValid()
+	if len(dbtype) == 0 {
+		return nil, errors.New("invalid dbtype: " + ctx.GlobalString(utils.DbTypeFlag.Name))
+	}
+	if dbtype == database.DynamoDB || dbtype == database.MemoryDB {
+		return nil, errors.New(command + " is not supported for " + string(dbtype))
+	}
+
+	stack := MakeFullNode(ctx)
+	dbc := &database.DBConfig{
+		Dir: "chaindata", DBType: dbtype, SingleDB: ctx.GlobalIsSet(utils.SingleDBFlag.Name),
+		NumStateTrieShards: ctx.GlobalUint(utils.NumStateTrieShardsFlag.Name), OpenFilesLimit: database.GetOpenFilesLimit(),
+		LevelDBCompression: database.LevelDBCompressionType(ctx.GlobalInt(utils.LevelDBCompressionTypeFlag.Name)),
+	}
+	return stack.OpenDatabase(dbc), nil
+}
+
+func inspectChain(ctx *cli.Context) error {
+	chainDB, err := openChainDB(ctx, "database inspection")
+	if err != nil {
+		return err
+	}
+	defer chainDB.Close()
+
+	stats, err := chainDB.InspectDatabase()
+	if err != nil {
+		logger.Error("Failed to inspect database", "err", err)
+		return err
+	}
+
+	var (
+		totalCount uint64
+		totalSize  common.StorageSize
+	)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATABASE\tCATEGORY\tCOUNT\tSIZE")
+	for _, stat := range stats {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", stat.Database, stat.Category, stat.Count, stat.Size)
+		totalCount += stat.Count
+		totalSize += stat.Size
+	}
+	fmt.Fprintf(w, "\tTotal\t%d\t%s\n", totalCount, totalSize)
+	return w.Flush()
+}
+
+func verifyState(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		logger.Error("Too many arguments given")
+		return errors.New("too many arguments")
+	}
+	chainDB, err := openChainDB(ctx, "state verification")
+	if err != nil {
+		return err
+	}
+	defer chainDB.Close()
+
+	var root common.Hash
+	if ctx.NArg() == 1 {
+		if root, err = parseRoot(ctx.Args()[0]); err != nil {
+			logger.Error("Failed to resolve state root", "err", err)
+			return err
+		}
+	} else {
+		head := chainDB.ReadBlockByHash(chainDB.ReadHeadBlockHash())
+		if head == nil {
+			return errors.New("failed to load head block")
+		}
+		root = head.Root()
+	}
+
+	logger.Info("Start verifying state", "root", root)
+	if err := state.VerifyState(state.NewDatabase(chainDB), root, nil); err != nil {
+		logger.Error("Failed to verify state", "root", root, "err", err)
+		return err
+	}
+	logger.Info("Verified state", "root", root)
+	return nil
+}
@@ -31,7 +31,9 @@ import (
 	"unicode"
 
 	"github.com/Shopify/sarama"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher/kafka"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher/kas"
@@ -39,6 +41,8 @@ import (
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/node"
 	"github.com/klaytn/klaytn/node/cn"
+	"github.com/klaytn/klaytn/node/feedelegation"
+	"github.com/klaytn/klaytn/node/graphql"
 	"github.com/klaytn/klaytn/node/sc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/naoina/toml"
@@ -239,6 +243,43 @@ func makeKafkaConfig(ctx *cli.Context) *kafka.KafkaConfig {
 	return kafkaConfig
 }
 
+func makeFeeDelegationConfig(ctx *cli.Context) feedelegation.Config {
+	cfg := *feedelegation.DefaultConfig
+
+	if ctx.GlobalBool(utils.EnableFeeDelegationFlag.Name) {
+		cfg.EnabledFeeDelegation = true
+
+		feePayer := ctx.GlobalString(utils.FeeDelegationFeePayerFlag.Name)
+		if !common.IsHexAddress(feePayer) {
+			logger.Crit("FeePayer must be set to a valid address !", "key", utils.FeeDelegationFeePayerFlag.Name, "feePayer", feePayer)
+		}
+		cfg.FeePayer = common.HexToAddress(feePayer)
+		cfg.PolicyFile = ctx.GlobalString(utils.FeeDelegationPolicyFileFlag.Name)
+		cfg.MaxFeeRatio = types.FeeRatio(ctx.GlobalUint(utils.FeeDelegationMaxFeeRatioFlag.Name))
+		cfg.MaxGas = ctx.GlobalUint64(utils.FeeDelegationMaxGasFlag.Name)
+		cfg.SenderRateLimit = ctx.GlobalInt(utils.FeeDelegationRateLimitFlag.Name)
+		cfg.RateLimitInterval = ctx.GlobalDuration(utils.FeeDelegationRateLimitIntervalFlag.Name)
+		cfg.AuditLogFile = ctx.GlobalString(utils.FeeDelegationAuditLogFlag.Name)
+	}
+	return cfg
+}
+
+func makeGraphQLConfig(ctx *cli.Context) graphql.Config {
+	cfg := *graphql.DefaultConfig
+
+	if ctx.GlobalBool(utils.GraphQLEnabledFlag.Name) {
+		cfg.EnabledGraphQL = true
+
+		cfg.Host = ctx.GlobalString(utils.GraphQLListenAddrFlag.Name)
+		cfg.Port = ctx.GlobalInt(utils.GraphQLPortFlag.Name)
+		if ctx.GlobalIsSet(utils.GraphQLCORSDomainFlag.Name) {
+			cfg.Cors = strings.Split(ctx.GlobalString(utils.GraphQLCORSDomainFlag.Name), ",")
+		}
+		cfg.VirtualHosts = strings.Split(ctx.GlobalString(utils.GraphQLVirtualHostsFlag.Name), ",")
+	}
+	return cfg
+}
+
 func makeDBSyncerConfig(ctx *cli.Context) dbsyncer.DBConfig {
 	cfg := dbsyncer.DefaultDBConfig
 
@@ -251,9 +292,14 @@ func makeDBSyncerConfig(ctx *cli.Context) dbsyncer.DBConfig {
 		} else {
 			logger.Crit("DBHost must be set !", "key", utils.DBHostFlag.Name)
 		}
+		if ctx.GlobalIsSet(utils.DBTypeFlag.Name) {
+			cfg.DBType = strings.ToLower(ctx.GlobalString(utils.DBTypeFlag.Name))
+		}
 		if ctx.GlobalIsSet(utils.DBPortFlag.Name) {
 			dbports := ctx.GlobalString(utils.DBPortFlag.Name)
 			cfg.DBPort = dbports
+		} else if cfg.DBType == dbsyncer.POSTGRES {
+			cfg.DBPort = "5432"
 		}
 		if ctx.GlobalIsSet(utils.DBUserFlag.Name) {
 			dbuser := ctx.GlobalString(utils.DBUserFlag.Name)
@@ -405,6 +451,12 @@ func MakeFullNode(ctx *cli.Context) *node.Node {
 	chaindataFetcherConfig := makeChainDataFetcherConfig(ctx)
 	utils.RegisterChainDataFetcherService(stack, &chaindataFetcherConfig)
 
+	feeDelegationConfig := makeFeeDelegationConfig(ctx)
+	utils.RegisterFeeDelegationService(stack, &feeDelegationConfig)
+
+	graphQLConfig := makeGraphQLConfig(ctx)
+	utils.RegisterGraphQLService(stack, &graphQLConfig)
+
 	return stack
 }
 
@@ -649,6 +649,13 @@ var flagsWithValues = []struct {
 		flag:     "--dbsyncer",
 		flagType: FlagTypeBoolean,
 	},
+	{
+		flag:        "--dbsyncer.db.type",
+		flagType:    FlagTypeArgument,
+		values:      []string{"mysql", "postgres"},
+		wrongValues: commonThreeErrors,
+		errors:      []int{NonError, NonError, NonError},
+	},
 	{
 		flag:        "--dbsyncer.db.host",
 		flagType:    FlagTypeArgument,
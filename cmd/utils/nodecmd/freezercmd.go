@@ -0,0 +1,86 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"errors"
+
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/storage/database"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var FreezerCommand = cli.Command{
+	Name:     "db-freezer",
+	Usage:    "Manage the ancient block freezer",
+	Flags:    []cli.Flag{},
+	Category: "DB MIGRATION COMMANDS",
+	Description: `
+The ancient block freezer is an append-only flat-file store of the old canonical
+blocks. The headers, bodies and receipts of the frozen blocks are removed from
+the key-value databases, and they are transparently read from the freezer.
+The freezer is maintained offline only. A running node does not freeze blocks by
+itself, and it reads the blocks frozen before it started.
+Note: Do not use the freezer commands while a node is executing.
+`,
+	Subcommands: []cli.Command{
+		{
+			Name:   "freeze",
+			Usage:  "Move old canonical blocks into the ancient block freezer",
+			Flags:  append(append([]cli.Flag{}, dbFlags...), utils.AncientThresholdFlag),
+			Action: utils.MigrateFlags(freezeAncientBlocks),
+			Description: `
+This command moves the canonical blocks older than the threshold (--db.ancient.threshold)
+from the head block into the ancient block freezer in the 'ancient' directory of
+the chain database. The threshold must be at least 1 to keep the head block in the
+key-value databases. The blocks which have been already frozen are skipped, so the
+command can be run repeatedly as the chain grows.
+
+Note: This feature is not provided for DynamoDB.`,
+		},
+	},
+}
+
+func freezeAncientBlocks(ctx *cli.Context) error {
+	dbtype := database.DBType(ctx.GlobalString(utils.DbTypeFlag.Name)).ToValid()
+	if len(dbtype) == 0 {
+		return errors.New("invalid dbtype: " + ctx.GlobalString(utils.DbTypeFlag.Name))
+	}
+	if dbtype == database.DynamoDB || dbtype == database.MemoryDB {
+		return errors.New("ancient block freezer is not supported for " + string(dbtype))
+	}
+
+	stack := MakeFullNode(ctx)
+	dbc := &database.DBConfig{
+		Dir: "chaindata", DBType: dbtype, SingleDB: ctx.GlobalIsSet(utils.SingleDBFlag.Name),
+		NumStateTrieShards: ctx.GlobalUint(utils.NumStateTrieShardsFlag.Name), OpenFilesLimit: database.GetOpenFilesLimit(),
+		LevelDBCompression: database.LevelDBCompressionType(ctx.GlobalInt(utils.LevelDBCompressionTypeFlag.Name)),
+	}
+	chainDB := stack.OpenDatabase(dbc)
+	defer chainDB.Close()
+
+	threshold := ctx.GlobalUint64(utils.AncientThresholdFlag.Name)
+	logger.Info("Start freezing ancient blocks", "frozen", chainDB.Ancients(), "threshold", threshold)
+
+	frozen, err := chainDB.FreezeAncientBlocks(threshold)
+	if err != nil {
+		logger.Error("Failed to freeze ancient blocks", "newlyFrozen", frozen, "err", err)
+		return err
+	}
+	logger.Info("Finished freezing ancient blocks", "newlyFrozen", frozen, "frozen", chainDB.Ancients())
+	return nil
+}
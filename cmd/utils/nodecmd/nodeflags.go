@@ -43,6 +43,8 @@ var CommonNodeFlags = []cli.Flag{
 	utils.TxPoolDenyRemoteTxFlag,
 	utils.TxPoolJournalFlag,
 	utils.TxPoolJournalIntervalFlag,
+	utils.TxPoolJournalRemotesFlag,
+	utils.TxPoolRemoteJournalFlag,
 	utils.TxPoolPriceLimitFlag,
 	utils.TxPoolPriceBumpFlag,
 	utils.TxPoolExecSlotsAccountFlag,
@@ -50,6 +52,9 @@ var CommonNodeFlags = []cli.Flag{
 	utils.TxPoolNonExecSlotsAccountFlag,
 	utils.TxPoolNonExecSlotsAllFlag,
 	utils.TxPoolLifetimeFlag,
+	utils.TxPoolParkedSlotsAccountFlag,
+	utils.TxPoolParkedSlotsAllFlag,
+	utils.TxPoolParkedLifetimeFlag,
 	utils.TxPoolKeepLocalsFlag,
 	utils.SyncModeFlag,
 	utils.GCModeFlag,
@@ -108,6 +113,7 @@ var CommonNodeFlags = []cli.Flag{
 	utils.MetricsEnabledFlag,
 	utils.PrometheusExporterFlag,
 	utils.PrometheusExporterPortFlag,
+	utils.IstanbulValidatorStatsFlag,
 	utils.ExtraDataFlag,
 	utils.SrvTypeFlag,
 	utils.AutoRestartFlag,
@@ -135,7 +141,19 @@ var CommonRPCFlags = []cli.Flag{
 	utils.GRPCEnabledFlag,
 	utils.GRPCListenAddrFlag,
 	utils.GRPCPortFlag,
+	utils.AuthRPCEnabledFlag,
+	utils.AuthRPCListenAddrFlag,
+	utils.AuthRPCPortFlag,
+	utils.AuthRPCApiFlag,
+	utils.AuthRPCVirtualHostsFlag,
+	utils.AuthRPCJWTSecretFlag,
+	utils.GraphQLEnabledFlag,
+	utils.GraphQLListenAddrFlag,
+	utils.GraphQLPortFlag,
+	utils.GraphQLCORSDomainFlag,
+	utils.GraphQLVirtualHostsFlag,
 	utils.RPCConcurrencyLimit,
+	utils.RPCLimitsFlag,
 	utils.WSApiFlag,
 	utils.WSAllowedOriginsFlag,
 	utils.WSMaxSubscriptionPerConn,
@@ -156,6 +174,8 @@ var KCNFlags = []cli.Flag{
 	utils.BaobabFlag,
 	utils.BlockGenerationIntervalFlag,
 	utils.BlockGenerationTimeLimitFlag,
+	utils.IstanbulJournalFlag,
+	utils.IstanbulJournalSizeFlag,
 }
 
 var KPNFlags = []cli.Flag{
@@ -203,6 +223,7 @@ var KENFlags = []cli.Flag{
 	utils.ChainDataFetcherKafkaProducerIdFlag,
 	// DBSyncer
 	utils.EnableDBSyncerFlag,
+	utils.DBTypeFlag,
 	utils.DBHostFlag,
 	utils.DBPortFlag,
 	utils.DBNameFlag,
@@ -219,6 +240,15 @@ var KENFlags = []cli.Flag{
 	utils.BulkInsertSizeFlag,
 	utils.EventModeFlag,
 	utils.MaxBlockDiffFlag,
+	// FeeDelegation
+	utils.EnableFeeDelegationFlag,
+	utils.FeeDelegationFeePayerFlag,
+	utils.FeeDelegationPolicyFileFlag,
+	utils.FeeDelegationMaxFeeRatioFlag,
+	utils.FeeDelegationMaxGasFlag,
+	utils.FeeDelegationRateLimitFlag,
+	utils.FeeDelegationRateLimitIntervalFlag,
+	utils.FeeDelegationAuditLogFlag,
 	utils.TxResendIntervalFlag,
 	utils.TxResendCountFlag,
 	utils.TxResendUseLegacyFlag,
@@ -228,6 +258,8 @@ var KSCNFlags = []cli.Flag{
 	utils.RewardbaseFlag,
 	utils.BlockGenerationIntervalFlag,
 	utils.BlockGenerationTimeLimitFlag,
+	utils.IstanbulJournalFlag,
+	utils.IstanbulJournalSizeFlag,
 	utils.ServiceChainSignerFlag,
 	utils.AnchoringPeriodFlag,
 	utils.SentChainTxsLimit,
@@ -338,6 +370,7 @@ var KSENFlags = []cli.Flag{
 	utils.KASServiceChainAnchorRequestTimeoutFlag,
 	// DBSyncer
 	utils.EnableDBSyncerFlag,
+	utils.DBTypeFlag,
 	utils.DBHostFlag,
 	utils.DBPortFlag,
 	utils.DBNameFlag,
@@ -354,6 +387,15 @@ var KSENFlags = []cli.Flag{
 	utils.BulkInsertSizeFlag,
 	utils.EventModeFlag,
 	utils.MaxBlockDiffFlag,
+	// FeeDelegation
+	utils.EnableFeeDelegationFlag,
+	utils.FeeDelegationFeePayerFlag,
+	utils.FeeDelegationPolicyFileFlag,
+	utils.FeeDelegationMaxFeeRatioFlag,
+	utils.FeeDelegationMaxGasFlag,
+	utils.FeeDelegationRateLimitFlag,
+	utils.FeeDelegationRateLimitIntervalFlag,
+	utils.FeeDelegationAuditLogFlag,
 	utils.TxResendIntervalFlag,
 	utils.TxResendCountFlag,
 	utils.TxResendUseLegacyFlag,
@@ -0,0 +1,111 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/klaytn/klaytn/blockchain/state/pruner"
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/storage/database"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var SnapshotCommand = cli.Command{
+	Name:        "snapshot",
+	Usage:       "A set of commands based on the snapshot",
+	Category:    "DB MIGRATION COMMANDS",
+	Description: "",
+	Subcommands: []cli.Command{
+		{
+			Name:      "prune-state",
+			Usage:     "Prune stale klaytn state data based on the snapshot",
+			ArgsUsage: "<root>",
+			Action:    utils.MigrateFlags(pruneState),
+			Flags:     append(append([]cli.Flag{}, dbFlags...), utils.BloomFilterSizeFlag),
+			Description: `
+ken snapshot prune-state <state-root>
+will prune historical state data with the help of the state snapshot.
+All trie nodes and contract codes that do not belong to the specified
+version state will be deleted from the database. After pruning, only
+two version states are available: genesis and the specific one.
+
+The default pruning target is the HEAD-127 state.
+
+WARNING: The persistent trie node cache in the 'fastcache' directory of the
+data directory is deleted by the pruning, since it may contain the pruned nodes.
+
+If the pruning is interrupted, it is resumed by running this command again
+or by starting the node with the same data directory.
+`,
+		},
+	},
+}
+
+func pruneState(ctx *cli.Context) error {
+	dbtype := database.DBType(ctx.GlobalString(utils.DbTypeFlag.Name)).ToValid()
+	if len(dbtype) == 0 {
+		return errors.New("invalid dbtype: " + ctx.GlobalString(utils.DbTypeFlag.Name))
+	}
+	if dbtype == database.DynamoDB || dbtype == database.MemoryDB {
+		return errors.New("state pruning is not supported for " + string(dbtype))
+	}
+	if ctx.NArg() > 1 {
+		logger.Error("Too many arguments given")
+		return errors.New("too many arguments")
+	}
+
+	stack := MakeFullNode(ctx)
+	dbc := &database.DBConfig{
+		Dir: "chaindata", DBType: dbtype, SingleDB: ctx.GlobalIsSet(utils.SingleDBFlag.Name),
+		NumStateTrieShards: ctx.GlobalUint(utils.NumStateTrieShardsFlag.Name), OpenFilesLimit: database.GetOpenFilesLimit(),
+		LevelDBCompression: database.LevelDBCompressionType(ctx.GlobalInt(utils.LevelDBCompressionTypeFlag.Name)),
+	}
+	chainDB := stack.OpenDatabase(dbc)
+	defer chainDB.Close()
+
+	trieCachePath := filepath.Join(ctx.GlobalString(utils.DataDirFlag.Name), "fastcache")
+	prn, err := pruner.NewPruner(chainDB, stack.ResolvePath(""), trieCachePath, ctx.GlobalUint64(utils.BloomFilterSizeFlag.Name))
+	if err != nil {
+		logger.Error("Failed to open snapshot tree", "err", err)
+		return err
+	}
+
+	var targetRoot common.Hash
+	if ctx.NArg() == 1 {
+		targetRoot, err = parseRoot(ctx.Args()[0])
+		if err != nil {
+			logger.Error("Failed to resolve state root", "err", err)
+			return err
+		}
+	}
+	if err = prn.Prune(targetRoot); err != nil {
+		logger.Error("Failed to prune state", "err", err)
+		return err
+	}
+	return nil
+}
+
+func parseRoot(input string) (common.Hash, error) {
+	var h common.Hash
+	if err := h.UnmarshalText([]byte(input)); err != nil {
+		return h, err
+	}
+	return h, nil
+}
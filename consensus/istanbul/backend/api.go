@@ -32,7 +32,10 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/consensus/istanbul"
+	istanbulCore "github.com/klaytn/klaytn/consensus/istanbul/core"
 	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/reward"
 )
 
 // API is a user facing RPC API to dump Istanbul state
@@ -191,6 +194,60 @@ func (api *API) Discard(address common.Address) {
 	delete(api.istanbul.candidates, address)
 }
 
+// GetRoundHistory returns the timeline of each consensus round of the given block number
+// reconstructed from the consensus message journal, including the late and missing validators.
+// The pending block number refers to the block in progress.
+func (api *API) GetRoundHistory(number *rpc.BlockNumber) ([]*istanbulCore.RoundHistory, error) {
+	var sequence uint64
+	if number == nil || *number == rpc.LatestBlockNumber {
+		sequence = api.chain.CurrentHeader().Number.Uint64()
+	} else if *number == rpc.PendingBlockNumber {
+		sequence = api.chain.CurrentHeader().Number.Uint64() + 1
+	} else {
+		sequence = uint64(number.Int64())
+	}
+	return api.istanbul.core.RoundHistory(sequence)
+}
+
+// GetValidatorStats returns the consensus participation of each validator over the given
+// block range: the blocks proposed, the rounds missed as a proposer, the committed seal
+// participation and the blocks committed at round > 0. Only the blocks indexed with the
+// validator stats option are served.
+func (api *API) GetValidatorStats(start, end rpc.BlockNumber) (*ValidatorStatsResult, error) {
+	latest := api.chain.CurrentHeader().Number.Uint64()
+	resolve := func(number rpc.BlockNumber) (uint64, error) {
+		switch number {
+		case rpc.LatestBlockNumber:
+			return latest, nil
+		case rpc.PendingBlockNumber:
+			return 0, errPendingNotAllowed
+		}
+		return uint64(number.Int64()), nil
+	}
+	s, err := resolve(start)
+	if err != nil {
+		return nil, err
+	}
+	e, err := resolve(end)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == 0 {
+		return nil, errNoValidatorStatsForGenesis
+	}
+	if e > latest {
+		return nil, errEndLargetThanLatest
+	}
+	if s > e {
+		return nil, errStartLargerThanEnd
+	}
+	if e-s >= maxValidatorStatsRange {
+		return nil, errValidatorStatsRangeTooLarge
+	}
+	return api.istanbul.validatorStats(api.chain, s, e)
+}
+
 // API extended by Klaytn developers
 type APIExtension struct {
 	chain    consensus.ChainReader
@@ -208,6 +265,7 @@ var (
 	errExtractIstanbulExtra    = errors.New("extract Istanbul Extra from block header of the given block number")
 	errNoBlockExist            = errors.New("block with the given block number is not existed")
 	errNoBlockNumber           = errors.New("block number is not assigned")
+	errNoRewardForGenesis      = errors.New("the genesis block has no block reward")
 )
 
 // GetCouncil retrieves the list of authorized validators at the specified block.
@@ -537,6 +595,42 @@ func (api *APIExtension) GetBlockWithConsensusInfoByHash(blockHash common.Hash)
 	return api.makeRPCBlockOutput(block, cInfo, block.Transactions(), receipts), nil
 }
 
+// GetRewards returns the breakdown of the block reward of the given block.
+// It re-runs the reward distribution logic of Finalize for the block.
+func (api *APIExtension) GetRewards(number *rpc.BlockNumber) (*reward.RewardSpec, error) {
+	header, err := headerByRpcNumber(api.chain, number)
+	if err != nil {
+		return nil, err
+	}
+	if header.Number.Sign() == 0 {
+		return nil, errNoRewardForGenesis
+	}
+
+	sb := api.istanbul
+	// The current policy can differ from the one of the block since it can be changed by vote
+	item, err := sb.governance.GetItemAtNumberByIntKey(header.Number.Uint64(), params.Policy)
+	if err != nil {
+		logger.Error("Couldn't get the proposer policy from governance", "number", header.Number, "err", err)
+		return nil, errInternalError
+	}
+	policy, ok := item.(uint64)
+	if !ok {
+		logger.Error("Invalid type of the proposer policy", "number", header.Number, "type", reflect.TypeOf(item))
+		return nil, errInternalError
+	}
+	distribute := policy == uint64(istanbul.WeightedRandom)
+
+	pocAddr := common.Address{}
+	kirAddr := common.Address{}
+	if distribute {
+		if stakingInfo := reward.GetStakingInfo(header.Number.Uint64()); stakingInfo != nil {
+			kirAddr = stakingInfo.KIRAddr
+			pocAddr = stakingInfo.PoCAddr
+		}
+	}
+	return sb.rewardDistributor.GetBlockReward(header, distribute, pocAddr, kirAddr)
+}
+
 func (api *API) GetTimeout() uint64 {
 	return istanbul.DefaultConfig.Timeout
 }
@@ -123,6 +123,11 @@ type backend struct {
 
 	// Node type
 	nodetype common.ConnType
+
+	// The validator stats indexer receiving the new heads, and the last block number indexed by it
+	statsCh          chan *types.Header
+	statsOnce        sync.Once
+	lastStatsIndexed uint64
 }
 
 func (sb *backend) NodeType() common.ConnType {
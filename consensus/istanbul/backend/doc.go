@@ -27,6 +27,7 @@ Implementation of Backend interface and APIs are included in this package
  - `engine.go`: Implements various backend methods especially for verifying and building header information
  - `handler.go`: Implements backend methods for handling messages and broadcaster
  - `snapshot.go`: Defines snapshot struct which handles votes from nodes and makes governance changes
+ - `validator_stats.go`: Indexes the consensus participation of the validators from the committed seals and aggregates it over block ranges
 
 */
 package backend
@@ -134,6 +134,10 @@ func (sb *backend) RegisterConsensusMsgCode(peer consensus.Peer) {
 }
 
 func (sb *backend) NewChainHead() error {
+	if sb.config.ValidatorStats && sb.chain != nil {
+		sb.notifyValidatorStats(sb.chain.CurrentHeader())
+	}
+
 	sb.coreMu.RLock()
 	defer sb.coreMu.RUnlock()
 	if !sb.coreStarted {
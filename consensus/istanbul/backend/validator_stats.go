@@ -0,0 +1,278 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	istanbulCore "github.com/klaytn/klaytn/consensus/istanbul/core"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/rcrowley/go-metrics"
+)
+
+const (
+	// maxValidatorStatsRange is the maximum number of blocks aggregated by a request,
+	// and the maximum number of blocks indexed at once.
+	maxValidatorStatsRange = 3600
+
+	// validatorStatsChanSize is the size of the channel of the heads to be indexed.
+	// A head is skipped if the indexer is busy, and it is indexed with the next head.
+	validatorStatsChanSize = 1
+)
+
+var (
+	errValidatorStatsRangeTooLarge = fmt.Errorf("number of requested blocks should not be larger than %d", maxValidatorStatsRange)
+	errNoValidatorStatsForGenesis  = errors.New("the genesis block has no committed seals")
+	errValidatorStatsNotIndexed    = errors.New("validator stats are not indexed for the block")
+
+	roundChangeBlocksCounter = metrics.NewRegisteredCounter("consensus/istanbul/validator/roundChangeBlocks", nil)
+)
+
+// blockValidatorStats is the consensus participation of the validators in a block.
+// It is persisted by the block hash once computed.
+type blockValidatorStats struct {
+	Proposer        common.Address
+	Round           uint64
+	MissedProposers []common.Address // The proposers of the rounds before the block was proposed
+	Committee       []common.Address
+	Committers      []common.Address // The signers of the committed seals
+}
+
+// ValidatorStats is the consensus participation of a validator over a block range.
+type ValidatorStats struct {
+	Proposed          uint64  `json:"proposed"`          // The number of blocks proposed
+	MissedProposals   uint64  `json:"missedProposals"`   // The number of rounds the validator failed to get its proposal committed
+	CommitteeBlocks   uint64  `json:"committeeBlocks"`   // The number of blocks the validator was a committee member of
+	CommittedBlocks   uint64  `json:"committedBlocks"`   // The number of blocks containing the committed seal of the validator
+	CommitRate        float64 `json:"commitRate"`        // CommittedBlocks over CommitteeBlocks
+	RoundChangeBlocks uint64  `json:"roundChangeBlocks"` // The number of committee blocks committed at round > 0
+}
+
+// ValidatorStatsResult is the consensus participation of the validators over a block range.
+type ValidatorStatsResult struct {
+	Start             uint64                             `json:"start"`
+	End               uint64                             `json:"end"`
+	RoundChangeBlocks uint64                             `json:"roundChangeBlocks"`
+	Validators        map[common.Address]*ValidatorStats `json:"validators"`
+}
+
+// readValidatorStats returns the indexed consensus participation of the validators in the given block.
+func (sb *backend) readValidatorStats(hash common.Hash) (*blockValidatorStats, error) {
+	blob, err := sb.db.ReadValidatorStats(hash)
+	if err != nil {
+		return nil, errValidatorStatsNotIndexed
+	}
+	stats := new(blockValidatorStats)
+	if err := rlp.DecodeBytes(blob, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// blockValidatorStats returns the consensus participation of the validators in the given block.
+// It is computed from the committed seals of the block and stored if it is not indexed yet.
+func (sb *backend) blockValidatorStats(chain consensus.ChainReader, header *types.Header) (*blockValidatorStats, error) {
+	hash := header.Hash()
+	if stats, err := sb.readValidatorStats(hash); err == nil {
+		return stats, nil
+	}
+
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, errNoValidatorStatsForGenesis
+	}
+	snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := ecrecover(header)
+	if err != nil {
+		return nil, err
+	}
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &blockValidatorStats{Proposer: proposer, Round: uint64(header.Round())}
+
+	// The proposers of the previous rounds are calculated from the proposer of the parent block
+	lastProposer := common.Address{}
+	if parent := chain.GetHeader(header.ParentHash, number-1); parent != nil {
+		lastProposer, _ = ecrecover(parent)
+	}
+	for round := uint64(0); round < stats.Round; round++ {
+		valSet := snap.ValSet.Copy()
+		valSet.CalcProposer(lastProposer, round)
+		stats.MissedProposers = append(stats.MissedProposers, valSet.GetProposer().Address())
+	}
+
+	view := &istanbul.View{
+		Sequence: new(big.Int).SetUint64(number),
+		Round:    new(big.Int).SetUint64(stats.Round),
+	}
+	for _, val := range snap.ValSet.SubListWithProposer(header.ParentHash, proposer, view) {
+		stats.Committee = append(stats.Committee, val.Address())
+	}
+
+	proposalSeal := istanbulCore.PrepareCommittedSeal(hash)
+	for _, seal := range extra.CommittedSeal {
+		addr, err := cacheSignatureAddresses(proposalSeal, seal)
+		if err != nil {
+			return nil, err
+		}
+		stats.Committers = append(stats.Committers, addr)
+	}
+
+	blob, err := rlp.EncodeToBytes(stats)
+	if err != nil {
+		return nil, err
+	}
+	if err := sb.db.WriteValidatorStats(hash, blob); err != nil {
+		logger.Warn("Failed to store validator stats", "number", number, "hash", hash, "err", err)
+	}
+	return stats, nil
+}
+
+// notifyValidatorStats sends the new head to the validator stats indexer without blocking,
+// starting the indexer at first.
+func (sb *backend) notifyValidatorStats(head *types.Header) {
+	sb.statsOnce.Do(func() {
+		sb.statsCh = make(chan *types.Header, validatorStatsChanSize)
+		go sb.validatorStatsLoop()
+	})
+	select {
+	case sb.statsCh <- head:
+	default:
+	}
+}
+
+// validatorStatsLoop indexes the validator stats of the heads sent by NewChainHead apart from
+// the consensus. It runs for the lifetime of the backend.
+func (sb *backend) validatorStatsLoop() {
+	for head := range sb.statsCh {
+		sb.indexValidatorStats(sb.chain, head)
+	}
+}
+
+// indexValidatorStats indexes the consensus participation of the validators in the
+// blocks up to the given head and updates the metrics of the validators. The blocks
+// after the previously indexed head are indexed if there are not too many of them.
+func (sb *backend) indexValidatorStats(chain consensus.ChainReader, head *types.Header) {
+	number := head.Number.Uint64()
+	if number == sb.lastStatsIndexed {
+		return
+	}
+	from := number
+	if last := sb.lastStatsIndexed; last != 0 && last < number && number-last <= maxValidatorStatsRange {
+		from = last + 1
+	}
+	for ; from <= number && from > 0; from++ {
+		header := head
+		if from != number {
+			if header = chain.GetHeaderByNumber(from); header == nil {
+				break
+			}
+		}
+		stats, err := sb.blockValidatorStats(chain, header)
+		if err != nil {
+			logger.Warn("Failed to index validator stats", "number", from, "err", err)
+			break
+		}
+		updateValidatorMetrics(stats)
+	}
+	sb.lastStatsIndexed = number
+}
+
+// updateValidatorMetrics adds the consensus participation in a block to the metrics of the validators.
+func updateValidatorMetrics(stats *blockValidatorStats) {
+	validatorCounter(stats.Proposer, "proposed").Inc(1)
+	for _, addr := range stats.MissedProposers {
+		validatorCounter(addr, "missedProposals").Inc(1)
+	}
+	for _, addr := range stats.Committee {
+		validatorCounter(addr, "committeeBlocks").Inc(1)
+		if stats.Round > 0 {
+			validatorCounter(addr, "roundChangeBlocks").Inc(1)
+		}
+	}
+	for _, addr := range stats.Committers {
+		validatorCounter(addr, "committedBlocks").Inc(1)
+	}
+	if stats.Round > 0 {
+		roundChangeBlocksCounter.Inc(1)
+	}
+}
+
+// validatorCounter returns the counter of the given validator, registering it if it doesn't exist.
+func validatorCounter(addr common.Address, name string) metrics.Counter {
+	return metrics.GetOrRegisterCounter(fmt.Sprintf("consensus/istanbul/validator/%s/%s", addr.Hex(), name), nil)
+}
+
+// validatorStats aggregates the indexed consensus participation of the validators over the given block range.
+// It returns errValidatorStatsNotIndexed if a block in the range is not indexed.
+func (sb *backend) validatorStats(chain consensus.ChainReader, start, end uint64) (*ValidatorStatsResult, error) {
+	result := &ValidatorStatsResult{Start: start, End: end, Validators: make(map[common.Address]*ValidatorStats)}
+	get := func(addr common.Address) *ValidatorStats {
+		if _, ok := result.Validators[addr]; !ok {
+			result.Validators[addr] = new(ValidatorStats)
+		}
+		return result.Validators[addr]
+	}
+
+	for number := start; number <= end; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errNoBlockExist
+		}
+		stats, err := sb.readValidatorStats(header.Hash())
+		if err != nil {
+			return nil, err
+		}
+
+		get(stats.Proposer).Proposed++
+		for _, addr := range stats.MissedProposers {
+			get(addr).MissedProposals++
+		}
+		for _, addr := range stats.Committee {
+			v := get(addr)
+			v.CommitteeBlocks++
+			if stats.Round > 0 {
+				v.RoundChangeBlocks++
+			}
+		}
+		for _, addr := range stats.Committers {
+			get(addr).CommittedBlocks++
+		}
+		if stats.Round > 0 {
+			result.RoundChangeBlocks++
+		}
+	}
+
+	for _, v := range result.Validators {
+		if v.CommitteeBlocks > 0 {
+			v.CommitRate = float64(v.CommittedBlocks) / float64(v.CommitteeBlocks)
+		}
+	}
+	return result, nil
+}
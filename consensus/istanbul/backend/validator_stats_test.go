@@ -0,0 +1,125 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// insertBlocksWithSeal inserts n blocks committed by all validators on top of the current block.
+func insertBlocksWithSeal(t *testing.T, chain *blockchain.BlockChain, engine *backend, n int) {
+	parent := chain.CurrentBlock()
+	for i := 0; i < n; i++ {
+		block := makeBlockWithSeal(chain, engine, parent)
+		_, err := chain.InsertChain(types.Blocks{block})
+		require.NoError(t, err)
+		parent = block
+	}
+}
+
+func TestAPI_GetValidatorStats(t *testing.T) {
+	chain, engine := newBlockChain(4, proposerPolicy(params.WeightedRandom), blockPeriod(0))
+	defer engine.Stop()
+	insertBlocksWithSeal(t, chain, engine, 5)
+
+	// The API serves only the indexed blocks, and it does not index them
+	api := &API{chain: chain, istanbul: engine}
+	_, err := api.GetValidatorStats(rpc.BlockNumber(1), rpc.LatestBlockNumber)
+	assert.Equal(t, errValidatorStatsNotIndexed, err)
+	for i := uint64(1); i <= 5; i++ {
+		_, err := engine.db.ReadValidatorStats(chain.GetHeaderByNumber(i).Hash())
+		assert.Error(t, err)
+	}
+
+	for i := uint64(1); i <= 5; i++ {
+		engine.indexValidatorStats(chain, chain.GetHeaderByNumber(i))
+	}
+	stats, err := api.GetValidatorStats(rpc.BlockNumber(1), rpc.LatestBlockNumber)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1), stats.Start)
+	assert.Equal(t, uint64(5), stats.End)
+	assert.Equal(t, uint64(0), stats.RoundChangeBlocks)
+	require.Len(t, stats.Validators, len(addrs))
+	for _, addr := range addrs {
+		v := stats.Validators[addr]
+		require.NotNil(t, v)
+		assert.Equal(t, uint64(5), v.CommitteeBlocks)
+		assert.Equal(t, uint64(5), v.CommittedBlocks)
+		assert.Equal(t, float64(1), v.CommitRate)
+		assert.Equal(t, uint64(0), v.MissedProposals)
+	}
+	assert.Equal(t, uint64(5), stats.Validators[engine.address].Proposed)
+
+	// The stats of each block are persisted
+	for i := uint64(1); i <= 5; i++ {
+		_, err := engine.db.ReadValidatorStats(chain.GetHeaderByNumber(i).Hash())
+		assert.NoError(t, err)
+	}
+
+	// Invalid ranges
+	_, err = api.GetValidatorStats(rpc.BlockNumber(0), rpc.LatestBlockNumber)
+	assert.Equal(t, errNoValidatorStatsForGenesis, err)
+	_, err = api.GetValidatorStats(rpc.BlockNumber(1), rpc.BlockNumber(6))
+	assert.Equal(t, errEndLargetThanLatest, err)
+	_, err = api.GetValidatorStats(rpc.BlockNumber(3), rpc.BlockNumber(2))
+	assert.Equal(t, errStartLargerThanEnd, err)
+	_, err = api.GetValidatorStats(rpc.BlockNumber(1), rpc.PendingBlockNumber)
+	assert.Equal(t, errPendingNotAllowed, err)
+}
+
+func TestBackend_indexValidatorStats(t *testing.T) {
+	chain, engine := newBlockChain(4, proposerPolicy(params.WeightedRandom), blockPeriod(0))
+	defer engine.Stop()
+
+	// The test backends share the default config
+	engine.config.ValidatorStats = true
+	defer func() { engine.config.ValidatorStats = false }()
+
+	proposed := validatorCounter(engine.address, "proposed")
+	committed := validatorCounter(addrs[1], "committedBlocks")
+
+	// Only the head is indexed at first
+	insertBlocksWithSeal(t, chain, engine, 3)
+	engine.indexValidatorStats(chain, chain.CurrentHeader())
+	assert.Equal(t, int64(1), proposed.Count())
+	assert.Equal(t, int64(1), committed.Count())
+
+	// The blocks after the last indexed head are indexed
+	insertBlocksWithSeal(t, chain, engine, 2)
+	engine.indexValidatorStats(chain, chain.CurrentHeader())
+	assert.Equal(t, int64(3), proposed.Count())
+	assert.Equal(t, int64(3), committed.Count())
+
+	// The same head is not indexed twice
+	engine.indexValidatorStats(chain, chain.CurrentHeader())
+	assert.Equal(t, int64(3), proposed.Count())
+
+	// NewChainHead indexes the new heads in the background
+	insertBlocksWithSeal(t, chain, engine, 1)
+	engine.NewChainHead()
+	assert.Eventually(t, func() bool { return proposed.Count() == 4 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(4), committed.Count())
+}
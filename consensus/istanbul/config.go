@@ -34,6 +34,11 @@ type Config struct {
 	ProposerPolicy ProposerPolicy `toml:",omitempty"` // The policy for proposer selection
 	Epoch          uint64         `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
 	SubGroupSize   uint64         `toml:",omitempty"`
+
+	Journal     string `toml:",omitempty"` // File name of the consensus message journal (disabled if empty)
+	JournalSize uint64 `toml:",omitempty"` // The maximum size of the consensus message journal in bytes
+
+	ValidatorStats bool `toml:",omitempty"` // Index the consensus participation of the validators in new blocks and export it as metrics
 }
 
 // TODO-Klaytn-Istanbul: Do not use DefaultConfig except for assigning new config
@@ -43,4 +48,5 @@ var DefaultConfig = &Config{
 	ProposerPolicy: RoundRobin,
 	Epoch:          30000,
 	SubGroupSize:   21,
+	JournalSize:    64 * 1024 * 1024,
 }
@@ -63,6 +63,9 @@ func New(backend istanbul.Backend, config *istanbul.Config) Engine {
 		hashLockGauge:      metrics.NewRegisteredGauge("consensus/istanbul/core/hashLock", nil),
 	}
 	c.validateFn = c.checkValidatorSignature
+	if config.Journal != "" {
+		c.journal = newMsgJournal(config.Journal, config.JournalSize)
+	}
 	return c
 }
 
@@ -95,6 +98,9 @@ type core struct {
 	pendingRequests   *prque.Prque
 	pendingRequestsMu *sync.Mutex
 
+	// the journal of consensus messages, nil if disabled
+	journal *msgJournal
+
 	consensusTimestamp time.Time
 	// the meter to record the round change rate
 	roundMeter metrics.Meter
@@ -156,7 +162,9 @@ func (c *core) broadcast(msg *message) {
 	}
 
 	// Broadcast payload
-	if err = c.backend.Broadcast(msg.Hash, c.valSet, payload); err != nil {
+	err = c.backend.Broadcast(msg.Hash, c.valSet, payload)
+	c.journalMessage(journalSent, msg, time.Now(), err)
+	if err != nil {
 		logger.Error("Failed to broadcast message", "msg", msg, "err", err)
 		return
 	}
@@ -274,6 +282,7 @@ func (c *core) startNewRound(round *big.Int) {
 	c.updateRoundState(newView, c.valSet, roundChange)
 	// Calculate new proposer
 	c.valSet.CalcProposer(lastProposer, newView.Round.Uint64())
+	c.journalRound(journalRoundStart, newView)
 	c.waitingForRoundChange = false
 	c.setState(StateAcceptRequest)
 	if roundChange && c.isProposer() && c.current != nil {
@@ -305,6 +314,7 @@ func (c *core) catchUpRound(view *istanbul.View) {
 	// Need to keep block locked for round catching up
 	c.updateRoundState(view, c.valSet, true)
 	c.roundChangeSet.Clear(view.Round)
+	c.journalRound(journalCatchUp, view)
 
 	c.newRoundChangeTimer()
 	logger.Warn("[RC] Catch up round", "new_round", view.Round, "new_seq", view.Sequence, "new_proposer", c.valSet.GetProposer())
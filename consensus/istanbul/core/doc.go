@@ -34,10 +34,12 @@ Core package is composed of following files
  - `events.go`: Defines backlog event and timeout event
  - `final_committed.go`: Start a new round when a final committed proposal is stored
  - `handler.go`: Implements core.Engine.Start and Stop. Provides event and message hendlers
+ - `journal.go`: Implements the size-bounded journal of consensus messages sent and received
  - `message_set.go`: Defines messageSet struct which has a validator set and messages from other nodes
  - `prepare.go`: Implements core methods which send, receive, handle, verify and accept prepare phase messages
  - `preprepare.go`: Implements core methods which send, handle and accept preprepare messages
  - `request.go`: Implements core methods which handle, check, store and process preprepare messages
+ - `round_history.go`: Implements core.Engine.RoundHistory reconstructing the timeline of rounds from the journal
  - `roundchange.go`: Implement core methods receiving and handling roundchange messages
  - `roundstate.go`: Defines roundState struct which has messages of each phase for a round
  - `types.go`: Defines Engine interface and message, State type
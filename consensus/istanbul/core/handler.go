@@ -21,6 +21,9 @@
 package core
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/consensus/istanbul"
 )
@@ -45,6 +48,12 @@ func (c *core) Stop() error {
 
 	// Make sure the handler goroutine exits
 	c.handlerWg.Wait()
+
+	if c.journal != nil {
+		if err := c.journal.close(); err != nil {
+			c.logger.Warn("Failed to close consensus journal", "err", err)
+		}
+	}
 	return nil
 }
 
@@ -111,7 +120,9 @@ func (c *core) handleEvents() {
 					continue
 				}
 				// No need to check signature for internal messages
-				if err := c.handleCheckedMsg(ev.msg, src); err == nil {
+				err := c.handleCheckedMsg(ev.msg, src)
+				c.journalMessage(journalBacklog, ev.msg, time.Now(), err)
+				if err == nil {
 					p, err := ev.msg.Payload()
 					if err != nil {
 						c.logger.Warn("Get message payload failed", "err", err)
@@ -151,10 +162,12 @@ func (c *core) sendEvent(ev interface{}) {
 
 func (c *core) handleMsg(payload []byte) error {
 	logger := c.logger.NewWith()
+	arrival := time.Now()
 
 	// Decode message and check its signature
 	msg := new(message)
 	if err := msg.FromPayload(payload, c.validateFn); err != nil {
+		c.journalMessage(journalReceived, msg, arrival, err)
 		if c.backend.NodeType() == common.CONSENSUSNODE {
 			if err != istanbul.ErrUnauthorizedAddress {
 				logger.Error("Failed to decode message from payload", "err", err)
@@ -179,10 +192,13 @@ func (c *core) handleMsg(payload []byte) error {
 	_, src := c.valSet.GetByAddress(msg.Address)
 	if src == nil {
 		logger.Error("Invalid address in message", "msg", msg)
+		c.journalMessage(journalReceived, msg, arrival, istanbul.ErrUnauthorizedAddress)
 		return istanbul.ErrUnauthorizedAddress
 	}
 
-	return c.handleCheckedMsg(msg, src)
+	err := c.handleCheckedMsg(msg, src)
+	c.journalMessage(journalReceived, msg, arrival, err)
+	return err
 }
 
 func (c *core) handleCheckedMsg(msg *message, src istanbul.Validator) error {
@@ -232,6 +248,10 @@ func (c *core) handleTimeoutMsg(nextView *istanbul.View) {
 			"blockNumber", lastProposal.Number().Uint64(), "msgView", nextView.String())
 		return
 	}
+	c.journalRound(journalTimeout, &istanbul.View{
+		Sequence: nextView.Sequence,
+		Round:    new(big.Int).Sub(nextView.Round, common.Big1),
+	})
 
 	// If we're not waiting for round change yet, we can try to catch up
 	// the max round with F+1 round change message. We only need to catch up
@@ -0,0 +1,299 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+// errJournalDisabled is returned if the round history is requested, but the
+// consensus message journal is not enabled.
+var errJournalDisabled = errors.New("consensus message journal is disabled")
+
+// The kinds of the journal entries.
+const (
+	journalSent       uint64 = iota // A message broadcast by this node
+	journalReceived                 // A message received from a validator, including this node
+	journalBacklog                  // A future message processed from the backlog
+	journalRoundStart               // A new round started with a newly calculated proposer
+	journalCatchUp                  // The round is caught up by round change messages
+	journalTimeout                  // The round change timer of the round expired
+)
+
+var journalKindNames = map[uint64]string{
+	journalSent:       "sent",
+	journalReceived:   "received",
+	journalBacklog:    "backlog",
+	journalRoundStart: "roundStart",
+	journalCatchUp:    "catchUp",
+	journalTimeout:    "timeout",
+}
+
+var msgCodeNames = map[uint64]string{
+	msgPreprepare:  "preprepare",
+	msgPrepare:     "prepare",
+	msgCommit:      "commit",
+	msgRoundChange: "roundchange",
+}
+
+// journalEntry is a consensus message or a round event stored in the journal.
+type journalEntry struct {
+	Time      uint64 // Unix time in nanoseconds when the message arrived or the event happened
+	Kind      uint64
+	Code      uint64
+	Sequence  uint64
+	Round     uint64
+	Address   common.Address   // The sender of the message, or the proposer of a new round
+	Digest    common.Hash      // The proposal hash of the message
+	Committee []common.Address // The committee of a new round
+	Quorum    uint64           // The number of messages required for a new round to make progress
+	Err       string           // The outcome of handling the message
+}
+
+// msgJournal is a size-bounded log of consensus messages. If the journal file
+// grows larger than the limit, it is moved aside to a backup file replacing the
+// previous one, so that at most twice the limit is kept on disk.
+type msgJournal struct {
+	path    string // Filesystem path to store the entries at
+	maxSize uint64 // The maximum size of the journal file
+
+	writer *os.File // Output stream to write new entries into
+	size   uint64   // The current size of the journal file
+	mu     sync.Mutex
+}
+
+// newMsgJournal creates a new consensus message journal. The journal file is
+// opened by the first insertion.
+func newMsgJournal(path string, maxSize uint64) *msgJournal {
+	return &msgJournal{
+		path:    path,
+		maxSize: maxSize,
+	}
+}
+
+// insert appends the entry to the journal file, rotating it if it is full.
+func (journal *msgJournal) insert(entry *journalEntry) error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	if journal.writer != nil && journal.maxSize > 0 && journal.size >= journal.maxSize {
+		if err := journal.rotate(); err != nil {
+			return err
+		}
+	}
+	if journal.writer == nil {
+		writer, err := os.OpenFile(journal.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		info, err := writer.Stat()
+		if err != nil {
+			writer.Close()
+			return err
+		}
+		journal.writer, journal.size = writer, uint64(info.Size())
+	}
+	data, err := rlp.EncodeToBytes(entry)
+	if err != nil {
+		return err
+	}
+	n, err := journal.writer.Write(data)
+	journal.size += uint64(n)
+	return err
+}
+
+// rotate moves the full journal file to the backup file. The caller must hold the lock.
+func (journal *msgJournal) rotate() error {
+	if err := journal.writer.Close(); err != nil {
+		return err
+	}
+	journal.writer, journal.size = nil, 0
+	return os.Rename(journal.path, journal.path+".old")
+}
+
+// load returns the journal entries of the given sequence in the order of insertion.
+// Only opening the files is done under the lock, so that the consensus is not
+// blocked by a long scan. The entries appended during the scan are ignored.
+func (journal *msgJournal) load(sequence uint64) ([]*journalEntry, error) {
+	inputs, err := journal.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	var entries []*journalEntry
+	for _, input := range inputs {
+		stream := rlp.NewStream(input.reader, 0)
+		for {
+			entry := new(journalEntry)
+			if err = stream.Decode(entry); err != nil {
+				// A truncated entry can be left at the end of the file if the node crashed while writing it
+				if err != io.EOF {
+					logger.Warn("Failed to decode consensus journal entry", "path", input.file.Name(), "err", err)
+				}
+				break
+			}
+			if entry.Sequence == sequence {
+				entries = append(entries, entry)
+			}
+		}
+		input.file.Close()
+	}
+	return entries, nil
+}
+
+// journalInput is a read handle of a journal file bounded by its size at the time of opening.
+type journalInput struct {
+	file   *os.File
+	reader io.Reader
+}
+
+// snapshot opens the backup and the current journal files, bounding each of
+// them by its current size. An open handle keeps reading the same file even if
+// it is rotated afterwards.
+func (journal *msgJournal) snapshot() ([]journalInput, error) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	var inputs []journalInput
+	for _, path := range []string{journal.path + ".old", journal.path} {
+		file, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			for _, input := range inputs {
+				input.file.Close()
+			}
+			return nil, err
+		}
+		var size int64
+		if path == journal.path && journal.writer != nil {
+			size = int64(journal.size)
+		} else if info, err := file.Stat(); err == nil {
+			size = info.Size()
+		}
+		inputs = append(inputs, journalInput{file: file, reader: bufio.NewReader(io.LimitReader(file, size))})
+	}
+	return inputs, nil
+}
+
+// close closes the journal file.
+func (journal *msgJournal) close() error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	var err error
+	if journal.writer != nil {
+		err = journal.writer.Close()
+		journal.writer = nil
+	}
+	return err
+}
+
+// journalMessage writes the consensus message to the journal with the outcome of
+// handling it. Messages whose view can't be decoded are not journaled.
+func (c *core) journalMessage(kind uint64, msg *message, at time.Time, outcome error) {
+	if c.journal == nil {
+		return
+	}
+	view, digest, err := msg.viewAndDigest()
+	if err != nil {
+		return
+	}
+	entry := &journalEntry{
+		Time:     uint64(at.UnixNano()),
+		Kind:     kind,
+		Code:     msg.Code,
+		Sequence: view.Sequence.Uint64(),
+		Round:    view.Round.Uint64(),
+		Address:  msg.Address,
+		Digest:   digest,
+	}
+	if outcome != nil {
+		entry.Err = outcome.Error()
+	}
+	c.insertJournal(entry)
+}
+
+// journalRound writes a round event of the given view to the journal.
+func (c *core) journalRound(kind uint64, view *istanbul.View) {
+	if c.journal == nil {
+		return
+	}
+	entry := &journalEntry{
+		Time:     uint64(time.Now().UnixNano()),
+		Kind:     kind,
+		Sequence: view.Sequence.Uint64(),
+		Round:    view.Round.Uint64(),
+	}
+	if kind == journalRoundStart {
+		lastProposal, _ := c.backend.LastProposal()
+		for _, val := range c.valSet.SubList(lastProposal.Hash(), view) {
+			entry.Committee = append(entry.Committee, val.Address())
+		}
+		if proposer := c.valSet.GetProposer(); proposer != nil {
+			entry.Address = proposer.Address()
+		}
+		entry.Quorum = uint64(requiredMessageCount(c.valSet))
+	}
+	c.insertJournal(entry)
+}
+
+func (c *core) insertJournal(entry *journalEntry) {
+	if err := c.journal.insert(entry); err != nil {
+		c.logger.Warn("Failed to write consensus journal", "err", err)
+	}
+}
+
+// viewAndDigest returns the view and the proposal hash of the message. The
+// proposal hash of a preprepare is taken from the cache filled by the handler
+// or by the sender, and only the header of the proposal is decoded otherwise.
+func (m *message) viewAndDigest() (*istanbul.View, common.Hash, error) {
+	switch m.Code {
+	case msgPreprepare:
+		if m.view != nil {
+			return m.view, m.digest, nil
+		}
+		var preprepare struct {
+			View     *istanbul.View
+			Proposal struct {
+				Header *types.Header
+				Txs    rlp.RawValue
+			}
+		}
+		if err := m.Decode(&preprepare); err != nil {
+			return nil, common.Hash{}, err
+		}
+		return preprepare.View, preprepare.Proposal.Header.Hash(), nil
+	case msgPrepare, msgCommit, msgRoundChange:
+		var subject *istanbul.Subject
+		if err := m.Decode(&subject); err != nil {
+			return nil, common.Hash{}, err
+		}
+		return subject.View, subject.Digest, nil
+	default:
+		return nil, common.Hash{}, errInvalidMessage
+	}
+}
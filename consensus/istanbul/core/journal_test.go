@@ -0,0 +1,218 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/fork"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgJournal_rotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "istanbul-journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "consensus.rlp")
+	journal := newMsgJournal(path, 100)
+	defer journal.close()
+
+	// The journal file holds two entries at most, so it is rotated several times
+	for i := uint64(0); i < 8; i++ {
+		require.NoError(t, journal.insert(&journalEntry{Time: i, Kind: journalReceived, Sequence: 1 + i%2, Round: i}))
+	}
+	_, err = os.Stat(path + ".old")
+	require.NoError(t, err)
+
+	entries, err := journal.load(2)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		assert.Equal(t, uint64(2), entry.Sequence)
+		if i > 0 {
+			assert.True(t, entries[i-1].Time < entry.Time)
+		}
+	}
+	// The oldest entries are dropped
+	assert.True(t, len(entries) < 4)
+	assert.Equal(t, uint64(7), entries[len(entries)-1].Time)
+}
+
+func TestMsgJournal_loadDuringInsert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "istanbul-journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	journal := newMsgJournal(filepath.Join(dir, "consensus.rlp"), 0)
+	defer journal.close()
+	for i := uint64(0); i < 4; i++ {
+		require.NoError(t, journal.insert(&journalEntry{Time: i, Kind: journalReceived, Sequence: 1}))
+	}
+
+	// Entries inserted after the files are opened are not seen by the scan
+	inputs, err := journal.snapshot()
+	require.NoError(t, err)
+	require.NoError(t, journal.insert(&journalEntry{Time: 4, Kind: journalReceived, Sequence: 1}))
+	for _, input := range inputs {
+		input.file.Close()
+	}
+
+	entries, err := journal.load(1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(entries))
+}
+
+func TestMessage_viewAndDigest(t *testing.T) {
+	tx := types.NewTransaction(0, common.Address{1}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(5), Time: big.NewInt(1), BlockScore: big.NewInt(1)}).
+		WithBody([]*types.Transaction{tx})
+	view := &istanbul.View{Sequence: big.NewInt(5), Round: big.NewInt(2)}
+
+	encoded, err := Encode(&istanbul.Preprepare{View: view, Proposal: block})
+	require.NoError(t, err)
+
+	// The proposal hash is taken from the header without decoding the transactions
+	msg := &message{Code: msgPreprepare, Msg: encoded}
+	msgView, digest, err := msg.viewAndDigest()
+	require.NoError(t, err)
+	assert.Equal(t, view.String(), msgView.String())
+	assert.Equal(t, block.Hash(), digest)
+
+	// The cached view and proposal hash are used if the message has been handled
+	msg.view, msg.digest = &istanbul.View{Sequence: big.NewInt(5), Round: big.NewInt(3)}, common.Hash{1}
+	msgView, digest, err = msg.viewAndDigest()
+	require.NoError(t, err)
+	assert.Equal(t, msg.view, msgView)
+	assert.Equal(t, common.Hash{1}, digest)
+}
+
+func TestBuildRoundHistory(t *testing.T) {
+	a, b, c, d := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc"), common.HexToAddress("0xd")
+	committee := []common.Address{a, b, c, d}
+
+	msg := func(time, round, code uint64, from common.Address) *journalEntry {
+		return &journalEntry{Time: time, Kind: journalReceived, Code: code, Sequence: 5, Round: round, Address: from}
+	}
+	entries := []*journalEntry{
+		// Round 0 reaches the quorum, but d is late
+		{Time: 1, Kind: journalRoundStart, Sequence: 5, Address: a, Committee: committee, Quorum: 3},
+		msg(2, 0, msgPrepare, a), msg(3, 0, msgPrepare, b), msg(4, 0, msgPrepare, c), msg(5, 0, msgPrepare, d),
+		msg(6, 0, msgCommit, a), msg(7, 0, msgCommit, b),
+		// Non-committee messages don't complete the quorum
+		msg(8, 0, msgCommit, common.HexToAddress("0xe")),
+		msg(9, 0, msgCommit, c),
+
+		// Round 1 times out, c is late and d is missing
+		{Time: 10, Kind: journalRoundStart, Sequence: 5, Round: 1, Address: b, Committee: committee, Quorum: 3},
+		msg(11, 1, msgPrepare, a), msg(12, 1, msgPrepare, b),
+		{Time: 13, Kind: journalTimeout, Sequence: 5, Round: 1},
+		msg(14, 1, msgPrepare, c),
+	}
+	// The entries are sorted by time
+	entries[0], entries[len(entries)-1] = entries[len(entries)-1], entries[0]
+
+	history := buildRoundHistory(entries)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, uint64(0), history[0].Round)
+	assert.Equal(t, &a, history[0].Proposer)
+	assert.Equal(t, committee, history[0].Committee)
+	assert.Len(t, history[0].Events, 9)
+	assert.Equal(t, "roundStart", history[0].Events[0].Kind)
+	assert.Equal(t, "prepare", history[0].Events[1].Code)
+	assert.Equal(t, []common.Address{d}, history[0].Late)
+	assert.Empty(t, history[0].Missing)
+
+	assert.Equal(t, uint64(1), history[1].Round)
+	assert.Equal(t, &b, history[1].Proposer)
+	assert.Equal(t, "timeout", history[1].Events[3].Kind)
+	assert.Equal(t, []common.Address{c}, history[1].Late)
+	assert.Equal(t, []common.Address{d}, history[1].Missing)
+}
+
+func TestCore_RoundHistory(t *testing.T) {
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	dir, err := ioutil.TempDir("", "istanbul-journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	validatorAddrs, validatorKeyMap := genValidators(10)
+	mockBackend, mockCtrl := newMockBackend(t, validatorAddrs)
+	defer mockCtrl.Finish()
+
+	// The journal is disabled by default
+	istConfig := *istanbul.DefaultConfig
+	istConfig.ProposerPolicy = istanbul.WeightedRandom
+	_, err = New(mockBackend, &istConfig).RoundHistory(1)
+	assert.Equal(t, errJournalDisabled, err)
+
+	istConfig.Journal = filepath.Join(dir, "consensus.rlp")
+	istCore := New(mockBackend, &istConfig).(*core)
+	if err := istCore.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer istCore.Stop()
+
+	lastProposal, _ := mockBackend.LastProposal()
+	lastBlock := lastProposal.(*types.Block)
+	proposer := istCore.valSet.GetProposer()
+
+	newProposal, err := genBlock(lastBlock, validatorKeyMap[proposer.Address()])
+	require.NoError(t, err)
+	istanbulMsg, err := genIstanbulMsg(msgPreprepare, lastBlock.Hash(), newProposal, proposer.Address(), validatorKeyMap[proposer.Address()])
+	require.NoError(t, err)
+	require.NoError(t, istCore.handleMsg(istanbulMsg.Payload))
+
+	history, err := istCore.RoundHistory(1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+
+	round := history[0]
+	assert.Equal(t, uint64(1), round.Sequence)
+	assert.Equal(t, proposer.Address(), *round.Proposer)
+	assert.Len(t, round.Committee, len(istCore.valSet.SubList(lastBlock.Hash(), istCore.currentView())))
+	assert.Equal(t, round.Committee, round.Missing)
+
+	var received *RoundEvent
+	for _, ev := range round.Events {
+		if ev.Kind == "received" {
+			received = ev
+		}
+	}
+	require.NotNil(t, received)
+	assert.Equal(t, "preprepare", received.Code)
+	assert.Equal(t, proposer.Address(), *received.Address)
+	assert.Equal(t, newProposal.Hash(), *received.Digest)
+	assert.Empty(t, received.Error)
+
+	// Other sequences have no history
+	history, err = istCore.RoundHistory(2)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
@@ -47,9 +47,11 @@ func (c *core) sendPreprepare(request *istanbul.Request) {
 		}
 
 		c.broadcast(&message{
-			Hash: request.Proposal.ParentHash(),
-			Code: msgPreprepare,
-			Msg:  preprepare,
+			Hash:   request.Proposal.ParentHash(),
+			Code:   msgPreprepare,
+			Msg:    preprepare,
+			view:   curView,
+			digest: request.Proposal.Hash(),
 		})
 	}
 }
@@ -64,6 +66,7 @@ func (c *core) handlePreprepare(msg *message, src istanbul.Validator) error {
 		logger.Error("Failed to decode message", "code", msg.Code, "err", err)
 		return errInvalidMessage
 	}
+	msg.view, msg.digest = preprepare.View, preprepare.Proposal.Hash()
 
 	// Ensure we have the same view with the PRE-PREPARE message
 	// If it is old message, see if we need to broadcast COMMIT
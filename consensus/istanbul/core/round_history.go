@@ -0,0 +1,178 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"sort"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+// RoundEvent is a consensus message or a round event in the timeline of a round.
+type RoundEvent struct {
+	Time    time.Time       `json:"time"`
+	Kind    string          `json:"kind"`
+	Code    string          `json:"code,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Digest  *common.Hash    `json:"digest,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// RoundHistory is the timeline of a consensus round reconstructed from the message journal.
+// Late are the committee members whose PREPARE or COMMIT arrived after the quorum was
+// reached or the round timed out, and Missing are the ones which sent neither message.
+// The committee is known only for the rounds started by this node.
+type RoundHistory struct {
+	Sequence  uint64           `json:"sequence"`
+	Round     uint64           `json:"round"`
+	Proposer  *common.Address  `json:"proposer,omitempty"`
+	Committee []common.Address `json:"committee,omitempty"`
+	Events    []*RoundEvent    `json:"events"`
+	Late      []common.Address `json:"late,omitempty"`
+	Missing   []common.Address `json:"missing,omitempty"`
+}
+
+// RoundHistory implements core.Engine.RoundHistory
+func (c *core) RoundHistory(sequence uint64) ([]*RoundHistory, error) {
+	if c.journal == nil {
+		return nil, errJournalDisabled
+	}
+	entries, err := c.journal.load(sequence)
+	if err != nil {
+		return nil, err
+	}
+	return buildRoundHistory(entries), nil
+}
+
+// buildRoundHistory groups the journal entries of a sequence by round.
+func buildRoundHistory(entries []*journalEntry) []*RoundHistory {
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time < entries[j].Time })
+
+	rounds := make(map[uint64][]*journalEntry)
+	for _, entry := range entries {
+		rounds[entry.Round] = append(rounds[entry.Round], entry)
+	}
+	history := make([]*RoundHistory, 0, len(rounds))
+	for round, entries := range rounds {
+		history = append(history, buildRound(entries[0].Sequence, round, entries))
+	}
+	sort.Slice(history, func(i, j int) bool { return history[i].Round < history[j].Round })
+	return history
+}
+
+// buildRound builds the timeline of a round from its journal entries sorted by time.
+func buildRound(sequence, round uint64, entries []*journalEntry) *RoundHistory {
+	var (
+		h       = &RoundHistory{Sequence: sequence, Round: round, Events: make([]*RoundEvent, 0, len(entries))}
+		quorum  uint64
+		endTime uint64
+
+		// The first arrival time of PREPARE and COMMIT by sender
+		arrivals = map[uint64]map[common.Address]uint64{msgPrepare: {}, msgCommit: {}}
+	)
+	for _, entry := range entries {
+		ev := &RoundEvent{
+			Time:  time.Unix(0, int64(entry.Time)),
+			Kind:  journalKindNames[entry.Kind],
+			Error: entry.Err,
+		}
+		switch entry.Kind {
+		case journalSent, journalReceived, journalBacklog:
+			addr, digest := entry.Address, entry.Digest
+			ev.Code, ev.Address = msgCodeNames[entry.Code], &addr
+			if digest != (common.Hash{}) {
+				ev.Digest = &digest
+			}
+			if entry.Kind == journalReceived {
+				if senders, ok := arrivals[entry.Code]; ok {
+					if _, exist := senders[addr]; !exist {
+						senders[addr] = entry.Time
+					}
+				}
+			}
+		case journalRoundStart:
+			proposer := entry.Address
+			ev.Address = &proposer
+			if h.Committee == nil {
+				h.Proposer, h.Committee, quorum = &proposer, entry.Committee, entry.Quorum
+			}
+		case journalCatchUp, journalTimeout:
+			if endTime == 0 {
+				endTime = entry.Time
+			}
+		}
+		h.Events = append(h.Events, ev)
+	}
+	if h.Committee == nil {
+		return h
+	}
+
+	// Messages from outside of the committee don't count towards the quorum
+	members := make(map[common.Address]bool, len(h.Committee))
+	for _, addr := range h.Committee {
+		members[addr] = true
+	}
+	for _, senders := range arrivals {
+		for addr := range senders {
+			if !members[addr] {
+				delete(senders, addr)
+			}
+		}
+	}
+
+	late := make(map[common.Address]bool)
+	for _, code := range []uint64{msgPrepare, msgCommit} {
+		deadline := quorumTime(arrivals[code], quorum, endTime)
+		if deadline == 0 {
+			continue
+		}
+		for addr, t := range arrivals[code] {
+			if t > deadline {
+				late[addr] = true
+			}
+		}
+	}
+	for _, addr := range h.Committee {
+		if late[addr] {
+			h.Late = append(h.Late, addr)
+		}
+		_, prepared := arrivals[msgPrepare][addr]
+		_, committed := arrivals[msgCommit][addr]
+		if !prepared && !committed {
+			h.Missing = append(h.Missing, addr)
+		}
+	}
+	return h
+}
+
+// quorumTime returns the arrival time of the message completing the quorum, or
+// the end of the round if it came first. It returns 0 if neither is known.
+func quorumTime(arrivals map[common.Address]uint64, quorum, endTime uint64) uint64 {
+	if quorum == 0 || uint64(len(arrivals)) < quorum {
+		return endTime
+	}
+	times := make([]uint64, 0, len(arrivals))
+	for _, t := range arrivals {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	if endTime != 0 && endTime < times[quorum-1] {
+		return endTime
+	}
+	return times[quorum-1]
+}
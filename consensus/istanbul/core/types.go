@@ -32,6 +32,10 @@ import (
 type Engine interface {
 	Start() error
 	Stop() error
+
+	// RoundHistory returns the timeline of each round of the given sequence
+	// reconstructed from the consensus message journal.
+	RoundHistory(sequence uint64) ([]*RoundHistory, error)
 }
 
 type State uint64
@@ -86,6 +90,10 @@ type message struct {
 	Address       common.Address
 	Signature     []byte
 	CommittedSeal []byte
+
+	// The view and the proposal hash of a decoded preprepare, kept for the journal
+	view   *istanbul.View
+	digest common.Hash
 }
 
 // ==============================================
@@ -185,6 +185,31 @@ web3._extend({
 			call: 'chaindatafetcher_stopRangeFetching',
 			params: 0
 		}),
+		new web3._extend.Method({
+			name: 'startJob',
+			call: 'chaindatafetcher_startJob',
+			params: 5
+		}),
+		new web3._extend.Method({
+			name: 'stopJob',
+			call: 'chaindatafetcher_stopJob',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'resumeJob',
+			call: 'chaindatafetcher_resumeJob',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'removeJob',
+			call: 'chaindatafetcher_removeJob',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'jobs',
+			call: 'chaindatafetcher_jobs',
+			params: 0
+		}),
 		new web3._extend.Method({
 			name: 'readCheckpoint',
 			call: 'chaindatafetcher_readCheckpoint',
@@ -296,6 +321,17 @@ web3._extend({
 			call: 'governance_vote',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'dryRunVote',
+			call: 'governance_dryRunVote',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'paramHistory',
+			call: 'governance_paramHistory',
+			params: 1,
+			inputFormatter: [null]
+		}),
 		new web3._extend.Method({
 			name: 'itemsAt',
 			call: 'governance_itemsAt',
@@ -739,6 +775,12 @@ web3._extend({
 			params: 2,
 			inputFormatter: [null, null]
 		}),
+		new web3._extend.Method({
+			name: 'traceCall',
+			call: 'debug_traceCall',
+			params: 3,
+			inputFormatter: [null, web3._extend.formatters.inputDefaultBlockNumberFormatter, null]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -807,6 +849,12 @@ web3._extend({
 				return formatted;
 			}
 		}),
+		new web3._extend.Method({
+			name: 'callBundle',
+			call: 'klay_callBundle',
+			params: 3,
+			inputFormatter: [null, web3._extend.formatters.inputDefaultBlockNumberFormatter, null]
+		}),
 		new web3._extend.Method({
 			name: 'sign',
 			call: 'klay_sign',
@@ -861,6 +909,12 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getRewards',
+			call: 'klay_getRewards',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'gasPriceAt',
 			call: 'klay_gasPriceAt',
@@ -1200,6 +1254,18 @@ web3._extend({
 			name: 'discard',
 			call: 'istanbul_discard',
 			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getRoundHistory',
+			call: 'istanbul_getRoundHistory',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorStats',
+			call: 'istanbul_getValidatorStats',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		})
 	],
 	properties:
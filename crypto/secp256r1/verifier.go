@@ -0,0 +1,48 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package secp256r1 implements the signature verification on the secp256r1 (P-256) curve
+// used by the P256VERIFY precompiled contract (RIP-7212).
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"math/big"
+)
+
+// Verify checks the given signature (r, s) of the hash against the public key (x, y).
+// It returns false if the public key is not a valid point on the curve.
+func Verify(hash []byte, r, s, x, y *big.Int) bool {
+	publicKey := newPublicKey(x, y)
+	if publicKey == nil {
+		return false
+	}
+	return ecdsa.Verify(publicKey, hash, r, s)
+}
+
+// newPublicKey returns the public key of the given coordinates, or nil if they are
+// not a point on the curve or the point at infinity.
+func newPublicKey(x, y *big.Int) *ecdsa.PublicKey {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil
+	}
+	curve := elliptic.P256()
+	if !curve.IsOnCurve(x, y) {
+		return nil
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("klaytn"))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+
+	n := elliptic.P256().Params().N
+	x, y := key.X, key.Y
+	offCurveY := new(big.Int).Add(y, big.NewInt(1))
+
+	testCases := []struct {
+		name     string
+		r, s     *big.Int
+		x, y     *big.Int
+		expected bool
+	}{
+		{"valid", r, s, x, y, true},
+		{"swapped r and s", s, r, x, y, false},
+		{"off-curve key", r, s, x, offCurveY, false},
+		{"point at infinity", r, s, big.NewInt(0), big.NewInt(0), false},
+		{"zero r", big.NewInt(0), s, x, y, false},
+		{"zero s", r, big.NewInt(0), x, y, false},
+		{"r equal to N", new(big.Int).Set(n), s, x, y, false},
+		{"s equal to N", r, new(big.Int).Set(n), x, y, false},
+		{"r overflowed by N", new(big.Int).Add(r, n), s, x, y, false},
+		{"s overflowed by N", r, new(big.Int).Add(s, n), x, y, false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Verify(hash[:], tc.r, tc.s, tc.x, tc.y), tc.name)
+	}
+}
@@ -47,6 +47,32 @@ func (api *PublicChainDataFetcherAPI) StopRangeFetching() error {
 	return api.f.stopRangeFetching()
 }
 
+// StartJob starts a named backfill job handling the blocks from start to end with the given request types.
+// The job is limited to blocksPerSecond blocks per second unless it is 0.
+func (api *PublicChainDataFetcherAPI) StartJob(name string, start, end uint64, reqType uint, blocksPerSecond uint64) error {
+	return api.f.startJob(name, start, end, types.RequestType(reqType), blocksPerSecond)
+}
+
+// StopJob stops the job, which can be resumed from its checkpoint later.
+func (api *PublicChainDataFetcherAPI) StopJob(name string) error {
+	return api.f.stopJob(name)
+}
+
+// ResumeJob resumes the stopped or failed job from its checkpoint.
+func (api *PublicChainDataFetcherAPI) ResumeJob(name string) error {
+	return api.f.resumeJob(name)
+}
+
+// RemoveJob removes the job which is not running.
+func (api *PublicChainDataFetcherAPI) RemoveJob(name string) error {
+	return api.f.removeJob(name)
+}
+
+// Jobs returns all the jobs with their progress.
+func (api *PublicChainDataFetcherAPI) Jobs() []*types.Job {
+	return api.f.getJobs()
+}
+
 func (api *PublicChainDataFetcherAPI) Status() string {
 	return api.f.status()
 }
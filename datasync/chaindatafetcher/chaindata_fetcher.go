@@ -45,6 +45,10 @@ const (
 	running
 )
 
+// reorgTrackingDepth is the number of recent blocks whose handled hashes are tracked
+// to notify the removal of the re-orged blocks.
+const reorgTrackingDepth = 128
+
 var (
 	logger              = log.NewModuleLogger(log.ChainDataFetcher)
 	errUnsupportedMode  = errors.New("the given chaindatafetcher mode is not supported")
@@ -54,6 +58,7 @@ var (
 //go:generate mockgen -destination=./mocks/blockchain_mock.go -package=mocks github.com/klaytn/klaytn/datasync/chaindatafetcher BlockChain
 type BlockChain interface {
 	SubscribeChainEvent(ch chan<- blockchain.ChainEvent) event.Subscription
+	SubscribeChainSideEvent(ch chan<- blockchain.ChainSideEvent) event.Subscription
 	CurrentHeader() *types.Header
 	GetBlockByNumber(number uint64) *types.Block
 	GetReceiptsByBlockHash(blockHash common.Hash) types.Receipts
@@ -68,6 +73,9 @@ type ChainDataFetcher struct {
 	chainCh  chan blockchain.ChainEvent
 	chainSub event.Subscription
 
+	chainSideCh  chan blockchain.ChainSideEvent
+	chainSideSub event.Subscription
+
 	reqCh  chan *cfTypes.Request // TODO-ChainDataFetcher add logic to insert new requests from APIs to this channel
 	stopCh chan struct{}
 
@@ -77,10 +85,18 @@ type ChainDataFetcher struct {
 	checkpoint    int64
 	checkpointMap map[int64]struct{}
 
+	handledMu     sync.Mutex
+	handledHashes map[uint64]common.Hash // the hashes of the recently handled blocks by their numbers
+	handledHead   uint64
+
+	jobsMu sync.Mutex
+	jobs   map[string]*job
+
 	wg sync.WaitGroup
 
 	repo         Repository
 	checkpointDB CheckpointDB
+	jobDB        JobDB
 	setters      []ComponentSetter
 
 	fetchingStarted      uint32
@@ -113,16 +129,21 @@ func NewChainDataFetcher(ctx *node.ServiceContext, cfg *ChainDataFetcherConfig)
 		logger.Error("the chaindatafetcher mode is not supported", "mode", cfg.Mode)
 		return nil, errUnsupportedMode
 	}
+	jobDB := newJobDB()
 	return &ChainDataFetcher{
 		config:        cfg,
 		chainCh:       make(chan blockchain.ChainEvent, cfg.BlockChannelSize),
+		chainSideCh:   make(chan blockchain.ChainSideEvent, cfg.BlockChannelSize),
 		reqCh:         make(chan *cfTypes.Request, cfg.JobChannelSize),
 		stopCh:        make(chan struct{}),
 		numHandlers:   cfg.NumHandlers,
 		checkpointMap: make(map[int64]struct{}),
+		handledHashes: make(map[uint64]common.Hash),
+		jobs:          make(map[string]*job),
 		repo:          repo,
 		checkpointDB:  checkpointDB,
-		setters:       setters,
+		jobDB:         jobDB,
+		setters:       append(setters, jobDB),
 	}, nil
 }
 
@@ -170,6 +191,7 @@ func (f *ChainDataFetcher) Start(server p2p.Server) error {
 			return err
 		}
 	}
+	f.resumeJobs()
 	logger.Info("chaindata fetcher is started", "numHandlers", f.numHandlers)
 	return nil
 }
@@ -177,9 +199,11 @@ func (f *ChainDataFetcher) Start(server p2p.Server) error {
 func (f *ChainDataFetcher) Stop() error {
 	f.stopFetching()
 	f.stopRangeFetching()
+	f.haltJobs()
 	logger.Info("wait for all goroutines to be terminated...", "numGoroutines", f.config.NumHandlers)
 	close(f.stopCh)
 	f.wg.Wait()
+	f.flushJobs()
 	logger.Info("chaindata fetcher is stopped")
 	return nil
 }
@@ -204,6 +228,10 @@ func (f *ChainDataFetcher) startFetching() error {
 
 	// subscribe chain event in order to handle new blocks.
 	f.chainSub = f.blockchain.SubscribeChainEvent(f.chainCh)
+	// subscribe chain side event in order to notify the removal of re-orged blocks.
+	if _, ok := f.repo.(RemovedBlockHandler); ok {
+		f.chainSideSub = f.blockchain.SubscribeChainSideEvent(f.chainSideCh)
+	}
 	checkpoint := uint64(f.checkpoint)
 	currentBlock := f.blockchain.CurrentHeader().Number.Uint64()
 
@@ -232,6 +260,10 @@ func (f *ChainDataFetcher) stopFetching() error {
 	}
 
 	f.chainSub.Unsubscribe()
+	if f.chainSideSub != nil {
+		f.chainSideSub.Unsubscribe()
+		f.chainSideSub = nil
+	}
 	close(f.fetchingStopCh)
 	f.fetchingWg.Wait()
 	logger.Info("fetching is stopped")
@@ -353,6 +385,7 @@ func (f *ChainDataFetcher) SetComponents(components []interface{}) {
 		}
 	}
 	f.setCheckpoint()
+	f.loadJobs()
 }
 
 func (f *ChainDataFetcher) handleRequestByType(reqType cfTypes.RequestType, shouldUpdateCheckpoint bool, ev blockchain.ChainEvent) error {
@@ -380,10 +413,58 @@ func (f *ChainDataFetcher) handleRequestByType(reqType cfTypes.RequestType, shou
 	if shouldUpdateCheckpoint {
 		f.updateCheckpoint(ev.Block.Number().Int64())
 	}
+	f.markHandled(ev.Block)
 	handledBlockNumberGauge.Update(ev.Block.Number().Int64())
 	return nil
 }
 
+// markHandled records the hash of the handled block to find out if a re-orged block has been handled.
+// Only the blocks within reorgTrackingDepth from the highest handled block are kept.
+func (f *ChainDataFetcher) markHandled(block *types.Block) {
+	f.handledMu.Lock()
+	defer f.handledMu.Unlock()
+	if f.handledHashes == nil {
+		return
+	}
+	num := block.NumberU64()
+	f.handledHashes[num] = block.Hash()
+	if num > f.handledHead {
+		f.handledHead = num
+	}
+	if len(f.handledHashes) > 2*reorgTrackingDepth {
+		for n := range f.handledHashes {
+			if n+reorgTrackingDepth < f.handledHead {
+				delete(f.handledHashes, n)
+			}
+		}
+	}
+}
+
+// handleRemovedBlock notifies the removal of the re-orged block to the repository if the block has been handled.
+func (f *ChainDataFetcher) handleRemovedBlock(block *types.Block) error {
+	handler, ok := f.repo.(RemovedBlockHandler)
+	if !ok {
+		return nil
+	}
+
+	f.handledMu.Lock()
+	hash, handled := f.handledHashes[block.NumberU64()]
+	if handled && hash == block.Hash() {
+		delete(f.handledHashes, block.NumberU64())
+	}
+	f.handledMu.Unlock()
+	if !handled || hash != block.Hash() {
+		return nil
+	}
+
+	removedBlockCounter.Inc(1)
+	logger.Info("notifying the removal of a re-orged block", "blockNumber", block.NumberU64(), "hash", block.Hash())
+	handle := func(ev blockchain.ChainEvent, _ cfTypes.RequestType) error {
+		return handler.HandleRemovedBlock(ev.Block)
+	}
+	return f.retryFunc(handle)(blockchain.ChainEvent{Block: block}, cfTypes.RequestTypeBlockGroup)
+}
+
 func (f *ChainDataFetcher) resetChainCh() {
 	for {
 		select {
@@ -407,6 +488,7 @@ func (f *ChainDataFetcher) resetRequestCh() {
 func (f *ChainDataFetcher) pause() {
 	f.stopFetching()
 	f.stopRangeFetching()
+	f.failJobs(errMaxRetryExceeded)
 	f.resetChainCh()
 	f.resetRequestCh()
 }
@@ -435,18 +517,28 @@ func (f *ChainDataFetcher) handleRequest() {
 				logger.Error("the chaindatafetcher reaches the maximum retries. it pauses fetching and clear the channels", "blockNum", ev.Block.NumberU64())
 				f.pause()
 			}
+		case ev := <-f.chainSideCh:
+			if err := f.handleRemovedBlock(ev.Block); err != nil && err == errMaxRetryExceeded {
+				logger.Error("the chaindatafetcher reaches the maximum retries. it pauses fetching and clear the channels", "blockNum", ev.Block.NumberU64())
+				f.pause()
+			}
 		case req := <-f.reqCh:
 			numRequestsGauge.Update(int64(len(f.reqCh)))
 			ev, err := f.makeChainEvent(req.BlockNumber)
 			if err != nil {
 				// TODO-ChainDataFetcher handle error
 				logger.Error("making chain event is failed", "err", err)
+				if req.JobName != "" {
+					f.failJob(req.JobName, err)
+				}
 				break
 			}
 			err = f.handleRequestByType(req.ReqType, req.ShouldUpdateCheckpoint, ev)
 			if err != nil && err == errMaxRetryExceeded {
 				logger.Error("the chaindatafetcher reaches the maximum retries. it pauses fetching and clear the channels", "blockNum", ev.Block.NumberU64())
 				f.pause()
+			} else if err == nil && req.JobName != "" {
+				f.jobDone(req.JobName, req.BlockNumber)
 			}
 		}
 	}
@@ -552,5 +644,5 @@ func (f *ChainDataFetcher) retryFunc(insert HandleChainEventFn) HandleChainEvent
 }
 
 func (f *ChainDataFetcher) status() string {
-	return fmt.Sprintf("{fetching: %v, rangeFetching: %v}", atomic.LoadUint32(&f.fetchingStarted), atomic.LoadUint32(&f.rangeFetchingStarted))
+	return fmt.Sprintf("{fetching: %v, rangeFetching: %v, runningJobs: %v}", atomic.LoadUint32(&f.fetchingStarted), atomic.LoadUint32(&f.rangeFetchingStarted), f.numRunningJobs())
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher/mocks"
 	cfTypes "github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
 	eventMocks "github.com/klaytn/klaytn/event/mocks"
@@ -278,3 +279,46 @@ func TestChainDataFetcher_setComponents(t *testing.T) {
 	fetcher.setCheckpoint()
 	assert.Equal(t, testCheckpoint, fetcher.checkpoint)
 }
+
+// removedBlockRepository is a repository notifying the removal of re-orged blocks.
+type removedBlockRepository struct {
+	*mocks.MockRepository
+	*mocks.MockRemovedBlockHandler
+}
+
+func TestChainDataFetcher_handleRemovedBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := mocks.NewMockRemovedBlockHandler(ctrl)
+	fetcher := newTestChainDataFetcher()
+	fetcher.repo = &removedBlockRepository{mocks.NewMockRepository(ctrl), handler}
+	fetcher.handledHashes = make(map[uint64]common.Hash)
+
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10), Extra: []byte{1}})
+	forked := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10), Extra: []byte{2}})
+	fetcher.markHandled(block)
+
+	// the block which has not been handled is ignored
+	assert.NoError(t, fetcher.handleRemovedBlock(forked))
+
+	handler.EXPECT().HandleRemovedBlock(block).Return(nil).Times(1)
+	assert.NoError(t, fetcher.handleRemovedBlock(block))
+
+	// the removal is notified only once
+	assert.NoError(t, fetcher.handleRemovedBlock(block))
+}
+
+func TestChainDataFetcher_markHandled(t *testing.T) {
+	fetcher := newTestChainDataFetcher()
+	fetcher.handledHashes = make(map[uint64]common.Hash)
+
+	for i := int64(0); i <= 3*reorgTrackingDepth; i++ {
+		fetcher.markHandled(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(i)}))
+	}
+	assert.True(t, len(fetcher.handledHashes) <= 2*reorgTrackingDepth)
+	for i := uint64(2 * reorgTrackingDepth); i <= 3*reorgTrackingDepth; i++ {
+		_, ok := fetcher.handledHashes[i]
+		assert.True(t, ok)
+	}
+}
@@ -20,6 +20,8 @@ Source Files
   - api.go                   : includes chaindatafetcher-related APIs
   - chaindata_fetcher.go     : implements chaindatafetcher main operations
   - config.go                : includes chaindatafetcher configurations
+  - job.go                   : implements the named backfill jobs
+  - job_db.go                : implements the persistence of the backfill jobs
   - metrics.go               : includes chaindatafetcher metrics
   - repository.go            : implements repository interface
 */
@@ -0,0 +1,347 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package chaindatafetcher
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	cfTypes "github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
+)
+
+var (
+	errJobNotFound        = errors.New("the job does not exist")
+	errJobAlreadyExists   = errors.New("the job already exists")
+	errJobRunning         = errors.New("the job is running")
+	errJobNotRunning      = errors.New("the job is not running")
+	errJobFinished        = errors.New("the job is already finished")
+	errEmptyJobName       = errors.New("the job name is empty")
+	errInvalidBlockRange  = errors.New("the block range is invalid")
+	errInvalidRequestType = errors.New("the request type is invalid")
+)
+
+// jobCheckpointInterval is the number of handled blocks after which the checkpoint of a job is persisted.
+// The blocks handled after the persisted checkpoint are requested again when the node is restarted abnormally.
+const jobCheckpointInterval = 100
+
+// job is a backfill job with its in-memory progress.
+type job struct {
+	*cfTypes.Job
+
+	handled   map[uint64]struct{} // the handled blocks after the checkpoint
+	persisted uint64              // the checkpoint stored in the job database
+	stopCh    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// loadJobs reads the persisted jobs from the job database.
+func (f *ChainDataFetcher) loadJobs() {
+	jobs, err := f.jobDB.ReadJobs()
+	if err != nil {
+		logger.Crit("ReadJobs is failed", "err", err)
+	}
+
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	f.jobs = make(map[string]*job, len(jobs))
+	for _, j := range jobs {
+		f.jobs[j.Name] = &job{Job: j, persisted: j.Checkpoint}
+	}
+	logger.Info("Chaindatafetcher jobs are loaded", "numJobs", len(jobs))
+}
+
+// writeJobs persists all the jobs. The caller must hold jobsMu.
+func (f *ChainDataFetcher) writeJobs() error {
+	jobs := make([]*cfTypes.Job, 0, len(f.jobs))
+	for _, j := range f.jobs {
+		jobs = append(jobs, j.Job)
+	}
+	sort.Slice(jobs, func(i, k int) bool { return jobs[i].Name < jobs[k].Name })
+	if err := f.jobDB.WriteJobs(jobs); err != nil {
+		return err
+	}
+	for _, j := range f.jobs {
+		j.persisted = j.Checkpoint
+	}
+	return nil
+}
+
+// runJob launches a goroutine sending the requests of the job from its checkpoint.
+// The caller must hold jobsMu.
+func (f *ChainDataFetcher) runJob(j *job) {
+	j.handled = make(map[uint64]struct{})
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go func(name string, start, end uint64, reqType cfTypes.RequestType, blocksPerSecond uint64, stopCh chan struct{}) {
+		defer j.wg.Done()
+		f.sendJobRequests(name, start, end, reqType, blocksPerSecond, stopCh)
+	}(j.Name, j.Checkpoint, j.EndBlock, j.ReqType, j.BlocksPerSecond, j.stopCh)
+	logger.Info("the job is started", "name", j.Name, "checkpoint", j.Checkpoint, "endBlock", j.EndBlock)
+}
+
+// haltJob stops sending the requests of the job without changing its status.
+// The caller must hold jobsMu.
+func (f *ChainDataFetcher) haltJob(j *job) {
+	close(j.stopCh)
+	j.wg.Wait()
+}
+
+func (f *ChainDataFetcher) sendJobRequests(name string, startBlock, endBlock uint64, reqType cfTypes.RequestType, blocksPerSecond uint64, stopCh chan struct{}) {
+	var throttle <-chan time.Time
+	if blocksPerSecond > 0 {
+		if interval := time.Second / time.Duration(blocksPerSecond); interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			throttle = ticker.C
+		}
+	}
+	for i := startBlock; i <= endBlock; i++ {
+		if throttle != nil {
+			select {
+			case <-stopCh:
+				logger.Info("stopped making job requests", "name", name, "stoppedBlock", i)
+				return
+			case <-throttle:
+			}
+		}
+		select {
+		case <-stopCh:
+			logger.Info("stopped making job requests", "name", name, "stoppedBlock", i)
+			return
+		case f.reqCh <- cfTypes.NewJobRequest(name, reqType, i):
+		}
+	}
+	logger.Info("sending job requests is finished", "name", name, "endBlock", endBlock)
+}
+
+// resumeJobs restarts the jobs which were running before the fetcher was stopped.
+func (f *ChainDataFetcher) resumeJobs() {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	for _, j := range f.jobs {
+		if j.Status == cfTypes.JobStatusRunning {
+			f.runJob(j)
+		}
+	}
+}
+
+// haltJobs stops all the running jobs without changing their status,
+// so they are resumed after restart.
+func (f *ChainDataFetcher) haltJobs() {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	for _, j := range f.jobs {
+		if j.Status == cfTypes.JobStatusRunning {
+			f.haltJob(j)
+		}
+	}
+}
+
+// flushJobs persists the checkpoints which have not been stored yet.
+func (f *ChainDataFetcher) flushJobs() {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	for _, j := range f.jobs {
+		if j.Checkpoint != j.persisted {
+			if err := f.writeJobs(); err != nil {
+				logger.Error("writing jobs is failed", "err", err)
+			}
+			return
+		}
+	}
+}
+
+// failJobs stops all the running jobs and marks them as failed with the given error.
+func (f *ChainDataFetcher) failJobs(err error) {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	failed := false
+	for _, j := range f.jobs {
+		if j.Status == cfTypes.JobStatusRunning {
+			f.haltJob(j)
+			j.Status, j.Error = cfTypes.JobStatusFailed, err.Error()
+			failed = true
+		}
+	}
+	if failed {
+		if err := f.writeJobs(); err != nil {
+			logger.Error("writing jobs is failed", "err", err)
+		}
+	}
+}
+
+// failJob stops the job and marks it as failed with the given error.
+func (f *ChainDataFetcher) failJob(name string, err error) {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	j, ok := f.jobs[name]
+	if !ok || j.Status != cfTypes.JobStatusRunning {
+		return
+	}
+	f.haltJob(j)
+	j.Status, j.Error = cfTypes.JobStatusFailed, err.Error()
+	logger.Error("the job is failed", "name", name, "checkpoint", j.Checkpoint, "err", err)
+	if err := f.writeJobs(); err != nil {
+		logger.Error("writing jobs is failed", "name", name, "err", err)
+	}
+}
+
+// jobDone marks the block as handled by the job and moves forward the checkpoint of the job.
+// The checkpoint is persisted every jobCheckpointInterval blocks and when the job is finished.
+func (f *ChainDataFetcher) jobDone(name string, num uint64) {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	j, ok := f.jobs[name]
+	if !ok || j.handled == nil || num < j.Checkpoint {
+		return
+	}
+	j.handled[num] = struct{}{}
+
+	updated := false
+	for {
+		if _, ok := j.handled[j.Checkpoint]; !ok {
+			break
+		}
+		delete(j.handled, j.Checkpoint)
+		j.Checkpoint++
+		updated = true
+	}
+	if !updated {
+		return
+	}
+	if j.Checkpoint > j.EndBlock {
+		j.Status = cfTypes.JobStatusFinished
+		logger.Info("the job is finished", "name", name, "startBlock", j.StartBlock, "endBlock", j.EndBlock)
+	} else if j.Checkpoint-j.persisted < jobCheckpointInterval {
+		return
+	}
+	if err := f.writeJobs(); err != nil {
+		logger.Error("writing jobs is failed", "name", name, "err", err)
+	}
+}
+
+func (f *ChainDataFetcher) startJob(name string, startBlock, endBlock uint64, reqType cfTypes.RequestType, blocksPerSecond uint64) error {
+	if name == "" {
+		return errEmptyJobName
+	}
+	if startBlock > endBlock || endBlock > f.blockchain.CurrentHeader().Number.Uint64() {
+		return errInvalidBlockRange
+	}
+	if !cfTypes.IsValidRequestType(reqType) {
+		return errInvalidRequestType
+	}
+
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	if _, ok := f.jobs[name]; ok {
+		return errJobAlreadyExists
+	}
+	j := &job{Job: &cfTypes.Job{
+		Name:            name,
+		ReqType:         reqType,
+		StartBlock:      startBlock,
+		EndBlock:        endBlock,
+		BlocksPerSecond: blocksPerSecond,
+		Checkpoint:      startBlock,
+		Status:          cfTypes.JobStatusRunning,
+	}}
+	f.jobs[name] = j
+	if err := f.writeJobs(); err != nil {
+		delete(f.jobs, name)
+		return err
+	}
+	f.runJob(j)
+	return nil
+}
+
+func (f *ChainDataFetcher) stopJob(name string) error {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	j, ok := f.jobs[name]
+	if !ok {
+		return errJobNotFound
+	}
+	if j.Status != cfTypes.JobStatusRunning {
+		return errJobNotRunning
+	}
+	f.haltJob(j)
+	j.Status = cfTypes.JobStatusStopped
+	logger.Info("the job is stopped", "name", name, "checkpoint", j.Checkpoint)
+	return f.writeJobs()
+}
+
+func (f *ChainDataFetcher) resumeJob(name string) error {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	j, ok := f.jobs[name]
+	if !ok {
+		return errJobNotFound
+	}
+	if j.Status == cfTypes.JobStatusRunning {
+		return errJobRunning
+	}
+	if j.Status == cfTypes.JobStatusFinished || j.Checkpoint > j.EndBlock {
+		return errJobFinished
+	}
+	j.Status, j.Error = cfTypes.JobStatusRunning, ""
+	if err := f.writeJobs(); err != nil {
+		return err
+	}
+	f.runJob(j)
+	return nil
+}
+
+func (f *ChainDataFetcher) removeJob(name string) error {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	j, ok := f.jobs[name]
+	if !ok {
+		return errJobNotFound
+	}
+	if j.Status == cfTypes.JobStatusRunning {
+		return errJobRunning
+	}
+	delete(f.jobs, name)
+	return f.writeJobs()
+}
+
+// getJobs returns the copies of all the jobs sorted by their names.
+func (f *ChainDataFetcher) getJobs() []*cfTypes.Job {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	jobs := make([]*cfTypes.Job, 0, len(f.jobs))
+	for _, j := range f.jobs {
+		copied := *j.Job
+		jobs = append(jobs, &copied)
+	}
+	sort.Slice(jobs, func(i, k int) bool { return jobs[i].Name < jobs[k].Name })
+	return jobs
+}
+
+func (f *ChainDataFetcher) numRunningJobs() int {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	n := 0
+	for _, j := range f.jobs {
+		if j.Status == cfTypes.JobStatusRunning {
+			n++
+		}
+	}
+	return n
+}
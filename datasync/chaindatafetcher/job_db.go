@@ -0,0 +1,60 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package chaindatafetcher
+
+import (
+	"encoding/json"
+
+	cfTypes "github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
+	"github.com/klaytn/klaytn/storage/database"
+)
+
+// jobDB stores the backfill jobs in the misc database of the node.
+type jobDB struct {
+	manager database.DBManager
+}
+
+func newJobDB() *jobDB {
+	return &jobDB{}
+}
+
+func (db *jobDB) ReadJobs() ([]*cfTypes.Job, error) {
+	data, err := db.manager.ReadChainDataFetcherJobs()
+	if err != nil || data == nil {
+		return nil, err
+	}
+	var jobs []*cfTypes.Job
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (db *jobDB) WriteJobs(jobs []*cfTypes.Job) error {
+	data, err := json.Marshal(jobs)
+	if err != nil {
+		return err
+	}
+	return db.manager.WriteChainDataFetcherJobs(data)
+}
+
+func (db *jobDB) SetComponent(component interface{}) {
+	switch c := component.(type) {
+	case database.DBManager:
+		db.manager = c
+	}
+}
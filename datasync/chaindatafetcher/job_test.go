@@ -0,0 +1,217 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package chaindatafetcher
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/datasync/chaindatafetcher/mocks"
+	cfTypes "github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestJobFetcher(ctrl *gomock.Controller) (*ChainDataFetcher, *mocks.MockJobDB) {
+	jobDB := mocks.NewMockJobDB(ctrl)
+	fetcher := newTestChainDataFetcher()
+	fetcher.jobDB = jobDB
+	fetcher.jobs = make(map[string]*job)
+	return fetcher, jobDB
+}
+
+func TestChainDataFetcher_jobStartStopResumeRemove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fetcher, jobDB := newTestJobFetcher(ctrl)
+	bc := mocks.NewMockBlockChain(ctrl)
+	bc.EXPECT().CurrentHeader().Return(&types.Header{Number: big.NewInt(100)}).AnyTimes()
+	fetcher.blockchain = bc
+	jobDB.EXPECT().WriteJobs(gomock.Any()).Return(nil).AnyTimes()
+
+	// invalid jobs
+	assert.Equal(t, errEmptyJobName, fetcher.startJob("", 0, 10, cfTypes.RequestTypeGroupAll, 0))
+	assert.Equal(t, errInvalidBlockRange, fetcher.startJob("job", 10, 0, cfTypes.RequestTypeGroupAll, 0))
+	assert.Equal(t, errInvalidBlockRange, fetcher.startJob("job", 0, 101, cfTypes.RequestTypeGroupAll, 0))
+	assert.Equal(t, errInvalidRequestType, fetcher.startJob("job", 0, 10, cfTypes.RequestTypeLength, 0))
+
+	assert.NoError(t, fetcher.startJob("job", 0, 10, cfTypes.RequestTypeBlockGroup, 0))
+	assert.Equal(t, errJobAlreadyExists, fetcher.startJob("job", 0, 10, cfTypes.RequestTypeGroupAll, 0))
+	assert.Equal(t, 1, fetcher.numRunningJobs())
+
+	// take only parts of the requests
+	for i := uint64(0); i < 3; i++ {
+		req := <-fetcher.reqCh
+		assert.Equal(t, i, req.BlockNumber)
+		assert.Equal(t, "job", req.JobName)
+		assert.Equal(t, cfTypes.RequestTypeBlockGroup, req.ReqType)
+		assert.False(t, req.ShouldUpdateCheckpoint)
+	}
+	fetcher.jobDone("job", 0)
+	fetcher.jobDone("job", 1)
+
+	assert.Equal(t, errJobRunning, fetcher.removeJob("job"))
+	assert.Equal(t, errJobRunning, fetcher.resumeJob("job"))
+	assert.NoError(t, fetcher.stopJob("job"))
+	assert.Equal(t, errJobNotRunning, fetcher.stopJob("job"))
+	assert.Equal(t, errJobNotFound, fetcher.stopJob("unknown"))
+
+	jobs := fetcher.getJobs()
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, cfTypes.JobStatusStopped, jobs[0].Status)
+	assert.Equal(t, uint64(2), jobs[0].Checkpoint)
+
+	// the job is resumed from its checkpoint
+	assert.NoError(t, fetcher.resumeJob("job"))
+	assert.Equal(t, uint64(2), (<-fetcher.reqCh).BlockNumber)
+	assert.NoError(t, fetcher.stopJob("job"))
+
+	assert.NoError(t, fetcher.removeJob("job"))
+	assert.Equal(t, errJobNotFound, fetcher.removeJob("job"))
+	assert.Empty(t, fetcher.getJobs())
+}
+
+func TestChainDataFetcher_jobDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endBlock := uint64(jobCheckpointInterval + 1)
+	fetcher, jobDB := newTestJobFetcher(ctrl)
+	fetcher.jobs["job"] = &job{
+		Job:     &cfTypes.Job{Name: "job", StartBlock: 0, EndBlock: endBlock, Status: cfTypes.JobStatusRunning},
+		handled: make(map[uint64]struct{}),
+	}
+
+	// done order: 1, 0, 3, 2
+	// checkpoint: 0, 2, 2, 4
+	// the checkpoint is not persisted until it moves forward jobCheckpointInterval blocks
+	fetcher.jobDone("job", 1)
+	fetcher.jobDone("job", 0)
+	fetcher.jobDone("job", 3)
+	fetcher.jobDone("job", 2)
+	assert.Equal(t, uint64(4), fetcher.getJobs()[0].Checkpoint)
+
+	jobDB.EXPECT().WriteJobs(gomock.Any()).DoAndReturn(func(jobs []*cfTypes.Job) error {
+		assert.Equal(t, uint64(jobCheckpointInterval), jobs[0].Checkpoint)
+		assert.Equal(t, cfTypes.JobStatusRunning, jobs[0].Status)
+		return nil
+	})
+	for i := uint64(4); i < jobCheckpointInterval; i++ {
+		fetcher.jobDone("job", i)
+	}
+
+	// the finished job is persisted regardless of the interval
+	jobDB.EXPECT().WriteJobs(gomock.Any()).DoAndReturn(func(jobs []*cfTypes.Job) error {
+		assert.Equal(t, endBlock+1, jobs[0].Checkpoint)
+		assert.Equal(t, cfTypes.JobStatusFinished, jobs[0].Status)
+		return nil
+	})
+	fetcher.jobDone("job", endBlock)
+	fetcher.jobDone("job", jobCheckpointInterval)
+
+	// the requests of unknown jobs or the handled blocks are ignored
+	fetcher.jobDone("unknown", endBlock+1)
+	fetcher.jobDone("job", 1)
+	assert.Equal(t, errJobFinished, fetcher.resumeJob("job"))
+}
+
+func TestChainDataFetcher_flushJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fetcher, jobDB := newTestJobFetcher(ctrl)
+	fetcher.jobs["job"] = &job{
+		Job:     &cfTypes.Job{Name: "job", StartBlock: 0, EndBlock: 10, Status: cfTypes.JobStatusStopped},
+		handled: make(map[uint64]struct{}),
+	}
+
+	// nothing is written if there is no progress
+	fetcher.flushJobs()
+
+	fetcher.jobDone("job", 0)
+	fetcher.jobDone("job", 1)
+
+	// the progress not persisted yet is written
+	jobDB.EXPECT().WriteJobs(gomock.Any()).DoAndReturn(func(jobs []*cfTypes.Job) error {
+		assert.Equal(t, uint64(2), jobs[0].Checkpoint)
+		assert.Equal(t, cfTypes.JobStatusStopped, jobs[0].Status)
+		return nil
+	})
+	fetcher.flushJobs()
+	fetcher.flushJobs()
+}
+
+func TestChainDataFetcher_loadAndResumeJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fetcher, jobDB := newTestJobFetcher(ctrl)
+	jobDB.EXPECT().ReadJobs().Return([]*cfTypes.Job{
+		{Name: "running", ReqType: cfTypes.RequestTypeTraceGroup, StartBlock: 0, EndBlock: 6, Checkpoint: 5, Status: cfTypes.JobStatusRunning},
+		{Name: "stopped", ReqType: cfTypes.RequestTypeBlockGroup, StartBlock: 0, EndBlock: 6, Checkpoint: 1, Status: cfTypes.JobStatusStopped},
+	}, nil)
+	fetcher.loadJobs()
+	assert.Equal(t, 2, len(fetcher.getJobs()))
+
+	// only the running job is resumed from its checkpoint
+	fetcher.resumeJobs()
+	for i := uint64(5); i <= 6; i++ {
+		req := <-fetcher.reqCh
+		assert.Equal(t, "running", req.JobName)
+		assert.Equal(t, i, req.BlockNumber)
+	}
+
+	// the halted job is still running to be resumed after restart
+	fetcher.haltJobs()
+	assert.Equal(t, 1, fetcher.numRunningJobs())
+}
+
+func TestChainDataFetcher_failJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fetcher, jobDB := newTestJobFetcher(ctrl)
+	bc := mocks.NewMockBlockChain(ctrl)
+	bc.EXPECT().CurrentHeader().Return(&types.Header{Number: big.NewInt(100)}).AnyTimes()
+	fetcher.blockchain = bc
+	jobDB.EXPECT().WriteJobs(gomock.Any()).Return(nil).AnyTimes()
+
+	assert.NoError(t, fetcher.startJob("job1", 0, 10, cfTypes.RequestTypeBlockGroup, 0))
+	assert.NoError(t, fetcher.startJob("job2", 0, 10, cfTypes.RequestTypeBlockGroup, 0))
+
+	fetcher.failJob("job1", errors.New("test-error"))
+	jobs := fetcher.getJobs()
+	assert.Equal(t, cfTypes.JobStatusFailed, jobs[0].Status)
+	assert.Equal(t, "test-error", jobs[0].Error)
+	assert.Equal(t, cfTypes.JobStatusRunning, jobs[1].Status)
+
+	fetcher.failJobs(errMaxRetryExceeded)
+	jobs = fetcher.getJobs()
+	assert.Equal(t, "test-error", jobs[0].Error)
+	assert.Equal(t, cfTypes.JobStatusFailed, jobs[1].Status)
+	assert.Equal(t, errMaxRetryExceeded.Error(), jobs[1].Error)
+
+	// the failed job can be resumed
+	assert.NoError(t, fetcher.resumeJob("job1"))
+	jobs = fetcher.getJobs()
+	assert.Equal(t, cfTypes.JobStatusRunning, jobs[0].Status)
+	assert.Empty(t, jobs[0].Error)
+	fetcher.haltJobs()
+}
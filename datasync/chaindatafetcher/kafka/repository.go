@@ -23,6 +23,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain/vm"
 
 	"github.com/klaytn/klaytn/blockchain"
+	klayTypes "github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
 )
 
@@ -37,6 +38,7 @@ func (r *traceGroupResult) Key() string {
 
 type blockGroupResult struct {
 	BlockNumber *big.Int               `json:"blockNumber"`
+	Removed     bool                   `json:"removed,omitempty"` // true if the block is re-orged out of the canonical chain
 	Result      map[string]interface{} `json:"result"`
 }
 
@@ -88,3 +90,18 @@ func (r *repository) HandleChainEvent(event blockchain.ChainEvent, dataType type
 		return fmt.Errorf("not supported type. [blockNumber: %v, reqType: %v]", event.Block.NumberU64(), dataType)
 	}
 }
+
+// HandleRemovedBlock publishes the removal of the re-orged block to the block group topic.
+// The message has the same key with the published block, and its result only contains
+// the hash and the parent hash of the removed block.
+func (r *repository) HandleRemovedBlock(block *klayTypes.Block) error {
+	result := &blockGroupResult{
+		BlockNumber: block.Number(),
+		Removed:     true,
+		Result: map[string]interface{}{
+			"hash":       block.Hash(),
+			"parentHash": block.ParentHash(),
+		},
+	}
+	return r.kafka.Publish(r.kafka.getTopicName(EventBlockGroup), result)
+}
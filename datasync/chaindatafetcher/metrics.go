@@ -31,4 +31,6 @@ var (
 	numRequestsGauge   = metrics.NewRegisteredGauge("chaindatafetcher/requests/gauge", nil)
 
 	traceAPIErrorCounter = metrics.NewRegisteredCounter("chaindatafetcher/trace/error", nil)
+
+	removedBlockCounter = metrics.NewRegisteredCounter("chaindatafetcher/removedblock/counter", nil)
 )
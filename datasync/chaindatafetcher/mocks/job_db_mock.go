@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/klaytn/klaytn/datasync/chaindatafetcher (interfaces: JobDB)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
+)
+
+// MockJobDB is a mock of JobDB interface
+type MockJobDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobDBMockRecorder
+}
+
+// MockJobDBMockRecorder is the mock recorder for MockJobDB
+type MockJobDBMockRecorder struct {
+	mock *MockJobDB
+}
+
+// NewMockJobDB creates a new mock instance
+func NewMockJobDB(ctrl *gomock.Controller) *MockJobDB {
+	mock := &MockJobDB{ctrl: ctrl}
+	mock.recorder = &MockJobDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobDB) EXPECT() *MockJobDBMockRecorder {
+	return m.recorder
+}
+
+// ReadJobs mocks base method
+func (m *MockJobDB) ReadJobs() ([]*types.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobs")
+	ret0, _ := ret[0].([]*types.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobs indicates an expected call of ReadJobs
+func (mr *MockJobDBMockRecorder) ReadJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobs", reflect.TypeOf((*MockJobDB)(nil).ReadJobs))
+}
+
+// WriteJobs mocks base method
+func (m *MockJobDB) WriteJobs(arg0 []*types.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteJobs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteJobs indicates an expected call of WriteJobs
+func (mr *MockJobDBMockRecorder) WriteJobs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteJobs", reflect.TypeOf((*MockJobDB)(nil).WriteJobs), arg0)
+}
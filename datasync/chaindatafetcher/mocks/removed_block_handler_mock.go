@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/klaytn/klaytn/datasync/chaindatafetcher (interfaces: RemovedBlockHandler)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/klaytn/klaytn/blockchain/types"
+)
+
+// MockRemovedBlockHandler is a mock of RemovedBlockHandler interface
+type MockRemovedBlockHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovedBlockHandlerMockRecorder
+}
+
+// MockRemovedBlockHandlerMockRecorder is the mock recorder for MockRemovedBlockHandler
+type MockRemovedBlockHandlerMockRecorder struct {
+	mock *MockRemovedBlockHandler
+}
+
+// NewMockRemovedBlockHandler creates a new mock instance
+func NewMockRemovedBlockHandler(ctrl *gomock.Controller) *MockRemovedBlockHandler {
+	mock := &MockRemovedBlockHandler{ctrl: ctrl}
+	mock.recorder = &MockRemovedBlockHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRemovedBlockHandler) EXPECT() *MockRemovedBlockHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleRemovedBlock mocks base method
+func (m *MockRemovedBlockHandler) HandleRemovedBlock(arg0 *types.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleRemovedBlock", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleRemovedBlock indicates an expected call of HandleRemovedBlock
+func (mr *MockRemovedBlockHandlerMockRecorder) HandleRemovedBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRemovedBlock", reflect.TypeOf((*MockRemovedBlockHandler)(nil).HandleRemovedBlock), arg0)
+}
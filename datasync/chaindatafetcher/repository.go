@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/klaytn/klaytn/blockchain"
+	klayTypes "github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher/types"
 )
 
@@ -33,16 +34,29 @@ type HandleChainEventFn func(blockchain.ChainEvent, types.RequestType) error
 //go:generate mockgen -destination=./mocks/repository_mock.go -package=mocks github.com/klaytn/klaytn/datasync/chaindatafetcher Repository
 //go:generate mockgen -destination=./mocks/checkpoint_db_mock.go -package=mocks github.com/klaytn/klaytn/datasync/chaindatafetcher CheckpointDB
 //go:generate mockgen -destination=./mocks/component_setter_mock.go -package=mocks github.com/klaytn/klaytn/datasync/chaindatafetcher ComponentSetter
+//go:generate mockgen -destination=./mocks/job_db_mock.go -package=mocks github.com/klaytn/klaytn/datasync/chaindatafetcher JobDB
+//go:generate mockgen -destination=./mocks/removed_block_handler_mock.go -package=mocks github.com/klaytn/klaytn/datasync/chaindatafetcher RemovedBlockHandler
 
 type Repository interface {
 	HandleChainEvent(event blockchain.ChainEvent, dataType types.RequestType) error
 }
 
+// RemovedBlockHandler is implemented by the repositories which notify the removal of
+// the handled blocks that are re-orged out of the canonical chain.
+type RemovedBlockHandler interface {
+	HandleRemovedBlock(block *klayTypes.Block) error
+}
+
 type CheckpointDB interface {
 	ReadCheckpoint() (int64, error)
 	WriteCheckpoint(checkpoint int64) error
 }
 
+type JobDB interface {
+	ReadJobs() ([]*types.Job, error)
+	WriteJobs(jobs []*types.Job) error
+}
+
 type ComponentSetter interface {
 	SetComponent(component interface{})
 }
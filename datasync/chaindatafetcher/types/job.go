@@ -0,0 +1,40 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+type JobStatus string
+
+const (
+	JobStatusRunning  = JobStatus("running")
+	JobStatusStopped  = JobStatus("stopped")
+	JobStatusFailed   = JobStatus("failed")
+	JobStatusFinished = JobStatus("finished")
+)
+
+// Job is a named backfill job which handles the blocks from StartBlock to EndBlock
+// with its own request types. The job is persisted with its progress, so it can be
+// resumed from the checkpoint after restart.
+type Job struct {
+	Name            string      `json:"name"`
+	ReqType         RequestType `json:"reqType"`
+	StartBlock      uint64      `json:"startBlock"`
+	EndBlock        uint64      `json:"endBlock"`
+	BlocksPerSecond uint64      `json:"blocksPerSecond"` // the maximum number of requested blocks per second, 0 means unlimited
+	Checkpoint      uint64      `json:"checkpoint"`      // all the blocks before the checkpoint are handled
+	Status          JobStatus   `json:"status"`
+	Error           string      `json:"error,omitempty"` // the reason why the job is failed
+}
@@ -43,12 +43,18 @@ type Request struct {
 	ReqType                RequestType
 	ShouldUpdateCheckpoint bool
 	BlockNumber            uint64
+	JobName                string // the name of the job which made the request, empty if not made by a job
 }
 
 func CheckRequestType(rt RequestType, targetType RequestType) bool {
 	return rt&targetType == targetType
 }
 
+// IsValidRequestType returns if the given request type is a non-empty combination of the request types.
+func IsValidRequestType(rt RequestType) bool {
+	return rt != 0 && rt < RequestTypeLength
+}
+
 func NewRequest(reqType RequestType, shouldUpdateCheckpoint bool, block uint64) *Request {
 	return &Request{
 		ReqType:                reqType,
@@ -56,3 +62,11 @@ func NewRequest(reqType RequestType, shouldUpdateCheckpoint bool, block uint64)
 		BlockNumber:            block,
 	}
 }
+
+func NewJobRequest(jobName string, reqType RequestType, block uint64) *Request {
+	return &Request{
+		ReqType:     reqType,
+		BlockNumber: block,
+		JobName:     jobName,
+	}
+}
@@ -29,6 +29,7 @@ type DBConfig struct {
 	EnabledLogMode  bool
 
 	// DB Config
+	DBType     string `toml:",omitempty"` // mysql or postgres
 	DBHost     string `toml:",omitempty"`
 	DBPort     string `toml:",omitempty"`
 	DBUser     string `toml:",omitempty"`
@@ -55,6 +56,7 @@ var DefaultDBConfig = &DBConfig{
 	EnabledDBSyncer: false,
 	EnabledLogMode:  false,
 
+	DBType: MYSQL,
 	DBPort: "3306",
 
 	MaxIdleConns:     50,
@@ -22,7 +22,6 @@ import (
 	"strings"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/event"
@@ -55,10 +54,7 @@ type DBSyncer struct {
 
 	logMode bool
 
-	blockInsertQuery     string
-	txInsertQuery        string
-	summaryInsertQuery   string
-	txHashMapInsertQuery string
+	dialect sqlDialect
 
 	HandleBlock func(block *types.Block) error
 	queryEngine *QueryEngine
@@ -71,7 +67,7 @@ type DBSyncer struct {
 }
 
 func NewDBSyncer(ctx *node.ServiceContext, cfg *DBConfig) (*DBSyncer, error) {
-	logger.Info("initialize DBSyncer", "db.host",
+	logger.Info("initialize DBSyncer", "db.type", cfg.DBType, "db.host",
 		cfg.DBHost, "db.port", cfg.DBPort, "db.name", cfg.DBName, "db.user", cfg.DBUser, "db.max.idle",
 		cfg.MaxIdleConns, "db.password", cfg.DBPassword, "db.max.open", cfg.MaxOpenConns, "db.max.lifetime",
 		cfg.ConnMaxLifetime, "block.ch.size", cfg.BlockChannelSize, "mode", cfg.Mode, "genquery.th",
@@ -88,8 +84,14 @@ func NewDBSyncer(ctx *node.ServiceContext, cfg *DBConfig) (*DBSyncer, error) {
 		return nil, errors.New("db config must be set (db.password)")
 	}
 
+	dialect, err := newSQLDialect(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &DBSyncer{
 		cfg:            cfg,
+		dialect:        dialect,
 		logMode:        cfg.EnabledLogMode,
 		bulkInsertSize: cfg.BulkInsertSize,
 		eventMode:      cfg.EventMode,
@@ -106,10 +108,9 @@ func (ds *DBSyncer) APIs() []rpc.API {
 }
 
 func (ds *DBSyncer) Start(server p2p.Server) error {
-	ds.dataSource = ds.cfg.DBUser + ":" + ds.cfg.DBPassword + "@tcp(" + ds.cfg.DBHost + ":" +
-		ds.cfg.DBPort + ")/" + ds.cfg.DBName + "?writeTimeout=10s&timeout=10s"
+	ds.dataSource = ds.dialect.dataSource(ds.cfg)
 
-	db, err := sql.Open("mysql", ds.dataSource)
+	db, err := sql.Open(ds.dialect.driverName(), ds.dataSource)
 	if err != nil {
 		logger.Error("fail to connect database", "target", ds.dataSource)
 		return err
@@ -122,21 +123,6 @@ func (ds *DBSyncer) Start(server p2p.Server) error {
 	// initialize context
 	ds.ctx, ds.stop = context.WithCancel(context.Background())
 
-	// query
-	ds.blockInsertQuery = "INSERT INTO " + ds.cfg.DBName + ".block " + "(totalTx, " +
-		"committee, gasUsed, gasPrice, hash, " +
-		"number, parentHash, proposer, reward, size, " +
-		"timestamp, timestampFoS)" + "VALUES (?,?,?,?,?,?,?,?,?,?,?,?)"
-
-	ds.txInsertQuery = "INSERT INTO " + ds.cfg.DBName + ".transaction " + "(id, blockHash, blockNumber, " +
-		"contractAddress, `from`, gas, gasPrice, gasUsed, input, nonce, status, `to`, " +
-		"timestamp, txHash, type, value, feePayer, feeRatio, senderTxHash) VALUES "
-
-	ds.summaryInsertQuery = "INSERT INTO " + ds.cfg.DBName + ".account_summary " + "(address, type, " +
-		"creator, created_tx, hra) VALUES "
-
-	ds.txHashMapInsertQuery = "INSERT INTO " + ds.cfg.DBName + ".sendertxhash_map " + "(senderTxHash, txHash) VALUES "
-
 	if ds.cfg.Mode == "single" {
 		ds.HandleBlock = ds.HandleChainEvent
 	} else if ds.cfg.Mode == "multi" {
@@ -278,6 +264,9 @@ func (ds *DBSyncer) HandleChainEvent(block *types.Block) error {
 
 func (ds *DBSyncer) syncBlockHeader(block *types.Block) error {
 	proposerAddr, committeeAddrs, err := getProposerAndValidatorsFromBlock(block)
+	if err != nil {
+		return err
+	}
 
 	totalTx := block.Transactions().Len()
 	committee := strings.ToLower(committeeAddrs)
@@ -292,20 +281,10 @@ func (ds *DBSyncer) syncBlockHeader(block *types.Block) error {
 	timestamp := block.Header().Time.String()
 	timestampFos := block.Header().TimeFoS
 
-	stmtIns, err := ds.db.Prepare(ds.blockInsertQuery)
-	if err != nil {
-		logger.Error("fail to prepare (block)", "query", ds.blockInsertQuery)
-		return err
-	}
-
-	defer func() {
-		if err := stmtIns.Close(); err != nil {
-			logger.Error("fail to close stmt", "err", err)
-		}
-	}()
+	vals := []interface{}{totalTx, committee, gasUsed, gasPrice, hash,
+		number, parentHash, proposer, reward, size, timestamp, timestampFos}
 
-	if _, err := stmtIns.Exec(totalTx, committee, gasUsed, gasPrice, hash,
-		number, parentHash, proposer, reward, size, timestamp, timestampFos); err != nil {
+	if err := ds.dialect.bulkInsert(ds.ctx, ds.db, blockTable, vals, 1); err != nil {
 		logger.Error("fail to insert DB (block)", "number", block.Number(), "err", err)
 		return err
 	}
@@ -315,81 +294,78 @@ func (ds *DBSyncer) syncBlockHeader(block *types.Block) error {
 
 func (ds *DBSyncer) SyncTransactions(block *types.Block) error {
 	txKey := block.NumberU64() * TX_KEY_FACTOR
-	txStr, vals, insertCount := ds.resetTxParameter()
-	summaryStr, summaryVals, summaryInsertCount := ds.resetSummaryParameter()
-	txMapStr, txMapVals, txMapInsertCount := ds.resetTxMapParameter()
+	vals, insertCount := resetParameter()
+	summaryVals, summaryInsertCount := resetParameter()
+	txMapVals, txMapInsertCount := resetParameter()
 
 	receipts := ds.blockchain.GetReceiptsByBlockHash(block.Hash())
 
 	for index, tx := range block.Transactions() {
 		txKey += uint64(index)
-		cols, val, txMapArg, summaryArg, err := MakeTxDBRow(block, txKey, tx, receipts[index])
+		val, txMapArg, summaryArg, err := MakeTxDBRow(block, txKey, tx, receipts[index])
 		if err != nil {
 			return err
 		}
 
-		txStr += cols + ","
 		vals = append(vals, val...)
 		insertCount++
 
 		if insertCount >= ds.bulkInsertSize {
-			if err := ds.bulkInsert(txStr, vals, block.NumberU64(), insertCount); err != nil {
+			if err := ds.bulkInsert(txTable, vals, block.NumberU64(), insertCount); err != nil {
 				return err
 			}
-			txStr, vals, insertCount = ds.resetTxParameter()
+			vals, insertCount = resetParameter()
 		}
 
-		scols, sval, count, err := MakeSummaryDBRow(summaryArg)
+		sval, count, err := MakeSummaryDBRow(summaryArg)
 		if err != nil {
 			return err
 		}
 
 		if count == 1 {
-			summaryStr += scols + ","
 			summaryVals = append(summaryVals, sval...)
 			summaryInsertCount++
 		}
 
 		if summaryInsertCount >= ds.bulkInsertSize {
-			if err := ds.bulkInsert(summaryStr, summaryVals, block.NumberU64(), summaryInsertCount); err != nil {
+			if err := ds.bulkInsert(summaryTable, summaryVals, block.NumberU64(), summaryInsertCount); err != nil {
 				return err
 			}
-			summaryStr, summaryVals, summaryInsertCount = ds.resetTxParameter()
+			summaryVals, summaryInsertCount = resetParameter()
 		}
 
-		tcols, tval, tcount, err := MakeTxMappingRow(txMapArg)
+		tval, tcount, err := MakeTxMappingRow(txMapArg)
 		if err != nil {
 			return err
 		}
 
 		if tcount == 1 {
-			txMapStr += tcols + ","
 			txMapVals = append(txMapVals, tval...)
 			txMapInsertCount++
 		}
 
 		if txMapInsertCount >= ds.bulkInsertSize {
-			if err := ds.bulkInsert(txMapStr, txMapVals, block.NumberU64(), txMapInsertCount); err != nil {
+			if err := ds.bulkInsert(txHashMapTable, txMapVals, block.NumberU64(), txMapInsertCount); err != nil {
 				return err
 			}
-			txMapStr, txMapVals, txMapInsertCount = ds.resetTxMapParameter()
+			txMapVals, txMapInsertCount = resetParameter()
 		}
 	}
 
 	if insertCount > 0 {
-		if err := ds.bulkInsert(txStr, vals, block.NumberU64(), insertCount); err != nil {
+		if err := ds.bulkInsert(txTable, vals, block.NumberU64(), insertCount); err != nil {
 			return err
 		}
 	}
 
 	if summaryInsertCount > 0 {
-		if err := ds.bulkInsert(summaryStr, summaryVals, block.NumberU64(), summaryInsertCount); err != nil {
+		if err := ds.bulkInsert(summaryTable, summaryVals, block.NumberU64(), summaryInsertCount); err != nil {
 			return err
 		}
 	}
 
 	if txMapInsertCount > 0 {
-		if err := ds.bulkInsert(txMapStr, txMapVals, block.NumberU64(), txMapInsertCount); err != nil {
+		if err := ds.bulkInsert(txHashMapTable, txMapVals, block.NumberU64(), txMapInsertCount); err != nil {
 			return err
 		}
 	}
@@ -397,51 +373,21 @@ func (ds *DBSyncer) SyncTransactions(block *types.Block) error {
 	return nil
 }
 
-func (ds *DBSyncer) resetTxParameter() (txStr string, vals []interface{}, insertCount int) {
-	txStr = ds.txInsertQuery
+func resetParameter() (vals []interface{}, insertCount int) {
 	vals = []interface{}{}
 	insertCount = 0
 
-	return txStr, vals, insertCount
+	return vals, insertCount
 }
 
-func (ds *DBSyncer) resetSummaryParameter() (summaryStr string, vals []interface{}, insertCount int) {
-	summaryStr = ds.summaryInsertQuery
-	vals = []interface{}{}
-	insertCount = 0
-
-	return summaryStr, vals, insertCount
-}
-
-func (ds *DBSyncer) resetTxMapParameter() (txMapStr string, vals []interface{}, insertCount int) {
-	txMapStr = ds.txHashMapInsertQuery
-	vals = []interface{}{}
-	insertCount = 0
-
-	return txMapStr, vals, insertCount
-}
-
-func (ds *DBSyncer) bulkInsert(sqlStr string, vals []interface{}, blockNumber uint64, insertCount int) error {
+func (ds *DBSyncer) bulkInsert(table *dbTable, vals []interface{}, blockNumber uint64, insertCount int) error {
 	start := time.Now()
-	// trim the last
-	sqlStr = sqlStr[0 : len(sqlStr)-1]
 
-	stmtTxs, err := ds.db.Prepare(sqlStr)
-	if err != nil {
-		logger.Error("fail to create prepare", "sql", sqlStr, "err", err)
+	if err := ds.dialect.bulkInsert(ds.ctx, ds.db, table, vals, insertCount); err != nil {
+		logger.Error("fail to insert DB", "table", table.name, "number", blockNumber, "err", err)
 		return err
 	}
 
-	if _, err := stmtTxs.Exec(vals...); err != nil {
-		logger.Error("fail to insert DB (tx)", "number", blockNumber, "err", err)
-		return err
-	}
-	defer func() {
-		if err := stmtTxs.Close(); err != nil {
-			logger.Error("fail to close stmt", "err", err)
-		}
-	}()
-
 	if ds.logMode {
 		txTime := time.Since(start)
 		logger.Info("TX/BLOCK INSERT", "block", blockNumber, "counts", insertCount, "time", txTime)
@@ -77,6 +77,9 @@ func (ds *DBSyncer) HandleChainEventContext(block *types.Block) error {
 
 func (ds *DBSyncer) syncBlockHeaderContext(ctx context.Context, tx *sql.Tx, block *types.Block) error {
 	proposerAddr, committeeAddrs, err := getProposerAndValidatorsFromBlock(block)
+	if err != nil {
+		return err
+	}
 
 	totalTx := block.Transactions().Len()
 	committee := strings.ToLower(committeeAddrs)
@@ -91,21 +94,10 @@ func (ds *DBSyncer) syncBlockHeaderContext(ctx context.Context, tx *sql.Tx, bloc
 	timestamp := block.Header().Time.String()
 	timestampFos := block.Header().TimeFoS
 
-	stmtIns, err := ds.db.Prepare(ds.blockInsertQuery)
-	if err != nil {
-		logger.Error("fail to prepare (block)", "query", ds.blockInsertQuery)
-		return err
-	}
-	tStmtIns := tx.StmtContext(ctx, stmtIns)
-
-	defer func() {
-		if err := tStmtIns.Close(); err != nil {
-			logger.Error("fail to close stmt", "err", err)
-		}
-	}()
+	vals := []interface{}{totalTx, committee, gasUsed, gasPrice, hash,
+		number, parentHash, proposer, reward, size, timestamp, timestampFos}
 
-	if _, err := tStmtIns.Exec(totalTx, committee, gasUsed, gasPrice, hash,
-		number, parentHash, proposer, reward, size, timestamp, timestampFos); err != nil {
+	if err := ds.dialect.bulkInsert(ctx, tx, blockTable, vals, 1); err != nil {
 		logger.Error("fail to insert DB (block)", "number", block.Number(), "err", err)
 		return err
 	}
@@ -115,78 +107,78 @@ func (ds *DBSyncer) syncBlockHeaderContext(ctx context.Context, tx *sql.Tx, bloc
 
 func (ds *DBSyncer) syncTransactionsContext(ctx context.Context, syncTx *sql.Tx, block *types.Block) error {
 	txKey := block.NumberU64() * TX_KEY_FACTOR
-	txStr, vals, insertCount := ds.resetTxParameter()
-	summaryStr, summaryVals, summaryInsertCount := ds.resetSummaryParameter()
-	txMapStr, txMapVals, txMapInsertCount := ds.resetTxMapParameter()
+	vals, insertCount := resetParameter()
+	summaryVals, summaryInsertCount := resetParameter()
+	txMapVals, txMapInsertCount := resetParameter()
 
 	receipts := ds.blockchain.GetReceiptsByBlockHash(block.Hash())
 
 	for index, tx := range block.Transactions() {
 		txKey += uint64(index)
-		cols, val, txMapArg, summaryArg, err := MakeTxDBRow(block, txKey, tx, receipts[index])
+		val, txMapArg, summaryArg, err := MakeTxDBRow(block, txKey, tx, receipts[index])
 		if err != nil {
 			return err
 		}
 
-		txStr += cols + ","
 		vals = append(vals, val...)
 		insertCount++
 
 		if insertCount >= ds.bulkInsertSize {
-			if err := ds.bulkInsertContext(ctx, syncTx, txStr, vals, block, insertCount); err != nil {
+			if err := ds.bulkInsertContext(ctx, syncTx, txTable, vals, block, insertCount); err != nil {
 				return err
 			}
-			txStr, vals, insertCount = ds.resetTxParameter()
+			vals, insertCount = resetParameter()
 		}
 
-		scols, sval, count, err := MakeSummaryDBRow(summaryArg)
+		sval, count, err := MakeSummaryDBRow(summaryArg)
+		if err != nil {
+			return err
+		}
 
 		if count == 1 {
-			summaryStr += scols + ","
 			summaryVals = append(summaryVals, sval...)
 			summaryInsertCount++
 		}
 
 		if summaryInsertCount >= ds.bulkInsertSize {
-			if err := ds.bulkInsertContext(ctx, syncTx, summaryStr, summaryVals, block, summaryInsertCount); err != nil {
+			if err := ds.bulkInsertContext(ctx, syncTx, summaryTable, summaryVals, block, summaryInsertCount); err != nil {
 				return err
 			}
-			summaryStr, summaryVals, summaryInsertCount = ds.resetTxParameter()
+			summaryVals, summaryInsertCount = resetParameter()
 		}
 
-		tcols, tval, tcount, err := MakeTxMappingRow(txMapArg)
+		tval, tcount, err := MakeTxMappingRow(txMapArg)
 		if err != nil {
 			return err
 		}
 
 		if tcount == 1 {
-			txMapStr += tcols + ","
 			txMapVals = append(txMapVals, tval...)
 			txMapInsertCount++
 		}
 
 		if txMapInsertCount >= ds.bulkInsertSize {
-			if err := ds.bulkInsertContext(ctx, syncTx, txMapStr, txMapVals, block, txMapInsertCount); err != nil {
+			if err := ds.bulkInsertContext(ctx, syncTx, txHashMapTable, txMapVals, block, txMapInsertCount); err != nil {
 				return err
 			}
-			txMapStr, txMapVals, txMapInsertCount = ds.resetTxMapParameter()
+			txMapVals, txMapInsertCount = resetParameter()
 		}
 	}
 
 	if insertCount > 0 {
-		if err := ds.bulkInsertContext(ctx, syncTx, txStr, vals, block, insertCount); err != nil {
+		if err := ds.bulkInsertContext(ctx, syncTx, txTable, vals, block, insertCount); err != nil {
 			return err
 		}
 	}
 
 	if summaryInsertCount > 0 {
-		if err := ds.bulkInsertContext(ctx, syncTx, summaryStr, summaryVals, block, summaryInsertCount); err != nil {
+		if err := ds.bulkInsertContext(ctx, syncTx, summaryTable, summaryVals, block, summaryInsertCount); err != nil {
 			return err
 		}
 	}
 
 	if txMapInsertCount > 0 {
-		if err := ds.bulkInsertContext(ctx, syncTx, txMapStr, txMapVals, block, txMapInsertCount); err != nil {
+		if err := ds.bulkInsertContext(ctx, syncTx, txHashMapTable, txMapVals, block, txMapInsertCount); err != nil {
 			return err
 		}
 	}
@@ -194,27 +186,13 @@ func (ds *DBSyncer) syncTransactionsContext(ctx context.Context, syncTx *sql.Tx,
 	return nil
 }
 
-func (ds *DBSyncer) bulkInsertContext(ctx context.Context, tx *sql.Tx, sqlStr string, vals []interface{}, block *types.Block, insertCount int) error {
+func (ds *DBSyncer) bulkInsertContext(ctx context.Context, tx *sql.Tx, table *dbTable, vals []interface{}, block *types.Block, insertCount int) error {
 	start := time.Now()
-	// trim the last
-	sqlStr = sqlStr[0 : len(sqlStr)-1]
-
-	stmtTxs, err := ds.db.Prepare(sqlStr)
-	if err != nil {
-		logger.Error("fail to create prepare", "sql", sqlStr, "err", err)
-		return err
-	}
-	tStmtTxs := tx.StmtContext(ctx, stmtTxs)
 
-	if _, err := tStmtTxs.Exec(vals...); err != nil {
-		logger.Error("fail to insert DB (tx)", "number", block.Number(), "err", err)
+	if err := ds.dialect.bulkInsert(ctx, tx, table, vals, insertCount); err != nil {
+		logger.Error("fail to insert DB", "table", table.name, "number", block.Number(), "err", err)
 		return err
 	}
-	defer func() {
-		if err := tStmtTxs.Close(); err != nil {
-			logger.Error("fail to close stmt", "err", err)
-		}
-	}()
 
 	if ds.logMode {
 		txTime := time.Since(start)
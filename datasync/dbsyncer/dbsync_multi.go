@@ -82,7 +82,6 @@ RESULT:
 }
 
 func (ds *DBSyncer) parallelSyncBlockHeader(block *types.Block) ([]*BulkInsertQuery, error) {
-	blockStr := ds.blockInsertQuery + ","
 	vals := []interface{}{}
 
 	bulkInsertQuerys := []*BulkInsertQuery{}
@@ -108,15 +107,15 @@ func (ds *DBSyncer) parallelSyncBlockHeader(block *types.Block) ([]*BulkInsertQu
 	vals = append(vals, totalTx, committee, gasUsed, gasPrice, hash,
 		number, parentHash, proposer, reward, size, timestamp, timestampFos)
 
-	bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{blockStr, vals, block.NumberU64(), 1})
+	bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{blockTable, vals, block.NumberU64(), 1})
 
 	return bulkInsertQuerys, nil
 }
 
 func (ds *DBSyncer) parallelSyncTransactions(block *types.Block, receipts types.Receipts, bulkInsertQuerys []*BulkInsertQuery) ([]*BulkInsertQuery, error) {
-	txStr, vals, insertCount := ds.resetTxParameter()
-	summaryStr, summaryVals, summaryInsertCount := ds.resetSummaryParameter()
-	txMapStr, txMapVals, txMapInsertCount := ds.resetTxMapParameter()
+	vals, insertCount := resetParameter()
+	summaryVals, summaryInsertCount := resetParameter()
+	txMapVals, txMapInsertCount := resetParameter()
 
 	txLen := block.Transactions().Len()
 	result := make(chan *MakeQueryResult, txLen)
@@ -138,38 +137,35 @@ QUERY:
 			return nil, record.err
 		}
 
-		txStr += record.cols + ","
 		vals = append(vals, record.val...)
 		insertCount++
 
 		if insertCount >= ds.bulkInsertSize {
-			bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txStr, vals, block.NumberU64(), insertCount})
+			bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txTable, vals, block.NumberU64(), insertCount})
 
-			txStr, vals, insertCount = ds.resetTxParameter()
+			vals, insertCount = resetParameter()
 		}
 
 		if record.count == 1 {
-			summaryStr += record.scols + ","
 			summaryVals = append(summaryVals, record.sval...)
 			summaryInsertCount++
 		}
 
 		if summaryInsertCount >= ds.bulkInsertSize {
-			bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{summaryStr, summaryVals, block.NumberU64(), summaryInsertCount})
+			bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{summaryTable, summaryVals, block.NumberU64(), summaryInsertCount})
 
-			summaryStr, summaryVals, summaryInsertCount = ds.resetTxParameter()
+			summaryVals, summaryInsertCount = resetParameter()
 		}
 
 		if record.tcount == 1 {
-			txMapStr += record.tcols + ","
 			txMapVals = append(txMapVals, record.tval...)
 			txMapInsertCount++
 		}
 
 		if txMapInsertCount >= ds.bulkInsertSize {
-			bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txMapStr, txMapVals, block.NumberU64(), txMapInsertCount})
+			bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txHashMapTable, txMapVals, block.NumberU64(), txMapInsertCount})
 
-			txMapStr, txMapVals, txMapInsertCount = ds.resetTxMapParameter()
+			txMapVals, txMapInsertCount = resetParameter()
 		}
 
 		totalTxs++
@@ -179,15 +175,15 @@ QUERY:
 	}
 
 	if insertCount > 0 {
-		bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txStr, vals, block.NumberU64(), insertCount})
+		bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txTable, vals, block.NumberU64(), insertCount})
 	}
 
 	if summaryInsertCount > 0 {
-		bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{summaryStr, summaryVals, block.NumberU64(), summaryInsertCount})
+		bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{summaryTable, summaryVals, block.NumberU64(), summaryInsertCount})
 	}
 
 	if txMapInsertCount > 0 {
-		bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txMapStr, txMapVals, block.NumberU64(), txMapInsertCount})
+		bulkInsertQuerys = append(bulkInsertQuerys, &BulkInsertQuery{txHashMapTable, txMapVals, block.NumberU64(), txMapInsertCount})
 	}
 
 	return bulkInsertQuerys, nil
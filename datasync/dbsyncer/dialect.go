@@ -0,0 +1,120 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package dbsyncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+const (
+	MYSQL    = "mysql"
+	POSTGRES = "postgres"
+)
+
+// dbTable describes a table the dbsyncer writes to.
+type dbTable struct {
+	name     string
+	columns  []string
+	conflict string // the unique column used to resolve a conflict
+}
+
+var (
+	blockTable = &dbTable{
+		name: "block",
+		columns: []string{"totalTx", "committee", "gasUsed", "gasPrice", "hash", "number", "parentHash",
+			"proposer", "reward", "size", "timestamp", "timestampFoS"},
+		conflict: "number",
+	}
+	txTable = &dbTable{
+		name: "transaction",
+		columns: []string{"id", "blockHash", "blockNumber", "contractAddress", "from", "gas", "gasPrice",
+			"gasUsed", "input", "nonce", "status", "to", "timestamp", "txHash", "type", "value", "feePayer",
+			"feeRatio", "senderTxHash"},
+		conflict: "id",
+	}
+	summaryTable = &dbTable{
+		name:     "account_summary",
+		columns:  []string{"address", "type", "creator", "created_tx", "hra"},
+		conflict: "address",
+	}
+	txHashMapTable = &dbTable{
+		name:     "sendertxhash_map",
+		columns:  []string{"senderTxHash", "txHash"},
+		conflict: "senderTxHash",
+	}
+)
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// sqlDialect abstracts the SQL dialect of the database the dbsyncer writes to.
+type sqlDialect interface {
+	// driverName returns the name of the database/sql driver.
+	driverName() string
+
+	// dataSource returns the data source name of the database.
+	dataSource(cfg *DBConfig) string
+
+	// bulkInsert inserts the rows into the table. vals holds the values of the rows
+	// in the order of the table columns, and insertCount is the number of the rows.
+	bulkInsert(ctx context.Context, conn execer, table *dbTable, vals []interface{}, insertCount int) error
+}
+
+func newSQLDialect(cfg *DBConfig) (sqlDialect, error) {
+	switch strings.ToLower(cfg.DBType) {
+	case "", MYSQL:
+		return &mysqlDialect{dbName: cfg.DBName}, nil
+	case POSTGRES:
+		return &postgresDialect{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported db type (%s)", cfg.DBType)
+	}
+}
+
+// placeholders returns the placeholders of the rows, such as "(?,?),(?,?)".
+// placeholder returns the placeholder of the i-th value.
+func placeholders(insertCount int, numColumns int, placeholder func(i int) string) string {
+	var sb strings.Builder
+	for row := 0; row < insertCount; row++ {
+		if row > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("(")
+		for col := 0; col < numColumns; col++ {
+			if col > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(placeholder(row*numColumns + col))
+		}
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+func quoteColumns(columns []string, quote func(string) string) []string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = quote(column)
+	}
+	return quoted
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package dbsyncer
+
+import (
+	"context"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// mysqlDialect writes rows with multi-row INSERT statements.
+type mysqlDialect struct {
+	dbName string
+}
+
+func (d *mysqlDialect) driverName() string {
+	return MYSQL
+}
+
+func (d *mysqlDialect) dataSource(cfg *DBConfig) string {
+	return cfg.DBUser + ":" + cfg.DBPassword + "@tcp(" + cfg.DBHost + ":" +
+		cfg.DBPort + ")/" + cfg.DBName + "?writeTimeout=10s&timeout=10s"
+}
+
+// insertQuery returns an INSERT statement of the table with the placeholders of insertCount rows.
+func (d *mysqlDialect) insertQuery(table *dbTable, insertCount int) string {
+	columns := quoteColumns(table.columns, func(column string) string { return "`" + column + "`" })
+	return "INSERT INTO " + d.dbName + "." + table.name + " (" + strings.Join(columns, ", ") + ") VALUES " +
+		placeholders(insertCount, len(table.columns), func(int) string { return "?" })
+}
+
+func (d *mysqlDialect) bulkInsert(ctx context.Context, conn execer, table *dbTable, vals []interface{}, insertCount int) error {
+	query := d.insertQuery(table, insertCount)
+
+	stmt, err := conn.PrepareContext(ctx, query)
+	if err != nil {
+		logger.Error("fail to create prepare", "table", table.name, "err", err)
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("fail to close stmt", "err", err)
+		}
+	}()
+
+	_, err = stmt.ExecContext(ctx, vals...)
+	return err
+}
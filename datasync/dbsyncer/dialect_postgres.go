@@ -0,0 +1,181 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package dbsyncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/lib/pq"
+)
+
+// postgresDialect loads rows into a temporary table with COPY and moves them into the
+// target table with an INSERT ... ON CONFLICT DO UPDATE, so that a block can be synced again.
+// An INSERT ... ON CONFLICT DO UPDATE cannot update a row twice, so only the last of
+// the rows with the same conflict key in a batch is written.
+// Identifiers are lower-cased, which is what an unquoted identifier of a PostgreSQL
+// schema folds to.
+type postgresDialect struct {
+	tmpTableSeq uint64
+}
+
+func (d *postgresDialect) driverName() string {
+	return POSTGRES
+}
+
+func (d *postgresDialect) dataSource(cfg *DBConfig) string {
+	dsn := url.URL{
+		Scheme:   POSTGRES,
+		User:     url.UserPassword(cfg.DBUser, cfg.DBPassword),
+		Host:     net.JoinHostPort(cfg.DBHost, cfg.DBPort),
+		Path:     "/" + cfg.DBName,
+		RawQuery: "connect_timeout=10&sslmode=disable",
+	}
+	return dsn.String()
+}
+
+func (d *postgresDialect) quote(identifier string) string {
+	return pq.QuoteIdentifier(strings.ToLower(identifier))
+}
+
+// upsertQuery returns an INSERT statement of the table which updates the conflicting row.
+// If from is empty, the statement inserts the rows of the placeholders of insertCount rows.
+// Otherwise, it inserts all rows of the table from.
+func (d *postgresDialect) upsertQuery(table *dbTable, insertCount int, from string) string {
+	columns := strings.Join(quoteColumns(table.columns, d.quote), ", ")
+	conflict := d.quote(table.conflict)
+
+	var updates []string
+	for _, column := range table.columns {
+		if quoted := d.quote(column); quoted != conflict {
+			updates = append(updates, quoted+" = EXCLUDED."+quoted)
+		}
+	}
+
+	query := "INSERT INTO " + d.quote(table.name) + " (" + columns + ") "
+	if from == "" {
+		query += "VALUES " + placeholders(insertCount, len(table.columns), func(i int) string { return "$" + strconv.Itoa(i+1) })
+	} else {
+		query += "SELECT " + columns + " FROM " + from
+	}
+	return query + " ON CONFLICT (" + conflict + ") DO UPDATE SET " + strings.Join(updates, ", ")
+}
+
+// dedupeRows returns the rows without the rows whose conflict key appears again later,
+// keeping the order of the remaining rows.
+func dedupeRows(table *dbTable, vals []interface{}, insertCount int) ([]interface{}, int) {
+	numColumns := len(table.columns)
+	conflictIdx := -1
+	for i, column := range table.columns {
+		if strings.EqualFold(column, table.conflict) {
+			conflictIdx = i
+			break
+		}
+	}
+	if conflictIdx < 0 || insertCount < 2 {
+		return vals, insertCount
+	}
+
+	last := make(map[string]int, insertCount)
+	for row := 0; row < insertCount; row++ {
+		last[fmt.Sprintf("%v", vals[row*numColumns+conflictIdx])] = row
+	}
+	if len(last) == insertCount {
+		return vals, insertCount
+	}
+
+	deduped := make([]interface{}, 0, len(last)*numColumns)
+	for row := 0; row < insertCount; row++ {
+		if last[fmt.Sprintf("%v", vals[row*numColumns+conflictIdx])] == row {
+			deduped = append(deduped, vals[row*numColumns:(row+1)*numColumns]...)
+		}
+	}
+	return deduped, len(last)
+}
+
+func (d *postgresDialect) bulkInsert(ctx context.Context, conn execer, table *dbTable, vals []interface{}, insertCount int) error {
+	vals, insertCount = dedupeRows(table, vals, insertCount)
+	if insertCount == 1 {
+		_, err := conn.ExecContext(ctx, d.upsertQuery(table, insertCount, ""), vals...)
+		return err
+	}
+
+	// COPY needs a transaction since the temporary table is dropped on commit.
+	db, ok := conn.(*sql.DB)
+	if !ok {
+		return d.copyInsert(ctx, conn, table, vals, insertCount)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := d.copyInsert(ctx, tx, table, vals, insertCount); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			logger.Error("fail to rollback tx", "table", table.name, "err", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// copyInsert copies the rows into a temporary table and upserts them into the table.
+// conn must be a transaction.
+func (d *postgresDialect) copyInsert(ctx context.Context, conn execer, table *dbTable, vals []interface{}, insertCount int) error {
+	tmpTable := fmt.Sprintf("%s_tmp_%d", strings.ToLower(table.name), atomic.AddUint64(&d.tmpTableSeq, 1))
+
+	if _, err := conn.ExecContext(ctx, "CREATE TEMPORARY TABLE "+d.quote(tmpTable)+
+		" (LIKE "+d.quote(table.name)+" INCLUDING DEFAULTS) ON COMMIT DROP"); err != nil {
+		logger.Error("fail to create temporary table", "table", tmpTable, "err", err)
+		return err
+	}
+
+	columns := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		columns[i] = strings.ToLower(column)
+	}
+	stmt, err := conn.PrepareContext(ctx, pq.CopyIn(tmpTable, columns...))
+	if err != nil {
+		logger.Error("fail to create prepare", "table", tmpTable, "err", err)
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("fail to close stmt", "err", err)
+		}
+	}()
+
+	numColumns := len(table.columns)
+	for row := 0; row < insertCount; row++ {
+		if _, err := stmt.ExecContext(ctx, vals[row*numColumns:(row+1)*numColumns]...); err != nil {
+			return err
+		}
+	}
+	// flush the buffered rows
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, d.upsertQuery(table, insertCount, d.quote(tmpTable)))
+	return err
+}
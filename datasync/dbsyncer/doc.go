@@ -16,16 +16,20 @@
 
 /*
 Package dbsyncer implements blockchain data synchronisation to relational database.
+MySQL and PostgreSQL are supported, and the database is chosen by DBConfig.DBType.
 
 Source Files
 
-  - config.go         : includes configurations, mostly related to the connected database
-  - dbsync.go         : implements data synchronisation operations
-  - dbsync_context.go : provides context for chain event, block header, transactions and bulk inserts
-  - dbsync_multi.go   : supports parallel synchronisation
-  - gen_config.go     : is automatically generated from config.go
-  - query_engine.go   : supports query level requests and results
-  - tx_record.go      : manages transaction data handling
-  - utils.go          : includes utility functions for dbsyncer package
+  - config.go           : includes configurations, mostly related to the connected database
+  - dbsync.go           : implements data synchronisation operations
+  - dbsync_context.go   : provides context for chain event, block header, transactions and bulk inserts
+  - dbsync_multi.go     : supports parallel synchronisation
+  - dialect.go          : defines the synchronised tables and the interface of SQL dialects
+  - dialect_mysql.go    : implements the MySQL dialect with multi-row inserts
+  - dialect_postgres.go : implements the PostgreSQL dialect with COPY and ON CONFLICT upserts
+  - gen_config.go       : is automatically generated from config.go
+  - query_engine.go     : supports query level requests and results
+  - tx_record.go        : manages transaction data handling
+  - utils.go            : includes utility functions for dbsyncer package
 */
 package dbsyncer
@@ -11,6 +11,7 @@ func (d DBConfig) MarshalTOML() (interface{}, error) {
 	type DBConfig struct {
 		EnabledDBSyncer  bool
 		EnabledLogMode   bool
+		DBType           string        `toml:",omitempty"`
 		DBHost           string        `toml:",omitempty"`
 		DBPort           string        `toml:",omitempty"`
 		DBUser           string        `toml:",omitempty"`
@@ -30,6 +31,7 @@ func (d DBConfig) MarshalTOML() (interface{}, error) {
 	var enc DBConfig
 	enc.EnabledDBSyncer = d.EnabledDBSyncer
 	enc.EnabledLogMode = d.EnabledLogMode
+	enc.DBType = d.DBType
 	enc.DBHost = d.DBHost
 	enc.DBPort = d.DBPort
 	enc.DBUser = d.DBUser
@@ -53,6 +55,7 @@ func (d *DBConfig) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	type DBConfig struct {
 		EnabledDBSyncer  *bool
 		EnabledLogMode   *bool
+		DBType           *string        `toml:",omitempty"`
 		DBHost           *string        `toml:",omitempty"`
 		DBPort           *string        `toml:",omitempty"`
 		DBUser           *string        `toml:",omitempty"`
@@ -79,6 +82,9 @@ func (d *DBConfig) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.EnabledLogMode != nil {
 		d.EnabledLogMode = *dec.EnabledLogMode
 	}
+	if dec.DBType != nil {
+		d.DBType = *dec.DBType
+	}
 	if dec.DBHost != nil {
 		d.DBHost = *dec.DBHost
 	}
@@ -45,14 +45,11 @@ type BulkInsertResult struct {
 type MakeQueryResult struct {
 	block *types.Block
 
-	cols string
-	val  []interface{}
+	val []interface{}
 
-	scols string
 	sval  []interface{}
 	count int
 
-	tcols  string
 	tval   []interface{}
 	tcount int
 
@@ -87,9 +84,9 @@ func newQueryEngine(ds *DBSyncer, taskQueue int, insertQueue int) *QueryEngine {
 }
 
 func (qe *QueryEngine) make(block *types.Block, txKey uint64, tx *types.Transaction, receipt *types.Receipt, result chan *MakeQueryResult) {
-	cols, val, txMapArg, summaryArg, err := MakeTxDBRow(block, txKey, tx, receipt)
-	scols, sval, count, serr := MakeSummaryDBRow(summaryArg)
-	tcols, tval, tcount, terr := MakeTxMappingRow(txMapArg)
+	val, txMapArg, summaryArg, err := MakeTxDBRow(block, txKey, tx, receipt)
+	sval, count, serr := MakeSummaryDBRow(summaryArg)
+	tval, tcount, terr := MakeTxMappingRow(txMapArg)
 
 	defer func() {
 		// recover from panic caused by writing to a closed channel
@@ -99,19 +96,19 @@ func (qe *QueryEngine) make(block *types.Block, txKey uint64, tx *types.Transact
 		}
 	}()
 	if err == nil && serr == nil && terr == nil {
-		result <- &MakeQueryResult{block, cols, val, scols, sval, count, tcols, tval, tcount, nil}
+		result <- &MakeQueryResult{block, val, sval, count, tval, tcount, nil}
 	} else {
 		if err != nil {
 			logger.Error("fail to make row (tx)", "err", err)
-			result <- &MakeQueryResult{block, cols, val, scols, sval, count, tcols, tval, tcount, err}
+			result <- &MakeQueryResult{block, val, sval, count, tval, tcount, err}
 		}
 		if serr != nil {
 			logger.Error("fail to make row (summary)", "err", serr)
-			result <- &MakeQueryResult{block, cols, val, scols, sval, count, tcols, tval, tcount, serr}
+			result <- &MakeQueryResult{block, val, sval, count, tval, tcount, serr}
 		}
 		if terr != nil {
 			logger.Error("fail to make row (senderHash)", "err", terr)
-			result <- &MakeQueryResult{block, cols, val, scols, sval, count, tcols, tval, tcount, terr}
+			result <- &MakeQueryResult{block, val, sval, count, tval, tcount, terr}
 		}
 	}
 }
@@ -124,7 +121,7 @@ func (qe *QueryEngine) execute(insertQuery *BulkInsertQuery, result chan *BulkIn
 			return
 		}
 	}()
-	if err := qe.ds.bulkInsert(insertQuery.parameters, insertQuery.vals, insertQuery.blockNumber, insertQuery.insertCount); err != nil {
+	if err := qe.ds.bulkInsert(insertQuery.table, insertQuery.vals, insertQuery.blockNumber, insertQuery.insertCount); err != nil {
 		logger.Error("fail to bulkinsert (tx/summary/senderHash)", "err", err)
 		result <- &BulkInsertResult{err}
 	}
@@ -44,14 +44,13 @@ type TxReceiptArgument struct {
 }
 
 type BulkInsertQuery struct {
-	parameters  string
+	table       *dbTable
 	vals        []interface{}
 	blockNumber uint64
 	insertCount int
 }
 
-func MakeTxDBRow(block *types.Block, txKey uint64, tx *types.Transaction, receipt *types.Receipt) (string, []interface{}, TxMapArguments, SummaryArguments, error) {
-	cols := ""
+func MakeTxDBRow(block *types.Block, txKey uint64, tx *types.Transaction, receipt *types.Receipt) ([]interface{}, TxMapArguments, SummaryArguments, error) {
 	vals := []interface{}{}
 
 	blockHash := block.Hash().Hex()
@@ -68,14 +67,14 @@ func MakeTxDBRow(block *types.Block, txKey uint64, tx *types.Transaction, receip
 		addr, err := types.Sender(signer, tx)
 		if err != nil {
 			logger.Error("fail to tx.From", "err", err)
-			return "", []interface{}{}, TxMapArguments{}, SummaryArguments{}, err
+			return []interface{}{}, TxMapArguments{}, SummaryArguments{}, err
 		}
 		from = strings.ToLower(addr.Hex())
 	} else {
 		addr, err := tx.From()
 		if err != nil {
 			logger.Error("fail to tx.From", "err", err)
-			return "", []interface{}{}, TxMapArguments{}, SummaryArguments{}, err
+			return []interface{}{}, TxMapArguments{}, SummaryArguments{}, err
 		}
 		from = strings.ToLower(addr.Hex())
 	}
@@ -106,7 +105,7 @@ func MakeTxDBRow(block *types.Block, txKey uint64, tx *types.Transaction, receip
 		feeAddr, err := tx.FeePayer()
 		if err != nil {
 			logger.Error("fail to tx.FeePayer", "err", err)
-			return "", []interface{}{}, TxMapArguments{}, SummaryArguments{}, err
+			return []interface{}{}, TxMapArguments{}, SummaryArguments{}, err
 		}
 		ratio, _ := tx.FeeRatio()
 		// ok is false in TxTypeFeeDelegatedValueTransfer
@@ -121,17 +120,16 @@ func MakeTxDBRow(block *types.Block, txKey uint64, tx *types.Transaction, receip
 		senderHash = senderTxHash.Hex()
 	}
 
-	cols = "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
 	vals = append(vals, txKey, blockHash, blockNumber, contractAddress, from, gas, txGasPrice, txGasUsed, input, nonce, status, to,
 		timestamp, txHash, txtype, value, feePayer, feeRatio, senderHash)
 
 	TxMapArg := TxMapArguments{senderHash, txHash}
 	summaryArg := SummaryArguments{from, txHash, to, contractAddress, tx}
 
-	return cols, vals, TxMapArg, summaryArg, nil
+	return vals, TxMapArg, summaryArg, nil
 }
 
-func MakeSummaryDBRow(sa SummaryArguments) (cols string, vals []interface{}, count int, err error) {
+func MakeSummaryDBRow(sa SummaryArguments) (vals []interface{}, count int, err error) {
 	// insert account summary for creation and deploy
 	if !sa.tx.IsEthereumTransaction() {
 		if sa.tx.Type().IsAccountCreation() {
@@ -149,7 +147,6 @@ func MakeSummaryDBRow(sa SummaryArguments) (cols string, vals []interface{}, cou
 				hra = internalTx.HumanReadable
 			}
 
-			cols = "(?,?,?,?,?)"
 			vals = append(vals, sa.to, accountType, creator, createdTx, hra)
 
 			count = 1
@@ -173,7 +170,6 @@ func MakeSummaryDBRow(sa SummaryArguments) (cols string, vals []interface{}, cou
 				logger.Error("fail to convert ", "type", internalTx, "txhash", sa.tx.Hash().Hex())
 			}
 
-			cols = "(?,?,?,?,?)"
 			vals = append(vals, sa.contractAddress, accountType, creator, createdTx, hra)
 
 			count = 1
@@ -184,22 +180,20 @@ func MakeSummaryDBRow(sa SummaryArguments) (cols string, vals []interface{}, cou
 		creator := sa.from
 		createdTx := sa.txHash
 
-		cols = "(?,?,?,?,?)"
 		vals = append(vals, sa.contractAddress, accountType, creator, createdTx, false)
 
 		count = 1
 	}
 
-	return cols, vals, count, nil
+	return vals, count, nil
 }
 
-func MakeTxMappingRow(tm TxMapArguments) (cols string, vals []interface{}, count int, err error) {
+func MakeTxMappingRow(tm TxMapArguments) (vals []interface{}, count int, err error) {
 	if tm.senderTxHash != "" {
-		cols = "(?,?)"
 		vals = append(vals, tm.senderTxHash, tm.txHash)
 
 		count = 1
 	}
 
-	return cols, vals, count, nil
+	return vals, count, nil
 }
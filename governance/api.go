@@ -19,6 +19,7 @@ package governance
 import (
 	"errors"
 	"math/big"
+	"reflect"
 	"strings"
 
 	"github.com/klaytn/klaytn/common/hexutil"
@@ -27,6 +28,7 @@ import (
 	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/reward"
+	"github.com/klaytn/klaytn/rlp"
 )
 
 type PublicGovernanceAPI struct {
@@ -104,29 +106,37 @@ func (api *PublicGovernanceAPI) Vote(key string, val interface{}) (string, error
 	if GovernanceModeMap[gMode] == params.GovernanceMode_Single && gNode != api.governance.NodeAddress() {
 		return "", errPermissionDenied
 	}
+	if _, err := api.validateVote(key, val); err != nil {
+		return "", err
+	}
+	if api.governance.AddVote(key, val) {
+		return "Your vote is prepared. It will be put into the block header or applied when your node generates a block as a proposer. Note that your vote may be duplicate.", nil
+	}
+	return "", errInvalidKeyValue
+}
+
+// validateVote checks the given vote against the current governance parameters.
+func (api *PublicGovernanceAPI) validateVote(key string, val interface{}) (*GovernanceVote, error) {
 	vote, ok := api.governance.ValidateVote(&GovernanceVote{Key: strings.ToLower(key), Value: val})
 	if !ok {
-		return "", errInvalidKeyValue
+		return nil, errInvalidKeyValue
 	}
 	if vote.Key == "governance.removevalidator" {
 		if api.isRemovingSelf(val.(string)) {
-			return "", errRemoveSelf
+			return nil, errRemoveSelf
 		}
 	}
 	if vote.Key == "kip71.lowerboundbasefee" {
 		if vote.Value.(uint64) > api.governance.UpperBoundBaseFee() {
-			return "", errInvalidLowerBound
+			return nil, errInvalidLowerBound
 		}
 	}
 	if vote.Key == "kip71.upperboundbasefee" {
 		if vote.Value.(uint64) < api.governance.LowerBoundBaseFee() {
-			return "", errInvalidUpperBound
+			return nil, errInvalidUpperBound
 		}
 	}
-	if api.governance.AddVote(key, val) {
-		return "Your vote is prepared. It will be put into the block header or applied when your node generates a block as a proposer. Note that your vote may be duplicate.", nil
-	}
-	return "", errInvalidKeyValue
+	return vote, nil
 }
 
 func (api *PublicGovernanceAPI) isRemovingSelf(val string) bool {
@@ -237,6 +247,110 @@ func (api *PublicGovernanceAPI) ItemCacheFromDb(num *rpc.BlockNumber) map[string
 	return ret
 }
 
+// ParamChange represents a value of a governance item and the blocks where it was
+// stored and from which it was effective.
+type ParamChange struct {
+	Value         interface{} `json:"value"`
+	StoredAt      uint64      `json:"storedAt"`
+	EffectiveFrom uint64      `json:"effectiveFrom"`
+}
+
+// ParamHistory returns the change history of the governance items, ordered by block number.
+// The history covers the governance indices kept in the idx cache.
+// If a key is given, only the history of the key is returned.
+func (api *PublicGovernanceAPI) ParamHistory(key *string) (map[string][]*ParamChange, error) {
+	var target string
+	if key != nil && *key != "" {
+		target = strings.ToLower(strings.Trim(*key, " "))
+		if _, ok := GovernanceKeyMap[target]; !ok {
+			return nil, ErrUnknownKey
+		}
+	}
+
+	epoch := api.governance.Epoch()
+	ret := make(map[string][]*ParamChange)
+	for _, idx := range api.governance.IdxCache() {
+		data, err := api.governance.DB().ReadGovernance(idx)
+		if err != nil {
+			return nil, err
+		}
+		effectiveFrom := idx
+		if idx > 0 {
+			// governance data stored at an epoch block is read from the next epoch
+			effectiveFrom = idx + epoch
+		}
+		for k, v := range adjustDecodedSet(data) {
+			if target != "" && k != target {
+				continue
+			}
+			history := ret[k]
+			if len(history) > 0 && reflect.DeepEqual(history[len(history)-1].Value, v) {
+				continue
+			}
+			ret[k] = append(history, &ParamChange{Value: v, StoredAt: idx, EffectiveFrom: effectiveFrom})
+		}
+	}
+	return ret, nil
+}
+
+// DryRunVoteResult represents the result of a dry-run vote.
+type DryRunVoteResult struct {
+	Key          string      `json:"key"`
+	Value        interface{} `json:"value"`
+	CurrentValue interface{} `json:"currentValue"`
+	VoteBlock    uint64      `json:"voteBlock"`
+	ApplyBlock   uint64      `json:"applyBlock"`
+}
+
+// DryRunVote validates the given vote without casting it. The vote is checked as Vote does
+// and as it would be parsed from a block header. It returns the block where the vote would
+// be put if it were cast now, and the block from which it would apply if it passed there.
+func (api *PublicGovernanceAPI) DryRunVote(key string, val interface{}) (*DryRunVoteResult, error) {
+	vote, err := api.validateVote(key, val)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := GovernanceForbiddenKeyMap[vote.Key]; ok {
+		return nil, errInvalidKeyValue
+	}
+
+	// Round-trip the vote through the header encoding
+	encoded, err := rlp.EncodeToBytes(&GovernanceVote{Validator: api.governance.NodeAddress(), Key: vote.Key, Value: vote.Value})
+	if err != nil {
+		return nil, err
+	}
+	received := new(GovernanceVote)
+	if err := rlp.DecodeBytes(encoded, received); err != nil {
+		return nil, err
+	}
+	if received, err = api.governance.ParseVoteValue(received); err != nil {
+		return nil, err
+	}
+	if _, ok := api.governance.ValidateVote(received); !ok {
+		return nil, errInvalidKeyValue
+	}
+
+	epoch := api.governance.Epoch()
+	voteBlock := api.governance.BlockChain().CurrentHeader().Number.Uint64() + 1
+	var applyBlock uint64
+	switch GovernanceKeyMap[vote.Key] {
+	case params.AddValidator, params.RemoveValidator:
+		// the validator set is changed as soon as the vote passes
+		applyBlock = voteBlock + 1
+	default:
+		// a passed vote is written at the next epoch block and read from the epoch after it
+		applyBlock = (voteBlock/epoch+1)*epoch + epoch
+	}
+
+	return &DryRunVoteResult{
+		Key:          received.Key,
+		Value:        received.Value,
+		CurrentValue: api.governance.CurrentSetCopy()[received.Key],
+		VoteBlock:    voteBlock,
+		ApplyBlock:   applyBlock,
+	}, nil
+}
+
 type VoteList struct {
 	Key      string
 	Value    interface{}
@@ -1,9 +1,11 @@
 package governance
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/docker/docker/pkg/testutil/assert"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
@@ -36,3 +38,90 @@ func TestLowerBoundFeeSet(t *testing.T) {
 	_, err := govApi.Vote("kip71.lowerboundbasefee", invalidLowerBoundBaseFee)
 	assert.Equal(t, err, errInvalidLowerBound)
 }
+
+type testBlockChain struct {
+	num uint64
+}
+
+func (bc *testBlockChain) CurrentHeader() *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(bc.num)}
+}
+
+func (bc *testBlockChain) GetHeaderByNumber(val uint64) *types.Header { return nil }
+func (bc *testBlockChain) SetProposerPolicy(val uint64)               {}
+func (bc *testBlockChain) SetUseGiniCoeff(val bool)                   {}
+func (bc *testBlockChain) SetLowerBoundBaseFee(val uint64)            {}
+func (bc *testBlockChain) SetUpperBoundBaseFee(val uint64)            {}
+func (bc *testBlockChain) SetGasTarget(val uint64)                    {}
+func (bc *testBlockChain) SetMaxBlockGasUsedForBaseFee(val uint64)    {}
+func (bc *testBlockChain) SetBaseFeeDenominator(val uint64)           {}
+
+func TestDryRunVote(t *testing.T) {
+	govApi := newTestGovernanceApi()
+	govApi.governance.SetBlockchain(&testBlockChain{num: 100})
+	epoch := govApi.governance.Epoch()
+
+	// the vote would be put in the next block and written at the next epoch block
+	result, err := govApi.DryRunVote("governance.unitprice", float64(50000000000))
+	assert.NilError(t, err)
+	assert.Equal(t, result.Key, "governance.unitprice")
+	assert.Equal(t, result.Value, uint64(50000000000))
+	assert.Equal(t, result.CurrentValue, govApi.governance.UnitPrice())
+	assert.Equal(t, result.VoteBlock, uint64(101))
+	assert.Equal(t, result.ApplyBlock, 2*epoch)
+
+	// the validator set is changed right after the vote
+	result, err = govApi.DryRunVote("governance.addvalidator", "0x0000000000000000000000000000000000000001")
+	assert.NilError(t, err)
+	assert.Equal(t, result.ApplyBlock, uint64(102))
+
+	// the dry-run doesn't cast the vote
+	assert.Equal(t, len(govApi.MyVotes()), 0)
+
+	_, err = govApi.DryRunVote("istanbul.policy", float64(2))
+	assert.Equal(t, err, errInvalidKeyValue)
+	_, err = govApi.DryRunVote("reward.mintingamount", "abc")
+	assert.Equal(t, err, errInvalidKeyValue)
+	_, err = govApi.DryRunVote("kip71.lowerboundbasefee", float64(govApi.governance.UpperBoundBaseFee()+100))
+	assert.Equal(t, err, errInvalidLowerBound)
+}
+
+func TestParamHistory(t *testing.T) {
+	govApi := newTestGovernanceApi()
+	gov := govApi.governance
+	epoch := gov.Epoch()
+
+	_, items, err := gov.ReadGovernance(0)
+	assert.NilError(t, err)
+	base := NewGovernanceSet()
+	base.Import(items)
+
+	delta := NewGovernanceSet()
+	delta.SetValue(params.UnitPrice, uint64(1))
+	assert.NilError(t, gov.WriteGovernance(epoch, base, delta))
+	// the unchanged value is not recorded again
+	base.Merge(delta.Items())
+	assert.NilError(t, gov.WriteGovernance(3*epoch, base, NewGovernanceSet()))
+
+	key := "Governance.UnitPrice"
+	history, err := govApi.ParamHistory(&key)
+	assert.NilError(t, err)
+	assert.Equal(t, len(history), 1)
+
+	changes := history["governance.unitprice"]
+	assert.Equal(t, len(changes), 2)
+	assert.Equal(t, changes[0].StoredAt, uint64(0))
+	assert.Equal(t, changes[0].EffectiveFrom, uint64(0))
+	assert.Equal(t, changes[1].Value, uint64(1))
+	assert.Equal(t, changes[1].StoredAt, epoch)
+	assert.Equal(t, changes[1].EffectiveFrom, 2*epoch)
+
+	history, err = govApi.ParamHistory(nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(history["governance.unitprice"]), 2)
+	assert.Equal(t, len(history["istanbul.epoch"]), 1)
+
+	unknown := "unknown.key"
+	_, err = govApi.ParamHistory(&unknown)
+	assert.Equal(t, err, ErrUnknownKey)
+}
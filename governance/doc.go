@@ -24,6 +24,8 @@ How to cast a vote
 To cast a vote, a node have to be a member of the Governance Council.
 If the governance mode is "single", only one designated node (the governing node) can vote.
 In the console of the node, "governance.vote(key, value)" API can be used to cast a vote.
+"governance.dryRunVote(key, value)" validates a vote without casting it and reports the block from which it would apply if it passed.
+"governance.paramHistory(key)" shows when each value of the governance items was stored and became effective.
 
 Keys for the voting API
 
@@ -51,6 +51,7 @@ type HeaderEngine interface {
 	// Cast votes from API
 	AddVote(key string, val interface{}) bool
 	ValidateVote(vote *GovernanceVote) (*GovernanceVote, bool)
+	ParseVoteValue(gVote *GovernanceVote) (*GovernanceVote, error)
 
 	// Access database for voting states
 	CanWriteGovernanceState(num uint64) bool
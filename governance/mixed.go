@@ -111,6 +111,10 @@ func (e *MixedEngine) ValidateVote(vote *GovernanceVote) (*GovernanceVote, bool)
 	return e.defaultGov.ValidateVote(vote)
 }
 
+func (e *MixedEngine) ParseVoteValue(gVote *GovernanceVote) (*GovernanceVote, error) {
+	return e.defaultGov.ParseVoteValue(gVote)
+}
+
 func (e *MixedEngine) CanWriteGovernanceState(num uint64) bool {
 	return e.defaultGov.CanWriteGovernanceState(num)
 }
@@ -126,6 +126,9 @@ const (
 	KAS
 	FORK
 	NodeCnGasPrice
+	BlockchainStatePruner
+	NodeFeeDelegation
+	NodeGraphQL
 
 	// ModuleNameLen should be placed at the end of the list.
 	ModuleNameLen
@@ -203,4 +206,7 @@ var moduleNames = [ModuleNameLen]string{
 	"kas",
 	"fork",
 	"node/cn/gasprice",
+	"blockchain/state/pruner",
+	"node/feedelegation",
+	"node/graphql",
 }
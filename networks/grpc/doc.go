@@ -25,6 +25,8 @@ Source files
 Each file provides the following features
  - gClient.go : gRPC client implementation.
  - gServer.go : gRPC server implementation.
+ - gKlayClient.go : Typed gRPC client of the KlayAPI service.
+ - gKlayServer.go : Typed gRPC service of the klay namespace APIs, served from the API backend next to the generic JSON-RPC envelope.
  - klaytn.proto : Define a interface and messages to use in gRPC server and clients.
  - klaytn.pb.go : the generated Go file from klaytn.proto by protoc-gen-go.
 */
@@ -161,11 +161,13 @@ func (gkc *gKlaytnClient) handleBiCall(stream KlaytnNode_BiCallClient, request f
 			if err := stream.RecvMsg(&recv); err != nil {
 				logger.Warn("fail to recv response", "err", err)
 				waitGroup.Done()
+				return
 			}
 
 			if err := handle(&recv); err != nil {
 				logger.Warn("fail to handle response", "err", err)
 				waitGroup.Done()
+				return
 			}
 		}
 	}()
@@ -0,0 +1,274 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/rlp"
+	"google.golang.org/grpc"
+)
+
+// KlayClient is a typed gRPC client of the KlayAPI service.
+type KlayClient struct {
+	conn *grpc.ClientConn
+	c    KlayAPIClient
+}
+
+// DialKlayClient connects a typed client to the gRPC server at the given address.
+func DialKlayClient(ctx context.Context, addr string) (*KlayClient, error) {
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return NewKlayClient(conn), nil
+}
+
+// NewKlayClient creates a typed client that uses the given connection.
+func NewKlayClient(conn *grpc.ClientConn) *KlayClient {
+	return &KlayClient{conn: conn, c: NewKlayAPIClient(conn)}
+}
+
+// Close closes the underlying connection.
+func (kc *KlayClient) Close() error {
+	return kc.conn.Close()
+}
+
+// toBlockReference returns the block reference of the given block number.
+// nil means the latest block.
+func toBlockReference(number *big.Int) *BlockReference {
+	if number == nil {
+		return &BlockReference{Reference: &BlockReference_Tag{Tag: BlockTag_LATEST}}
+	}
+	return &BlockReference{Reference: &BlockReference_Number{Number: number.Uint64()}}
+}
+
+// BlockNumber returns the most recent block number.
+func (kc *KlayClient) BlockNumber(ctx context.Context) (uint64, error) {
+	res, err := kc.c.BlockNumber(ctx, &Empty{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Number, nil
+}
+
+// BlockByNumber returns the block of the given number. nil means the latest block.
+// If fullTx is true, the block contains the transaction objects instead of the hashes.
+func (kc *KlayClient) BlockByNumber(ctx context.Context, number *big.Int, fullTx bool) (*Block, error) {
+	return kc.c.GetBlock(ctx, &BlockRequest{Block: toBlockReference(number), FullTransactions: fullTx})
+}
+
+// BlockByHash returns the block of the given hash.
+// If fullTx is true, the block contains the transaction objects instead of the hashes.
+func (kc *KlayClient) BlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (*Block, error) {
+	ref := &BlockReference{Reference: &BlockReference_Hash{Hash: hash.Bytes()}}
+	return kc.c.GetBlock(ctx, &BlockRequest{Block: ref, FullTransactions: fullTx})
+}
+
+// TransactionByHash returns the transaction of the given hash.
+func (kc *KlayClient) TransactionByHash(ctx context.Context, hash common.Hash) (*Transaction, error) {
+	return kc.c.GetTransactionByHash(ctx, &TransactionHashRequest{Hash: hash.Bytes()})
+}
+
+// TransactionReceipt returns the receipt of the transaction of the given hash.
+func (kc *KlayClient) TransactionReceipt(ctx context.Context, hash common.Hash) (*Receipt, error) {
+	return kc.c.GetTransactionReceipt(ctx, &TransactionHashRequest{Hash: hash.Bytes()})
+}
+
+// AccountAt returns the balance, nonce and code of the account at the given block number.
+// nil means the latest block.
+func (kc *KlayClient) AccountAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*Account, error) {
+	return kc.c.GetAccount(ctx, &AccountRequest{Address: account.Bytes(), Block: toBlockReference(blockNumber)})
+}
+
+// BalanceAt returns the balance of the account at the given block number.
+func (kc *KlayClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	acc, err := kc.AccountAt(ctx, account, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(acc.Balance), nil
+}
+
+// NonceAt returns the nonce of the account at the given block number.
+func (kc *KlayClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	acc, err := kc.AccountAt(ctx, account, blockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return acc.Nonce, nil
+}
+
+// CodeAt returns the contract code of the account at the given block number.
+func (kc *KlayClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	acc, err := kc.AccountAt(ctx, account, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return acc.Code, nil
+}
+
+func toCallRequest(msg klaytn.CallMsg) *CallRequest {
+	req := &CallRequest{
+		From:  msg.From.Bytes(),
+		Gas:   msg.Gas,
+		Input: msg.Data,
+	}
+	if msg.To != nil {
+		req.To = msg.To.Bytes()
+	}
+	if msg.GasPrice != nil {
+		req.GasPrice = msg.GasPrice.Bytes()
+	}
+	if msg.Value != nil {
+		req.Value = msg.Value.Bytes()
+	}
+	return req
+}
+
+// CallContract executes a message call at the given block number and returns its output.
+func (kc *KlayClient) CallContract(ctx context.Context, msg klaytn.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	req := toCallRequest(msg)
+	req.Block = toBlockReference(blockNumber)
+	res, err := kc.c.Call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// EstimateGas returns the gas needed to execute the message on the latest state.
+func (kc *KlayClient) EstimateGas(ctx context.Context, msg klaytn.CallMsg) (uint64, error) {
+	res, err := kc.c.EstimateGas(ctx, toCallRequest(msg))
+	if err != nil {
+		return 0, err
+	}
+	return res.Gas, nil
+}
+
+// SendRawTransaction injects the signed transaction into the pending pool and returns its hash.
+func (kc *KlayClient) SendRawTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	raw, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	res, err := kc.c.SendRawTransaction(ctx, &SendRawTransactionRequest{RawTransaction: raw})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(res.Hash), nil
+}
+
+// SubscribeNewHead subscribes to notifications about the new chain heads.
+func (kc *KlayClient) SubscribeNewHead(ctx context.Context, ch chan<- *Header) (klaytn.Subscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := kc.c.SubscribeNewHeads(ctx, &Empty{})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return newStreamSubscription(cancel, func(quit <-chan struct{}) error {
+		for {
+			head, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			select {
+			case ch <- head:
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the addresses and topics of the query.
+// The block range of the query is ignored.
+func (kc *KlayClient) SubscribeFilterLogs(ctx context.Context, q klaytn.FilterQuery, ch chan<- types.Log) (klaytn.Subscription, error) {
+	filter := &LogFilter{}
+	for _, addr := range q.Addresses {
+		filter.Addresses = append(filter.Addresses, addr.Bytes())
+	}
+	for _, position := range q.Topics {
+		topics := &Topics{}
+		for _, topic := range position {
+			topics.Topics = append(topics.Topics, topic.Bytes())
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := kc.c.SubscribeLogs(ctx, filter)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return newStreamSubscription(cancel, func(quit <-chan struct{}) error {
+		for {
+			l, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			select {
+			case ch <- logFromProto(l):
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// newStreamSubscription returns a subscription which runs forward until it fails
+// or the subscription is cancelled. Cancelling the subscription closes the stream.
+func newStreamSubscription(cancel context.CancelFunc, forward func(quit <-chan struct{}) error) klaytn.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer cancel()
+
+		errc := make(chan error, 1)
+		go func() { errc <- forward(quit) }()
+
+		select {
+		case err := <-errc:
+			return err
+		case <-quit:
+			return nil
+		}
+	})
+}
+
+func logFromProto(l *Log) types.Log {
+	topics := make([]common.Hash, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = common.BytesToHash(topic)
+	}
+	return types.Log{
+		Address:     common.BytesToAddress(l.Address),
+		Topics:      topics,
+		Data:        l.Data,
+		BlockNumber: l.BlockNumber,
+		TxHash:      common.BytesToHash(l.TransactionHash),
+		TxIndex:     uint(l.TransactionIndex),
+		BlockHash:   common.BytesToHash(l.BlockHash),
+		Index:       uint(l.LogIndex),
+		Removed:     l.Removed,
+	}
+}
@@ -0,0 +1,615 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/api"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node/cn/filters"
+	"github.com/klaytn/klaytn/rlp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backend is the backend of the KlayAPI service. It is implemented by the API backend of a CN.
+type Backend interface {
+	api.Backend
+	filters.Backend
+}
+
+// klayAPIServer is an implementation of KlayAPIServer. It serves the typed
+// requests from the backend directly, so the type-specific fields of the
+// transactions are preserved in their raw encoding.
+type klayAPIServer struct {
+	b         Backend
+	chainAPI  *api.PublicBlockChainAPI
+	txPoolAPI *api.PublicTransactionPoolAPI
+
+	eventsOnce sync.Once
+	events     *filters.EventSystem
+}
+
+func newKlayAPIServer(b Backend) *klayAPIServer {
+	return &klayAPIServer{
+		b:         b,
+		chainAPI:  api.NewPublicBlockChainAPI(b),
+		txPoolAPI: api.NewPublicTransactionPoolAPI(b, new(api.AddrLocker)),
+	}
+}
+
+// eventSystem returns the event system of the subscriptions, creating it on the first use.
+func (s *klayAPIServer) eventSystem() *filters.EventSystem {
+	s.eventsOnce.Do(func() {
+		s.events = filters.NewEventSystem(s.b.EventMux(), s.b, false)
+	})
+	return s.events
+}
+
+// acquireLimit applies the RPC limits of the given method to the client of the gRPC request.
+func acquireLimit(ctx context.Context, method string) (context.Context, func(), error) {
+	ctx, release, err := rpc.AcquireLimit(withClientInfo(ctx, ctx), method)
+	if err != nil {
+		return nil, nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return ctx, release, nil
+}
+
+// checkLimit checks the execution time and the encoded size of the response against the RPC limits.
+func checkLimit(ctx context.Context, resp proto.Message) error {
+	if err := rpc.CheckLimit(ctx, proto.Size(resp)); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
+func bigBytes(b *big.Int) []byte {
+	if b == nil {
+		return nil
+	}
+	return b.Bytes()
+}
+
+func addressBytes(addr *common.Address) []byte {
+	if addr == nil {
+		return nil
+	}
+	return addr.Bytes()
+}
+
+func toAddress(b []byte) (common.Address, error) {
+	if len(b) != common.AddressLength {
+		return common.Address{}, status.Errorf(codes.InvalidArgument, "invalid address length %d", len(b))
+	}
+	return common.BytesToAddress(b), nil
+}
+
+func toOptionalAddress(b []byte) (*common.Address, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	addr, err := toAddress(b)
+	if err != nil {
+		return nil, err
+	}
+	return &addr, nil
+}
+
+func toHash(b []byte) (common.Hash, error) {
+	if len(b) != common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "invalid hash length %d", len(b))
+	}
+	return common.BytesToHash(b), nil
+}
+
+func toOptionalBig(b []byte) *hexutil.Big {
+	if len(b) == 0 {
+		return nil
+	}
+	return (*hexutil.Big)(new(big.Int).SetBytes(b))
+}
+
+// toBlockNumberOrHash converts the block reference to the block argument of the backend.
+func toBlockNumberOrHash(ref *BlockReference) (rpc.BlockNumberOrHash, error) {
+	switch r := ref.GetReference().(type) {
+	case nil:
+		return rpc.NewBlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil
+	case *BlockReference_Number:
+		if int64(r.Number) < 0 {
+			return rpc.BlockNumberOrHash{}, status.Errorf(codes.InvalidArgument, "block number too high %d", r.Number)
+		}
+		return rpc.NewBlockNumberOrHashWithNumber(rpc.BlockNumber(r.Number)), nil
+	case *BlockReference_Hash:
+		hash, err := toHash(r.Hash)
+		if err != nil {
+			return rpc.BlockNumberOrHash{}, err
+		}
+		return rpc.NewBlockNumberOrHashWithHash(hash, false), nil
+	case *BlockReference_Tag:
+		switch r.Tag {
+		case BlockTag_LATEST:
+			return rpc.NewBlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil
+		case BlockTag_PENDING:
+			return rpc.NewBlockNumberOrHashWithNumber(rpc.PendingBlockNumber), nil
+		case BlockTag_EARLIEST:
+			return rpc.NewBlockNumberOrHashWithNumber(rpc.EarliestBlockNumber), nil
+		}
+		return rpc.BlockNumberOrHash{}, status.Errorf(codes.InvalidArgument, "invalid block tag %v", r.Tag)
+	default:
+		return rpc.BlockNumberOrHash{}, status.Errorf(codes.InvalidArgument, "invalid block reference %v", ref)
+	}
+}
+
+func headerToProto(h *types.Header) *Header {
+	return &Header{
+		Hash:             h.Hash().Bytes(),
+		ParentHash:       h.ParentHash.Bytes(),
+		Reward:           h.Rewardbase.Bytes(),
+		StateRoot:        h.Root.Bytes(),
+		TransactionsRoot: h.TxHash.Bytes(),
+		ReceiptsRoot:     h.ReceiptHash.Bytes(),
+		LogsBloom:        h.Bloom.Bytes(),
+		BlockScore:       bigBytes(h.BlockScore),
+		Number:           h.Number.Uint64(),
+		GasUsed:          h.GasUsed,
+		Timestamp:        h.Time.Uint64(),
+		TimestampFos:     uint32(h.TimeFoS),
+		ExtraData:        h.Extra,
+		GovernanceData:   h.Governance,
+		VoteData:         h.Vote,
+		BaseFeePerGas:    bigBytes(h.BaseFee),
+	}
+}
+
+// txSender returns the sender of the transaction in the same way as the klay namespace APIs.
+func txSender(tx *types.Transaction) common.Address {
+	var from common.Address
+	if tx.IsEthereumTransaction() {
+		signer := types.LatestSignerForChainID(tx.ChainId())
+		from, _ = types.Sender(signer, tx)
+	} else {
+		from, _ = tx.From()
+	}
+	return from
+}
+
+// txToProto converts the transaction included in the given block. The header is nil
+// and the block hash is empty for a pending transaction.
+func txToProto(tx *types.Transaction, header *types.Header, blockHash common.Hash, blockNumber uint64, index uint64) (*Transaction, error) {
+	raw, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	from := txSender(tx)
+	out := &Transaction{
+		Hash:             tx.Hash().Bytes(),
+		Type:             uint32(tx.Type()),
+		TypeName:         tx.Type().String(),
+		From:             from.Bytes(),
+		To:               addressBytes(tx.To()),
+		Nonce:            tx.Nonce(),
+		Gas:              tx.Gas(),
+		GasPrice:         bigBytes(tx.GasPrice()),
+		Value:            bigBytes(tx.Value()),
+		Input:            tx.Data(),
+		SenderTxHash:     tx.SenderTxHashAll().Bytes(),
+		BlockNumber:      blockNumber,
+		TransactionIndex: index,
+		Raw:              raw,
+	}
+	if tx.Type() == types.TxTypeEthereumDynamicFee {
+		out.GasPrice = bigBytes(tx.EffectiveGasPrice(header))
+	}
+	if tx.Type().IsFeeDelegatedTransaction() {
+		if feePayer, err := tx.FeePayer(); err == nil {
+			out.FeePayer = feePayer.Bytes()
+		}
+	}
+	if feeRatio, ok := tx.FeeRatio(); ok {
+		out.FeeRatio = uint32(feeRatio)
+	}
+	if !common.EmptyHash(blockHash) {
+		out.BlockHash = blockHash.Bytes()
+	}
+	return out, nil
+}
+
+func logToProto(l *types.Log) *Log {
+	topics := make([][]byte, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = topic.Bytes()
+	}
+	return &Log{
+		Address:          l.Address.Bytes(),
+		Topics:           topics,
+		Data:             l.Data,
+		BlockNumber:      l.BlockNumber,
+		TransactionHash:  l.TxHash.Bytes(),
+		TransactionIndex: uint32(l.TxIndex),
+		BlockHash:        l.BlockHash.Bytes(),
+		LogIndex:         uint32(l.Index),
+		Removed:          l.Removed,
+	}
+}
+
+func blockToProto(block *types.Block, td *big.Int, fullTx bool) (*Block, error) {
+	header := block.Header()
+	out := &Block{
+		Header:          headerToProto(header),
+		TotalBlockScore: bigBytes(td),
+		Size:            uint64(block.Size()),
+	}
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			out.TransactionHashes = append(out.TransactionHashes, tx.Hash().Bytes())
+			continue
+		}
+		ptx, err := txToProto(tx, header, block.Hash(), block.NumberU64(), uint64(i))
+		if err != nil {
+			return nil, err
+		}
+		out.Transactions = append(out.Transactions, ptx)
+	}
+	return out, nil
+}
+
+func receiptToProto(tx *types.Transaction, header *types.Header, blockHash common.Hash, blockNumber uint64, index uint64, receipt *types.Receipt) (*Receipt, error) {
+	ptx, err := txToProto(tx, header, blockHash, blockNumber, index)
+	if err != nil {
+		return nil, err
+	}
+	out := &Receipt{
+		Transaction:       ptx,
+		Status:            uint64(receipt.Status),
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: bigBytes(tx.EffectiveGasPrice(header)),
+		LogsBloom:         receipt.Bloom.Bytes(),
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		out.Status = uint64(types.ReceiptStatusFailed)
+		out.TxError = uint64(receipt.Status)
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		out.ContractAddress = receipt.ContractAddress.Bytes()
+	}
+	for _, l := range receipt.Logs {
+		out.Logs = append(out.Logs, logToProto(l))
+	}
+	return out, nil
+}
+
+func (s *klayAPIServer) BlockNumber(ctx context.Context, _ *Empty) (*BlockNumberResponse, error) {
+	ctx, release, err := acquireLimit(ctx, "klay_blockNumber")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	header, err := s.b.HeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockNumberResponse{Number: header.Number.Uint64()}, nil
+}
+
+func (s *klayAPIServer) GetBlock(ctx context.Context, request *BlockRequest) (*Block, error) {
+	blockNrOrHash, err := toBlockNumberOrHash(request.GetBlock())
+	if err != nil {
+		return nil, err
+	}
+	method := "klay_getBlockByNumber"
+	if _, ok := blockNrOrHash.Hash(); ok {
+		method = "klay_getBlockByHash"
+	}
+	ctx, release, err := acquireLimit(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	block, err := s.b.BlockByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		// The backend reports a missing block as an error.
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if block == nil {
+		return nil, status.Error(codes.NotFound, "block not found")
+	}
+	resp, err := blockToProto(block, s.b.GetTd(block.Hash()), request.FullTransactions)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLimit(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *klayAPIServer) GetTransactionByHash(ctx context.Context, request *TransactionHashRequest) (*Transaction, error) {
+	hash, err := toHash(request.Hash)
+	if err != nil {
+		return nil, err
+	}
+	ctx, release, err := acquireLimit(ctx, "klay_getTransactionByHash")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var resp *Transaction
+	if tx, blockHash, blockNumber, index := s.b.ChainDB().ReadTxAndLookupInfo(hash); tx != nil {
+		resp, err = txToProto(tx, nil, blockHash, blockNumber, index)
+	} else if tx := s.b.GetPoolTransaction(hash); tx != nil {
+		resp, err = txToProto(tx, nil, common.Hash{}, 0, 0)
+	} else {
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLimit(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *klayAPIServer) GetTransactionReceipt(ctx context.Context, request *TransactionHashRequest) (*Receipt, error) {
+	hash, err := toHash(request.Hash)
+	if err != nil {
+		return nil, err
+	}
+	ctx, release, err := acquireLimit(ctx, "klay_getTransactionReceipt")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	tx, blockHash, blockNumber, index, receipt := s.b.GetTxLookupInfoAndReceipt(ctx, hash)
+	if tx == nil || receipt == nil {
+		return nil, status.Error(codes.NotFound, "receipt not found")
+	}
+	// The header is only used for the effective gas price, which falls back to the gas price of the tx.
+	header, _ := s.b.HeaderByHash(ctx, blockHash)
+	resp, err := receiptToProto(tx, header, blockHash, blockNumber, index, receipt)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLimit(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *klayAPIServer) GetAccount(ctx context.Context, request *AccountRequest) (*Account, error) {
+	addr, err := toAddress(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	blockNrOrHash, err := toBlockNumberOrHash(request.GetBlock())
+	if err != nil {
+		return nil, err
+	}
+	ctx, release, err := acquireLimit(ctx, "klay_getAccount")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	balance, err := s.chainAPI.GetBalance(ctx, addr, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := s.txPoolAPI.GetTransactionCount(ctx, addr, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	code, err := s.chainAPI.GetCode(ctx, addr, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Account{
+		Address: addr.Bytes(),
+		Balance: (*big.Int)(balance).Bytes(),
+		Nonce:   uint64(*nonce),
+		Code:    code,
+	}
+	if err := checkLimit(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func toCallArgs(request *CallRequest) (api.CallArgs, error) {
+	from, err := toOptionalAddress(request.From)
+	if err != nil {
+		return api.CallArgs{}, err
+	}
+	to, err := toOptionalAddress(request.To)
+	if err != nil {
+		return api.CallArgs{}, err
+	}
+	args := api.CallArgs{
+		To:       to,
+		Gas:      hexutil.Uint64(request.Gas),
+		GasPrice: toOptionalBig(request.GasPrice),
+		Input:    request.Input,
+	}
+	if from != nil {
+		args.From = *from
+	}
+	if value := toOptionalBig(request.Value); value != nil {
+		args.Value = *value
+	}
+	return args, nil
+}
+
+func (s *klayAPIServer) Call(ctx context.Context, request *CallRequest) (*CallResponse, error) {
+	args, err := toCallArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	blockNrOrHash, err := toBlockNumberOrHash(request.GetBlock())
+	if err != nil {
+		return nil, err
+	}
+	ctx, release, err := acquireLimit(ctx, "klay_call")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	result, err := s.chainAPI.Call(ctx, args, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resp := &CallResponse{Result: result}
+	if err := checkLimit(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *klayAPIServer) EstimateGas(ctx context.Context, request *CallRequest) (*EstimateGasResponse, error) {
+	args, err := toCallArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx, release, err := acquireLimit(ctx, "klay_estimateGas")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	gas, err := s.chainAPI.EstimateGas(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	resp := &EstimateGasResponse{Gas: uint64(gas)}
+	if err := checkLimit(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *klayAPIServer) SendRawTransaction(ctx context.Context, request *SendRawTransactionRequest) (*TransactionHashResponse, error) {
+	ctx, release, err := acquireLimit(ctx, "klay_sendRawTransaction")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	hash, err := s.txPoolAPI.SendRawTransaction(ctx, request.RawTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionHashResponse{Hash: hash.Bytes()}, nil
+}
+
+func (s *klayAPIServer) SubscribeNewHeads(_ *Empty, stream KlayAPI_SubscribeNewHeadsServer) error {
+	// The subscription lives beyond the max execution time, so only the other limits are applied.
+	_, release, err := acquireLimit(stream.Context(), "klay_newHeads")
+	if err != nil {
+		return err
+	}
+	release()
+
+	headers := make(chan *types.Header)
+	sub := s.eventSystem().SubscribeNewHeads(headers)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case header := <-headers:
+			if err := stream.Send(headerToProto(header)); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *klayAPIServer) SubscribeLogs(filter *LogFilter, stream KlayAPI_SubscribeLogsServer) error {
+	crit, err := toFilterQuery(filter)
+	if err != nil {
+		return err
+	}
+	// The subscription lives beyond the max execution time, so only the other limits are applied.
+	_, release, err := acquireLimit(stream.Context(), "klay_logs")
+	if err != nil {
+		return err
+	}
+	release()
+
+	matchedLogs := make(chan []*types.Log)
+	sub, err := s.eventSystem().SubscribeLogs(crit, matchedLogs)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case logs := <-matchedLogs:
+			for _, l := range logs {
+				if err := stream.Send(logToProto(l)); err != nil {
+					return err
+				}
+			}
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// toFilterQuery converts the log filter to the filter query of the logs subscription.
+func toFilterQuery(filter *LogFilter) (klaytn.FilterQuery, error) {
+	var crit klaytn.FilterQuery
+	for _, b := range filter.Addresses {
+		addr, err := toAddress(b)
+		if err != nil {
+			return crit, err
+		}
+		crit.Addresses = append(crit.Addresses, addr)
+	}
+	if len(filter.Topics) > 0 {
+		crit.Topics = make([][]common.Hash, len(filter.Topics))
+		for i, position := range filter.Topics {
+			// An empty position is a wildcard
+			for _, b := range position.Topics {
+				hash, err := toHash(b)
+				if err != nil {
+					return crit, err
+				}
+				crit.Topics[i] = append(crit.Topics[i], hash)
+			}
+		}
+	}
+	return crit, nil
+}
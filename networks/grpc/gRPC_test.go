@@ -17,13 +17,30 @@
 package grpc
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"math/big"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/event"
 	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/klaytn/klaytn/storage/database"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -36,6 +53,17 @@ func (a APIgRPC) BlockNumber() float64 {
 	return TEST_BLOCK_NUMBER
 }
 
+// startTestListener starts a listener serving the given handler and backend on a free local port.
+// The listener is bound before it returns, so clients can connect without waiting.
+func startTestListener(t *testing.T, handler *rpc.Server, backend Backend) *Listener {
+	listener := &Listener{Addr: "127.0.0.1:0"}
+	listener.SetRPCServer(handler)
+	listener.SetBackend(backend)
+	require.NoError(t, listener.listen())
+	go listener.grpcServer.Serve(listener.lis)
+	return listener
+}
+
 func TestGRPC(t *testing.T) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
@@ -108,3 +136,301 @@ func testBiCall(t *testing.T, addr string, wg *sync.WaitGroup) {
 
 	time.Sleep(3 * time.Second)
 }
+
+func TestBiCallStopsOnHandleError(t *testing.T) {
+	handler := rpc.NewServer()
+	handler.RegisterName("klay", &APIgRPC{})
+
+	listener := startTestListener(t, handler, nil)
+	defer listener.Stop()
+
+	kclient, _ := NewgKlaytnClient(listener.Addr)
+	defer kclient.Close()
+
+	knclient, err := kclient.makeKlaytnClient(timeout)
+	require.NoError(t, err)
+
+	stream, err := knclient.BiCall(kclient.ctx)
+	require.NoError(t, err)
+
+	// Two requests are sent, but the receiver must stop at the first failing response.
+	var requests, handled int32
+	done := make(chan struct{})
+	go func() {
+		kclient.handleBiCall(stream, func() (*RPCRequest, error) {
+			if atomic.AddInt32(&requests, 1) > 2 {
+				return nil, errors.New("no more requests")
+			}
+			return kclient.makeRPCRequest("klay", "klay_blockNumber", nil)
+		}, func(response *RPCResponse) error {
+			atomic.AddInt32(&handled, 1)
+			return errors.New("failed to handle")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("handleBiCall did not return")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&handled))
+}
+
+// testKlayBackend is a backend of the KlayAPI service serving a single block.
+// The methods not used by the service are left unimplemented.
+type testKlayBackend struct {
+	Backend
+
+	mux       *event.TypeMux
+	chainDB   database.DBManager
+	state     *state.StateDB
+	block     *types.Block
+	receipt   *types.Receipt
+	pendingTx *types.Transaction
+
+	txsFeed    event.Feed
+	logsFeed   event.Feed
+	rmLogsFeed event.Feed
+	chainFeed  event.Feed
+}
+
+func newTestKlayBackend(t *testing.T) *testKlayBackend {
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	tx := types.NewTransaction(0, testAccount, big.NewInt(1), 21000, big.NewInt(25), nil)
+	require.NoError(t, tx.Sign(signer, key))
+	receipt := &types.Receipt{Status: types.ReceiptStatusErrExecutionReverted, GasUsed: 21000, TxHash: tx.Hash()}
+	header := &types.Header{
+		Number:     big.NewInt(int64(TEST_BLOCK_NUMBER)),
+		BlockScore: big.NewInt(1),
+		Time:       big.NewInt(1000),
+		GasUsed:    21000,
+	}
+	block := types.NewBlockWithHeader(header).WithBody([]*types.Transaction{tx})
+
+	// A fee-delegated tx has the fields which are not in the common transaction fields
+	pendingTx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransferWithRatio, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:              uint64(1),
+		types.TxValueKeyTo:                 testAccount,
+		types.TxValueKeyAmount:             big.NewInt(1),
+		types.TxValueKeyGasLimit:           uint64(100000),
+		types.TxValueKeyGasPrice:           big.NewInt(25),
+		types.TxValueKeyFrom:               from,
+		types.TxValueKeyFeePayer:           testFeePayer,
+		types.TxValueKeyFeeRatioOfFeePayer: types.FeeRatio(30),
+	})
+	require.NoError(t, err)
+	require.NoError(t, pendingTx.Sign(signer, key))
+
+	chainDB := database.NewMemoryDBManager()
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(chainDB), nil)
+	require.NoError(t, err)
+	statedb.SetCode(testAccount, []byte{0x60, 0x00})
+	statedb.AddBalance(testAccount, big.NewInt(1000))
+	statedb.SetNonce(testAccount, 7)
+
+	return &testKlayBackend{
+		mux:       new(event.TypeMux),
+		chainDB:   chainDB,
+		state:     statedb,
+		block:     block,
+		receipt:   receipt,
+		pendingTx: pendingTx,
+	}
+}
+
+func (b *testKlayBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	block, err := b.BlockByNumberOrHash(ctx, rpc.NewBlockNumberOrHashWithNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+func (b *testKlayBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	block, err := b.BlockByNumberOrHash(ctx, rpc.NewBlockNumberOrHashWithHash(hash, false))
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+func (b *testKlayBackend) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Block, error) {
+	if number, ok := blockNrOrHash.Number(); ok && (number == rpc.LatestBlockNumber || number.Uint64() == b.block.NumberU64()) {
+		return b.block, nil
+	}
+	if hash, ok := blockNrOrHash.Hash(); ok && hash == b.block.Hash() {
+		return b.block, nil
+	}
+	return nil, errors.New("the block does not exist")
+}
+
+func (b *testKlayBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	return b.state, b.block.Header(), nil
+}
+
+func (b *testKlayBackend) GetTd(hash common.Hash) *big.Int {
+	return big.NewInt(100)
+}
+
+func (b *testKlayBackend) ChainDB() database.DBManager {
+	return b.chainDB
+}
+
+func (b *testKlayBackend) GetPoolTransaction(hash common.Hash) *types.Transaction {
+	if hash == b.pendingTx.Hash() {
+		return b.pendingTx
+	}
+	return nil
+}
+
+func (b *testKlayBackend) GetTxLookupInfoAndReceipt(ctx context.Context, hash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, *types.Receipt) {
+	if tx := b.block.Transactions()[0]; hash == tx.Hash() {
+		return tx, b.block.Hash(), b.block.NumberU64(), 0, b.receipt
+	}
+	return nil, common.Hash{}, 0, 0, nil
+}
+
+func (b *testKlayBackend) EventMux() *event.TypeMux {
+	return b.mux
+}
+
+func (b *testKlayBackend) SubscribeNewTxsEvent(ch chan<- blockchain.NewTxsEvent) event.Subscription {
+	return b.txsFeed.Subscribe(ch)
+}
+
+func (b *testKlayBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription {
+	return b.logsFeed.Subscribe(ch)
+}
+
+func (b *testKlayBackend) SubscribeRemovedLogsEvent(ch chan<- blockchain.RemovedLogsEvent) event.Subscription {
+	return b.rmLogsFeed.Subscribe(ch)
+}
+
+func (b *testKlayBackend) SubscribeChainEvent(ch chan<- blockchain.ChainEvent) event.Subscription {
+	return b.chainFeed.Subscribe(ch)
+}
+
+var (
+	testAccount  = common.HexToAddress("0x9abc")
+	testFeePayer = common.HexToAddress("0xdef0")
+)
+
+func TestTypedGRPC(t *testing.T) {
+	backend := newTestKlayBackend(t)
+	listener := startTestListener(t, rpc.NewServer(), backend)
+	defer listener.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := DialKlayClient(ctx, listener.Addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	number, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(TEST_BLOCK_NUMBER), number)
+
+	blockTx := backend.block.Transactions()[0]
+	block, err := client.BlockByNumber(ctx, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, backend.block.Hash().Bytes(), block.Header.Hash)
+	assert.Equal(t, uint64(TEST_BLOCK_NUMBER), block.Header.Number)
+	assert.Equal(t, []byte{1}, block.Header.BlockScore)
+	assert.Equal(t, uint64(21000), block.Header.GasUsed)
+	assert.Equal(t, []byte{100}, block.TotalBlockScore)
+	assert.Equal(t, [][]byte{blockTx.Hash().Bytes()}, block.TransactionHashes)
+
+	block, err = client.BlockByHash(ctx, backend.block.Hash(), true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(block.Transactions))
+	assert.Equal(t, backend.block.Hash().Bytes(), block.Transactions[0].BlockHash)
+
+	_, err = client.BlockByNumber(ctx, big.NewInt(int64(TEST_BLOCK_NUMBER)+1), false)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The type-specific fields are preserved in the raw encoding of the tx
+	tx, err := client.TransactionByHash(ctx, backend.pendingTx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(types.TxTypeFeeDelegatedValueTransferWithRatio), tx.Type)
+	assert.Equal(t, testFeePayer.Bytes(), tx.FeePayer)
+	assert.Equal(t, uint32(30), tx.FeeRatio)
+	assert.Empty(t, tx.BlockHash)
+	decoded := new(types.Transaction)
+	require.NoError(t, rlp.DecodeBytes(tx.Raw, decoded))
+	assert.Equal(t, backend.pendingTx.Hash(), decoded.Hash())
+	feePayer, err := decoded.FeePayer()
+	require.NoError(t, err)
+	assert.Equal(t, testFeePayer, feePayer)
+
+	_, err = client.TransactionByHash(ctx, common.Hash{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	receipt, err := client.TransactionReceipt(ctx, blockTx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(types.ReceiptStatusFailed), receipt.Status)
+	assert.Equal(t, uint64(types.ReceiptStatusErrExecutionReverted), receipt.TxError)
+	assert.Equal(t, blockTx.Hash().Bytes(), receipt.Transaction.Hash)
+	assert.Equal(t, []byte{25}, receipt.EffectiveGasPrice)
+
+	account, err := client.AccountAt(ctx, testAccount, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), new(big.Int).SetBytes(account.Balance))
+	assert.Equal(t, uint64(7), account.Nonce)
+	assert.Equal(t, []byte{0x60, 0x00}, account.Code)
+
+	_, err = client.c.GetAccount(ctx, &AccountRequest{Address: []byte{0x01}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	heads := make(chan *Header)
+	sub, err := client.SubscribeNewHead(ctx, heads)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	logs := make(chan types.Log)
+	logsSub, err := client.SubscribeFilterLogs(ctx, klaytn.FilterQuery{Addresses: []common.Address{testAccount}}, logs)
+	require.NoError(t, err)
+	defer logsSub.Unsubscribe()
+
+	// The events are sent repeatedly until received, since the subscriptions are installed asynchronously.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				backend.chainFeed.Send(blockchain.ChainEvent{Block: backend.block})
+				backend.logsFeed.Send([]*types.Log{{Address: common.HexToAddress("0x01")}, {Address: testAccount, BlockNumber: 1}})
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	select {
+	case head := <-heads:
+		assert.Equal(t, backend.block.Hash().Bytes(), head.Hash)
+		assert.Equal(t, uint64(TEST_BLOCK_NUMBER), head.Number)
+	case err := <-sub.Err():
+		t.Fatal(err)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the new head")
+	}
+
+	select {
+	case l := <-logs:
+		assert.Equal(t, testAccount, l.Address)
+		assert.Equal(t, uint64(1), l.BlockNumber)
+	case err := <-logsSub.Err():
+		t.Fatal(err)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the log")
+	}
+}
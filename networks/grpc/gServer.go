@@ -32,6 +32,8 @@ import (
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/networks/rpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -39,7 +41,9 @@ var logger = log.NewModuleLogger(log.NetworksGRPC)
 
 type Listener struct {
 	Addr       string
+	lis        net.Listener
 	handler    *rpc.Server
+	backend    Backend
 	grpcServer *grpc.Server
 }
 
@@ -54,6 +58,21 @@ func (t *grpcReadWriteNopCloser) Close() error {
 	return nil
 }
 
+// withClientInfo returns a context derived from ctx carrying the address and the API key
+// of the client of the gRPC request context, by which the RPC limits are applied.
+func withClientInfo(ctx context.Context, requestCtx context.Context) context.Context {
+	var remote, apiKey string
+	if p, ok := peer.FromContext(requestCtx); ok && p.Addr != nil {
+		remote = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(requestCtx); ok {
+		if values := md.Get(rpc.APIKeyHeader()); len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+	return rpc.WithClientInfo(ctx, remote, apiKey)
+}
+
 // klaytnServer is an implementation of KlaytnNodeServer.
 type klaytnServer struct {
 	handler *rpc.Server
@@ -123,7 +142,7 @@ func (kns *klaytnServer) BiCall(stream KlaytnNode_BiCallServer) error {
 			return dec.Decode(v)
 		}
 
-		ctx := context.Background()
+		ctx := withClientInfo(context.Background(), stream.Context())
 
 		reader := bufio.NewReaderSize(preader, common.MaxRequestContentLength)
 		kns.handler.ServeSingleRequest(ctx, rpc.NewCodec(&grpcReadWriteNopCloser{reader, &grpcWriter{stream, nil}}, encoder, decoder), rpc.OptionMethodInvocation|rpc.OptionSubscriptions)
@@ -163,7 +182,7 @@ func (kns *klaytnServer) Subscribe(request *RPCRequest, stream KlaytnNode_Subscr
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := withClientInfo(context.Background(), stream.Context())
 
 	reader := bufio.NewReaderSize(preader, common.MaxRequestContentLength)
 	kns.handler.ServeSingleRequest(ctx, rpc.NewCodec(&grpcReadWriteNopCloser{reader, &grpcWriter{stream, writeErr}}, encoder, decoder), rpc.OptionMethodInvocation|rpc.OptionSubscriptions)
@@ -222,7 +241,7 @@ func (kns *klaytnServer) Call(ctx context.Context, request *RPCRequest) (*RPCRes
 	}
 
 	reader := bufio.NewReaderSize(preader, common.MaxRequestContentLength)
-	kns.handler.ServeSingleRequest(ctx, rpc.NewCodec(&grpcReadWriteNopCloser{reader, writer}, encoder, decoder), rpc.OptionMethodInvocation)
+	kns.handler.ServeSingleRequest(withClientInfo(ctx, ctx), rpc.NewCodec(&grpcReadWriteNopCloser{reader, writer}, encoder, decoder), rpc.OptionMethodInvocation)
 
 loop:
 	for {
@@ -250,22 +269,44 @@ func (gs *Listener) SetRPCServer(handler *rpc.Server) {
 	gs.handler = handler
 }
 
+// SetBackend sets the backend of the KlayAPI service.
+// The KlayAPI service is not served without a backend.
+func (gs *Listener) SetBackend(backend Backend) {
+	gs.backend = backend
+}
+
 func (gs *Listener) Start() {
-	lis, err := net.Listen("tcp", gs.Addr)
-	if err != nil {
+	if err := gs.listen(); err != nil {
 		// TODO-Klaytn-gRPC Need to handle err
 		logger.Error("failed to listen", "err", err)
+		return
+	}
+	if err := gs.grpcServer.Serve(gs.lis); err != nil {
+		// TODO-Klaytn-gRPC Need to handle err
+		logger.Error("failed to serve", "err", err)
 	}
+}
+
+// listen binds the listener to Addr and registers the services on a new gRPC server.
+// Addr is updated to the bound address, so a port 0 is resolved to the actual one.
+func (gs *Listener) listen() error {
+	lis, err := net.Listen("tcp", gs.Addr)
+	if err != nil {
+		return err
+	}
+	gs.lis = lis
+	gs.Addr = lis.Addr().String()
 	gs.grpcServer = grpc.NewServer()
 
 	RegisterKlaytnNodeServer(gs.grpcServer, &klaytnServer{handler: gs.handler})
 
+	if gs.backend != nil {
+		RegisterKlayAPIServer(gs.grpcServer, newKlayAPIServer(gs.backend))
+	}
+
 	// Register reflection service on gRPC server.
 	reflection.Register(gs.grpcServer)
-	if err := gs.grpcServer.Serve(lis); err != nil {
-		// TODO-Klaytn-gRPC Need to handle err
-		logger.Error("failed to serve", "err", err)
-	}
+	return nil
 }
 
 func (gs *Listener) Stop() {
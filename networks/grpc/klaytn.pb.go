@@ -23,6 +23,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type BlockTag int32
+
+const (
+	BlockTag_LATEST   BlockTag = 0
+	BlockTag_PENDING  BlockTag = 1
+	BlockTag_EARLIEST BlockTag = 2
+)
+
+var BlockTag_name = map[int32]string{
+	0: "LATEST",
+	1: "PENDING",
+	2: "EARLIEST",
+}
+
+var BlockTag_value = map[string]int32{
+	"LATEST":   0,
+	"PENDING":  1,
+	"EARLIEST": 2,
+}
+
+func (x BlockTag) String() string {
+	return proto.EnumName(BlockTag_name, int32(x))
+}
+
+func (BlockTag) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{0}
+}
+
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -148,227 +176,2004 @@ func (m *RPCResponse) GetPayload() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Empty)(nil), "grpc.Empty")
-	proto.RegisterType((*RPCRequest)(nil), "grpc.RPCRequest")
-	proto.RegisterType((*RPCResponse)(nil), "grpc.RPCResponse")
+// BlockReference selects a block by its number, its hash or a tag.
+// An empty reference means the latest block.
+type BlockReference struct {
+	// Types that are valid to be assigned to Reference:
+	//	*BlockReference_Number
+	//	*BlockReference_Hash
+	//	*BlockReference_Tag
+	Reference            isBlockReference_Reference `protobuf_oneof:"reference"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func init() { proto.RegisterFile("klaytn.proto", fileDescriptor_c6d8429895d2d55b) }
+func (m *BlockReference) Reset()         { *m = BlockReference{} }
+func (m *BlockReference) String() string { return proto.CompactTextString(m) }
+func (*BlockReference) ProtoMessage()    {}
+func (*BlockReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{3}
+}
 
-var fileDescriptor_c6d8429895d2d55b = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0xfd, 0x66, 0xed, 0xd8, 0x67, 0x61, 0x9a, 0x83, 0x14, 0x4f, 0xa3, 0x17, 0x0b, 0x62,
-	0x19, 0xce, 0x5f, 0xd0, 0xe1, 0x49, 0x90, 0x12, 0xc1, 0x7b, 0xda, 0x7e, 0x68, 0xb1, 0x59, 0x62,
-	0x92, 0x09, 0xfd, 0x3b, 0x1e, 0xfc, 0x9d, 0x92, 0xd8, 0x51, 0x8f, 0x7a, 0x7c, 0xdf, 0x24, 0x4f,
-	0x1e, 0x5e, 0x4c, 0xde, 0x7a, 0x31, 0xb8, 0x5d, 0xa1, 0x8d, 0x72, 0x8a, 0x45, 0x2f, 0x46, 0x37,
-	0xd9, 0x1c, 0x4f, 0xee, 0xa5, 0x76, 0x43, 0xf6, 0x8c, 0xc8, 0xab, 0x2d, 0xa7, 0xf7, 0x3d, 0x59,
-	0xc7, 0x52, 0x9c, 0x5b, 0x32, 0x1f, 0x5d, 0x43, 0x29, 0xac, 0x20, 0x5f, 0xf0, 0x43, 0x64, 0x17,
-	0x18, 0x4b, 0x72, 0xaf, 0xaa, 0x4d, 0x67, 0xe1, 0x60, 0x4c, 0xbe, 0xd7, 0xc2, 0x08, 0x69, 0xd3,
-	0xe3, 0x15, 0xe4, 0x09, 0x1f, 0x53, 0x76, 0x85, 0xa7, 0x81, 0x6b, 0xb5, 0xda, 0x59, 0xf2, 0x60,
-	0x2d, 0x86, 0x5e, 0x89, 0x36, 0x80, 0x13, 0x7e, 0x88, 0xb7, 0x5f, 0x80, 0xf8, 0x10, 0x04, 0x1f,
-	0x55, 0x4b, 0xec, 0x06, 0xa3, 0xad, 0xe8, 0x7b, 0x76, 0x56, 0x78, 0xcf, 0x62, 0x72, 0xbb, 0x3c,
-	0xff, 0xd5, 0xfc, 0x50, 0xb3, 0x23, 0x76, 0x87, 0x8b, 0xa7, 0x7d, 0x6d, 0x1b, 0xd3, 0xd5, 0xf4,
-	0xc7, 0x37, 0x6b, 0x60, 0x1b, 0x8c, 0xcb, 0xee, 0x1f, 0xdf, 0xe4, 0xb0, 0x86, 0xf2, 0x1a, 0x97,
-	0x8d, 0x92, 0xc5, 0x38, 0xa6, 0xbf, 0x54, 0x2e, 0x27, 0xf1, 0xca, 0x8f, 0x5b, 0xc1, 0xe7, 0x2c,
-	0xf2, 0x5d, 0x1d, 0x87, 0xb1, 0x37, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0x07, 0x2f, 0xb1,
-	0x7c, 0x01, 0x00, 0x00,
+func (m *BlockReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockReference.Unmarshal(m, b)
+}
+func (m *BlockReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockReference.Marshal(b, m, deterministic)
+}
+func (m *BlockReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockReference.Merge(m, src)
+}
+func (m *BlockReference) XXX_Size() int {
+	return xxx_messageInfo_BlockReference.Size(m)
+}
+func (m *BlockReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockReference.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_BlockReference proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type isBlockReference_Reference interface {
+	isBlockReference_Reference()
+}
 
-// KlaytnNodeClient is the client API for KlaytnNode service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type KlaytnNodeClient interface {
-	Call(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (*RPCResponse, error)
-	Subscribe(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (KlaytnNode_SubscribeClient, error)
-	BiCall(ctx context.Context, opts ...grpc.CallOption) (KlaytnNode_BiCallClient, error)
+type BlockReference_Number struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3,oneof"`
 }
 
-type klaytnNodeClient struct {
-	cc *grpc.ClientConn
+type BlockReference_Hash struct {
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3,oneof"`
 }
 
-func NewKlaytnNodeClient(cc *grpc.ClientConn) KlaytnNodeClient {
-	return &klaytnNodeClient{cc}
+type BlockReference_Tag struct {
+	Tag BlockTag `protobuf:"varint,3,opt,name=tag,proto3,enum=grpc.BlockTag,oneof"`
 }
 
-func (c *klaytnNodeClient) Call(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (*RPCResponse, error) {
-	out := new(RPCResponse)
-	err := c.cc.Invoke(ctx, "/grpc.KlaytnNode/Call", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*BlockReference_Number) isBlockReference_Reference() {}
+
+func (*BlockReference_Hash) isBlockReference_Reference() {}
+
+func (*BlockReference_Tag) isBlockReference_Reference() {}
+
+func (m *BlockReference) GetReference() isBlockReference_Reference {
+	if m != nil {
+		return m.Reference
 	}
-	return out, nil
+	return nil
 }
 
-func (c *klaytnNodeClient) Subscribe(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (KlaytnNode_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_KlaytnNode_serviceDesc.Streams[0], "/grpc.KlaytnNode/Subscribe", opts...)
-	if err != nil {
-		return nil, err
+func (m *BlockReference) GetNumber() uint64 {
+	if x, ok := m.GetReference().(*BlockReference_Number); ok {
+		return x.Number
 	}
-	x := &klaytnNodeSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *BlockReference) GetHash() []byte {
+	if x, ok := m.GetReference().(*BlockReference_Hash); ok {
+		return x.Hash
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *BlockReference) GetTag() BlockTag {
+	if x, ok := m.GetReference().(*BlockReference_Tag); ok {
+		return x.Tag
 	}
-	return x, nil
+	return BlockTag_LATEST
 }
 
-type KlaytnNode_SubscribeClient interface {
-	Recv() (*RPCResponse, error)
-	grpc.ClientStream
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BlockReference) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BlockReference_Number)(nil),
+		(*BlockReference_Hash)(nil),
+		(*BlockReference_Tag)(nil),
+	}
 }
 
-type klaytnNodeSubscribeClient struct {
-	grpc.ClientStream
+type BlockNumberResponse struct {
+	Number               uint64   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *klaytnNodeSubscribeClient) Recv() (*RPCResponse, error) {
-	m := new(RPCResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *BlockNumberResponse) Reset()         { *m = BlockNumberResponse{} }
+func (m *BlockNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockNumberResponse) ProtoMessage()    {}
+func (*BlockNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{4}
+}
+
+func (m *BlockNumberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockNumberResponse.Unmarshal(m, b)
+}
+func (m *BlockNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockNumberResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockNumberResponse.Merge(m, src)
+}
+func (m *BlockNumberResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockNumberResponse.Size(m)
+}
+func (m *BlockNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockNumberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockNumberResponse proto.InternalMessageInfo
+
+func (m *BlockNumberResponse) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
 	}
-	return m, nil
+	return 0
 }
 
-func (c *klaytnNodeClient) BiCall(ctx context.Context, opts ...grpc.CallOption) (KlaytnNode_BiCallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_KlaytnNode_serviceDesc.Streams[1], "/grpc.KlaytnNode/BiCall", opts...)
-	if err != nil {
-		return nil, err
+type BlockRequest struct {
+	Block                *BlockReference `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	FullTransactions     bool            `protobuf:"varint,2,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
+func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()    {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{5}
+}
+
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRequest.Size(m)
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetBlock() *BlockReference {
+	if m != nil {
+		return m.Block
 	}
-	x := &klaytnNodeBiCallClient{stream}
-	return x, nil
+	return nil
 }
 
-type KlaytnNode_BiCallClient interface {
-	Send(*RPCRequest) error
-	Recv() (*RPCResponse, error)
-	grpc.ClientStream
+func (m *BlockRequest) GetFullTransactions() bool {
+	if m != nil {
+		return m.FullTransactions
+	}
+	return false
 }
 
-type klaytnNodeBiCallClient struct {
-	grpc.ClientStream
+type Header struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash           []byte   `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Reward               []byte   `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TransactionsRoot     []byte   `protobuf:"bytes,5,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactions_root,omitempty"`
+	ReceiptsRoot         []byte   `protobuf:"bytes,6,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	LogsBloom            []byte   `protobuf:"bytes,7,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	BlockScore           []byte   `protobuf:"bytes,8,opt,name=block_score,json=blockScore,proto3" json:"block_score,omitempty"`
+	Number               uint64   `protobuf:"varint,9,opt,name=number,proto3" json:"number,omitempty"`
+	GasUsed              uint64   `protobuf:"varint,10,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Timestamp            uint64   `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TimestampFos         uint32   `protobuf:"varint,12,opt,name=timestamp_fos,json=timestampFos,proto3" json:"timestamp_fos,omitempty"`
+	ExtraData            []byte   `protobuf:"bytes,13,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	GovernanceData       []byte   `protobuf:"bytes,14,opt,name=governance_data,json=governanceData,proto3" json:"governance_data,omitempty"`
+	VoteData             []byte   `protobuf:"bytes,15,opt,name=vote_data,json=voteData,proto3" json:"vote_data,omitempty"`
+	BaseFeePerGas        []byte   `protobuf:"bytes,16,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"base_fee_per_gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *klaytnNodeBiCallClient) Send(m *RPCRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{6}
 }
 
-func (x *klaytnNodeBiCallClient) Recv() (*RPCResponse, error) {
-	m := new(RPCResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Header.Unmarshal(m, b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return xxx_messageInfo_Header.Size(m)
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Header) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return m, nil
+	return nil
 }
 
-// KlaytnNodeServer is the server API for KlaytnNode service.
-type KlaytnNodeServer interface {
-	Call(context.Context, *RPCRequest) (*RPCResponse, error)
-	Subscribe(*RPCRequest, KlaytnNode_SubscribeServer) error
-	BiCall(KlaytnNode_BiCallServer) error
+func (m *Header) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
 }
 
-func RegisterKlaytnNodeServer(s *grpc.Server, srv KlaytnNodeServer) {
-	s.RegisterService(&_KlaytnNode_serviceDesc, srv)
+func (m *Header) GetReward() []byte {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
 }
 
-func _KlaytnNode_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RPCRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Header) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
 	}
-	if interceptor == nil {
-		return srv.(KlaytnNodeServer).Call(ctx, in)
+	return nil
+}
+
+func (m *Header) GetTransactionsRoot() []byte {
+	if m != nil {
+		return m.TransactionsRoot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.KlaytnNode/Call",
+	return nil
+}
+
+func (m *Header) GetReceiptsRoot() []byte {
+	if m != nil {
+		return m.ReceiptsRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KlaytnNodeServer).Call(ctx, req.(*RPCRequest))
+	return nil
+}
+
+func (m *Header) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _KlaytnNode_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RPCRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Header) GetBlockScore() []byte {
+	if m != nil {
+		return m.BlockScore
 	}
-	return srv.(KlaytnNodeServer).Subscribe(m, &klaytnNodeSubscribeServer{stream})
+	return nil
 }
 
-type KlaytnNode_SubscribeServer interface {
-	Send(*RPCResponse) error
-	grpc.ServerStream
+func (m *Header) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
 }
 
-type klaytnNodeSubscribeServer struct {
-	grpc.ServerStream
+func (m *Header) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
 }
 
-func (x *klaytnNodeSubscribeServer) Send(m *RPCResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Header) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func _KlaytnNode_BiCall_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(KlaytnNodeServer).BiCall(&klaytnNodeBiCallServer{stream})
+func (m *Header) GetTimestampFos() uint32 {
+	if m != nil {
+		return m.TimestampFos
+	}
+	return 0
 }
 
-type KlaytnNode_BiCallServer interface {
-	Send(*RPCResponse) error
-	Recv() (*RPCRequest, error)
-	grpc.ServerStream
+func (m *Header) GetExtraData() []byte {
+	if m != nil {
+		return m.ExtraData
+	}
+	return nil
 }
 
-type klaytnNodeBiCallServer struct {
-	grpc.ServerStream
+func (m *Header) GetGovernanceData() []byte {
+	if m != nil {
+		return m.GovernanceData
+	}
+	return nil
 }
 
-func (x *klaytnNodeBiCallServer) Send(m *RPCResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Header) GetVoteData() []byte {
+	if m != nil {
+		return m.VoteData
+	}
+	return nil
 }
 
-func (x *klaytnNodeBiCallServer) Recv() (*RPCRequest, error) {
-	m := new(RPCRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Header) GetBaseFeePerGas() []byte {
+	if m != nil {
+		return m.BaseFeePerGas
 	}
-	return m, nil
+	return nil
 }
 
-var _KlaytnNode_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "grpc.KlaytnNode",
-	HandlerType: (*KlaytnNodeServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Call",
-			Handler:    _KlaytnNode_Call_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Subscribe",
-			Handler:       _KlaytnNode_Subscribe_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "BiCall",
-			Handler:       _KlaytnNode_BiCall_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
+type Block struct {
+	Header          *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TotalBlockScore []byte  `protobuf:"bytes,2,opt,name=total_block_score,json=totalBlockScore,proto3" json:"total_block_score,omitempty"`
+	Size            uint64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Either transaction_hashes or transactions is filled depending on full_transactions of the request.
+	TransactionHashes    [][]byte       `protobuf:"bytes,4,rep,name=transaction_hashes,json=transactionHashes,proto3" json:"transaction_hashes,omitempty"`
+	Transactions         []*Transaction `protobuf:"bytes,5,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{7}
+}
+
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Block) GetTotalBlockScore() []byte {
+	if m != nil {
+		return m.TotalBlockScore
+	}
+	return nil
+}
+
+func (m *Block) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Block) GetTransactionHashes() [][]byte {
+	if m != nil {
+		return m.TransactionHashes
+	}
+	return nil
+}
+
+func (m *Block) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type Transaction struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Type                 uint32   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	TypeName             string   `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	From                 []byte   `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To                   []byte   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Nonce                uint64   `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Gas                  uint64   `protobuf:"varint,7,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice             []byte   `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Value                []byte   `protobuf:"bytes,9,opt,name=value,proto3" json:"value,omitempty"`
+	Input                []byte   `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	FeePayer             []byte   `protobuf:"bytes,11,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	FeeRatio             uint32   `protobuf:"varint,12,opt,name=fee_ratio,json=feeRatio,proto3" json:"fee_ratio,omitempty"`
+	SenderTxHash         []byte   `protobuf:"bytes,13,opt,name=sender_tx_hash,json=senderTxHash,proto3" json:"sender_tx_hash,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,14,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber          uint64   `protobuf:"varint,15,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TransactionIndex     uint64   `protobuf:"varint,16,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	Raw                  []byte   `protobuf:"bytes,17,opt,name=raw,proto3" json:"raw,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()    {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{8}
+}
+
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction.Unmarshal(m, b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return xxx_messageInfo_Transaction.Size(m)
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Transaction) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Transaction) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *Transaction) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *Transaction) GetTo() []byte {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *Transaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Transaction) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *Transaction) GetGasPrice() []byte {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *Transaction) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Transaction) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *Transaction) GetFeePayer() []byte {
+	if m != nil {
+		return m.FeePayer
+	}
+	return nil
+}
+
+func (m *Transaction) GetFeeRatio() uint32 {
+	if m != nil {
+		return m.FeeRatio
+	}
+	return 0
+}
+
+func (m *Transaction) GetSenderTxHash() []byte {
+	if m != nil {
+		return m.SenderTxHash
+	}
+	return nil
+}
+
+func (m *Transaction) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Transaction) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Transaction) GetTransactionIndex() uint64 {
+	if m != nil {
+		return m.TransactionIndex
+	}
+	return 0
+}
+
+func (m *Transaction) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
+}
+
+type TransactionHashRequest struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionHashRequest) Reset()         { *m = TransactionHashRequest{} }
+func (m *TransactionHashRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionHashRequest) ProtoMessage()    {}
+func (*TransactionHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{9}
+}
+
+func (m *TransactionHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionHashRequest.Unmarshal(m, b)
+}
+func (m *TransactionHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionHashRequest.Marshal(b, m, deterministic)
+}
+func (m *TransactionHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionHashRequest.Merge(m, src)
+}
+func (m *TransactionHashRequest) XXX_Size() int {
+	return xxx_messageInfo_TransactionHashRequest.Size(m)
+}
+func (m *TransactionHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionHashRequest proto.InternalMessageInfo
+
+func (m *TransactionHashRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type TransactionHashResponse struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionHashResponse) Reset()         { *m = TransactionHashResponse{} }
+func (m *TransactionHashResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionHashResponse) ProtoMessage()    {}
+func (*TransactionHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{10}
+}
+
+func (m *TransactionHashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionHashResponse.Unmarshal(m, b)
+}
+func (m *TransactionHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionHashResponse.Marshal(b, m, deterministic)
+}
+func (m *TransactionHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionHashResponse.Merge(m, src)
+}
+func (m *TransactionHashResponse) XXX_Size() int {
+	return xxx_messageInfo_TransactionHashResponse.Size(m)
+}
+func (m *TransactionHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionHashResponse proto.InternalMessageInfo
+
+func (m *TransactionHashResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type Log struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics               [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BlockNumber          uint64   `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,5,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	TransactionIndex     uint32   `protobuf:"varint,6,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	LogIndex             uint32   `protobuf:"varint,8,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	Removed              bool     `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{11}
+}
+
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Log.Unmarshal(m, b)
+}
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+}
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
+}
+func (m *Log) XXX_Size() int {
+	return xxx_messageInfo_Log.Size(m)
+}
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Log proto.InternalMessageInfo
+
+func (m *Log) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Log) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *Log) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Log) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Log) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *Log) GetTransactionIndex() uint32 {
+	if m != nil {
+		return m.TransactionIndex
+	}
+	return 0
+}
+
+func (m *Log) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Log) GetLogIndex() uint32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *Log) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+type Receipt struct {
+	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Status               uint64       `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	TxError              uint64       `protobuf:"varint,3,opt,name=tx_error,json=txError,proto3" json:"tx_error,omitempty"`
+	GasUsed              uint64       `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	EffectiveGasPrice    []byte       `protobuf:"bytes,5,opt,name=effective_gas_price,json=effectiveGasPrice,proto3" json:"effective_gas_price,omitempty"`
+	ContractAddress      []byte       `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	LogsBloom            []byte       `protobuf:"bytes,7,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	Logs                 []*Log       `protobuf:"bytes,8,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{12}
+}
+
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Receipt.Unmarshal(m, b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return xxx_messageInfo_Receipt.Size(m)
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *Receipt) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetTxError() uint64 {
+	if m != nil {
+		return m.TxError
+	}
+	return 0
+}
+
+func (m *Receipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetEffectiveGasPrice() []byte {
+	if m != nil {
+		return m.EffectiveGasPrice
+	}
+	return nil
+}
+
+func (m *Receipt) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *Receipt) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return nil
+}
+
+func (m *Receipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type AccountRequest struct {
+	Address              []byte          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Block                *BlockReference `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AccountRequest) Reset()         { *m = AccountRequest{} }
+func (m *AccountRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountRequest) ProtoMessage()    {}
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{13}
+}
+
+func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountRequest.Unmarshal(m, b)
+}
+func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRequest.Merge(m, src)
+}
+func (m *AccountRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountRequest.Size(m)
+}
+func (m *AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
+
+func (m *AccountRequest) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountRequest) GetBlock() *BlockReference {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type Account struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              []byte   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce                uint64   `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Code                 []byte   `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{14}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Account) GetBalance() []byte {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *Account) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Account) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+type CallRequest struct {
+	From     []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       []byte `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Gas      uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice []byte `protobuf:"bytes,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Value    []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Input    []byte `protobuf:"bytes,6,opt,name=input,proto3" json:"input,omitempty"`
+	// block is ignored by EstimateGas.
+	Block                *BlockReference `protobuf:"bytes,7,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CallRequest) Reset()         { *m = CallRequest{} }
+func (m *CallRequest) String() string { return proto.CompactTextString(m) }
+func (*CallRequest) ProtoMessage()    {}
+func (*CallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{15}
+}
+
+func (m *CallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest.Unmarshal(m, b)
+}
+func (m *CallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest.Marshal(b, m, deterministic)
+}
+func (m *CallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest.Merge(m, src)
+}
+func (m *CallRequest) XXX_Size() int {
+	return xxx_messageInfo_CallRequest.Size(m)
+}
+func (m *CallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest proto.InternalMessageInfo
+
+func (m *CallRequest) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *CallRequest) GetTo() []byte {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *CallRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *CallRequest) GetGasPrice() []byte {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *CallRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *CallRequest) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *CallRequest) GetBlock() *BlockReference {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type CallResponse struct {
+	Result               []byte   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallResponse) Reset()         { *m = CallResponse{} }
+func (m *CallResponse) String() string { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()    {}
+func (*CallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{16}
+}
+
+func (m *CallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse.Unmarshal(m, b)
+}
+func (m *CallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse.Marshal(b, m, deterministic)
+}
+func (m *CallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse.Merge(m, src)
+}
+func (m *CallResponse) XXX_Size() int {
+	return xxx_messageInfo_CallResponse.Size(m)
+}
+func (m *CallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse proto.InternalMessageInfo
+
+func (m *CallResponse) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type EstimateGasResponse struct {
+	Gas                  uint64   `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()    {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{17}
+}
+
+func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateGasResponse.Unmarshal(m, b)
+}
+func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateGasResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasResponse.Merge(m, src)
+}
+func (m *EstimateGasResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateGasResponse.Size(m)
+}
+func (m *EstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasResponse proto.InternalMessageInfo
+
+func (m *EstimateGasResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+type SendRawTransactionRequest struct {
+	RawTransaction       []byte   `protobuf:"bytes,1,opt,name=raw_transaction,json=rawTransaction,proto3" json:"raw_transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendRawTransactionRequest) Reset()         { *m = SendRawTransactionRequest{} }
+func (m *SendRawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SendRawTransactionRequest) ProtoMessage()    {}
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{18}
+}
+
+func (m *SendRawTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRawTransactionRequest.Unmarshal(m, b)
+}
+func (m *SendRawTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRawTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SendRawTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRawTransactionRequest.Merge(m, src)
+}
+func (m *SendRawTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SendRawTransactionRequest.Size(m)
+}
+func (m *SendRawTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRawTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRawTransactionRequest proto.InternalMessageInfo
+
+func (m *SendRawTransactionRequest) GetRawTransaction() []byte {
+	if m != nil {
+		return m.RawTransaction
+	}
+	return nil
+}
+
+type Topics struct {
+	Topics               [][]byte `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Topics) Reset()         { *m = Topics{} }
+func (m *Topics) String() string { return proto.CompactTextString(m) }
+func (*Topics) ProtoMessage()    {}
+func (*Topics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{19}
+}
+
+func (m *Topics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Topics.Unmarshal(m, b)
+}
+func (m *Topics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Topics.Marshal(b, m, deterministic)
+}
+func (m *Topics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Topics.Merge(m, src)
+}
+func (m *Topics) XXX_Size() int {
+	return xxx_messageInfo_Topics.Size(m)
+}
+func (m *Topics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Topics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Topics proto.InternalMessageInfo
+
+func (m *Topics) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type LogFilter struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Each position matches any of the given topics. An empty position matches any topic.
+	Topics               []*Topics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LogFilter) Reset()         { *m = LogFilter{} }
+func (m *LogFilter) String() string { return proto.CompactTextString(m) }
+func (*LogFilter) ProtoMessage()    {}
+func (*LogFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d8429895d2d55b, []int{20}
+}
+
+func (m *LogFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogFilter.Unmarshal(m, b)
+}
+func (m *LogFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogFilter.Marshal(b, m, deterministic)
+}
+func (m *LogFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogFilter.Merge(m, src)
+}
+func (m *LogFilter) XXX_Size() int {
+	return xxx_messageInfo_LogFilter.Size(m)
+}
+func (m *LogFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogFilter proto.InternalMessageInfo
+
+func (m *LogFilter) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *LogFilter) GetTopics() []*Topics {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("grpc.BlockTag", BlockTag_name, BlockTag_value)
+	proto.RegisterType((*Empty)(nil), "grpc.Empty")
+	proto.RegisterType((*RPCRequest)(nil), "grpc.RPCRequest")
+	proto.RegisterType((*RPCResponse)(nil), "grpc.RPCResponse")
+	proto.RegisterType((*BlockReference)(nil), "grpc.BlockReference")
+	proto.RegisterType((*BlockNumberResponse)(nil), "grpc.BlockNumberResponse")
+	proto.RegisterType((*BlockRequest)(nil), "grpc.BlockRequest")
+	proto.RegisterType((*Header)(nil), "grpc.Header")
+	proto.RegisterType((*Block)(nil), "grpc.Block")
+	proto.RegisterType((*Transaction)(nil), "grpc.Transaction")
+	proto.RegisterType((*TransactionHashRequest)(nil), "grpc.TransactionHashRequest")
+	proto.RegisterType((*TransactionHashResponse)(nil), "grpc.TransactionHashResponse")
+	proto.RegisterType((*Log)(nil), "grpc.Log")
+	proto.RegisterType((*Receipt)(nil), "grpc.Receipt")
+	proto.RegisterType((*AccountRequest)(nil), "grpc.AccountRequest")
+	proto.RegisterType((*Account)(nil), "grpc.Account")
+	proto.RegisterType((*CallRequest)(nil), "grpc.CallRequest")
+	proto.RegisterType((*CallResponse)(nil), "grpc.CallResponse")
+	proto.RegisterType((*EstimateGasResponse)(nil), "grpc.EstimateGasResponse")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "grpc.SendRawTransactionRequest")
+	proto.RegisterType((*Topics)(nil), "grpc.Topics")
+	proto.RegisterType((*LogFilter)(nil), "grpc.LogFilter")
+}
+
+func init() { proto.RegisterFile("klaytn.proto", fileDescriptor_c6d8429895d2d55b) }
+
+var fileDescriptor_c6d8429895d2d55b = []byte{
+	// 1578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xd9, 0x72, 0x1b, 0xb9,
+	0x15, 0xe5, 0xd2, 0xe2, 0x72, 0xd9, 0x5c, 0x04, 0x2b, 0x4a, 0x5b, 0xb6, 0xca, 0x4a, 0xc7, 0x15,
+	0xc9, 0x76, 0x24, 0x3b, 0x52, 0x52, 0x79, 0xca, 0x83, 0x68, 0x6b, 0xab, 0xa8, 0x14, 0x16, 0xa4,
+	0xf8, 0xb5, 0x0b, 0x6c, 0x82, 0x14, 0xcb, 0xcd, 0x06, 0x0d, 0x80, 0x5a, 0xe6, 0x13, 0xe6, 0x1b,
+	0xe6, 0x69, 0xaa, 0x66, 0xbe, 0x61, 0x3e, 0x61, 0xde, 0xe7, 0x1f, 0xe6, 0x3b, 0xa6, 0xb0, 0x74,
+	0xb3, 0x5b, 0xa4, 0xec, 0x99, 0x27, 0xf6, 0x5d, 0x70, 0x71, 0x71, 0xef, 0xb9, 0x07, 0x20, 0xb8,
+	0x9f, 0x22, 0x72, 0x2f, 0xe3, 0xbd, 0x29, 0x67, 0x92, 0x21, 0x67, 0xc4, 0xa7, 0xa1, 0x5f, 0x85,
+	0x95, 0xa3, 0xc9, 0x54, 0xde, 0xfb, 0x1f, 0x01, 0x70, 0xef, 0x3d, 0xa6, 0x9f, 0x67, 0x54, 0x48,
+	0xe4, 0x41, 0x55, 0x50, 0x7e, 0x33, 0x0e, 0xa9, 0x57, 0xdc, 0x2a, 0xee, 0xd4, 0x71, 0x22, 0xa2,
+	0x75, 0xa8, 0x4c, 0xa8, 0xbc, 0x66, 0x03, 0xaf, 0xa4, 0x0d, 0x56, 0x52, 0xfa, 0x29, 0xe1, 0x64,
+	0x22, 0xbc, 0xf2, 0x56, 0x71, 0xc7, 0xc5, 0x56, 0xf2, 0xb7, 0xa1, 0xa1, 0xe3, 0x8a, 0x29, 0x8b,
+	0x05, 0x55, 0x81, 0xa7, 0xe4, 0x3e, 0x62, 0x64, 0xa0, 0x03, 0xbb, 0x38, 0x11, 0xfd, 0xcf, 0xd0,
+	0xea, 0x46, 0x2c, 0xfc, 0x84, 0xe9, 0x90, 0x72, 0x1a, 0x87, 0xca, 0xb7, 0x12, 0xcf, 0x26, 0x7d,
+	0xca, 0xb5, 0xab, 0x73, 0x5a, 0xc0, 0x56, 0x46, 0x6b, 0xe0, 0x5c, 0x13, 0x71, 0xad, 0x53, 0x70,
+	0x4f, 0x0b, 0x58, 0x4b, 0xc8, 0x87, 0xb2, 0x24, 0x23, 0xbd, 0x7f, 0x6b, 0xbf, 0xb5, 0xa7, 0xce,
+	0xb7, 0xa7, 0x43, 0x5e, 0x91, 0xd1, 0x69, 0x01, 0x2b, 0x63, 0xb7, 0x01, 0x75, 0x9e, 0x6c, 0xe0,
+	0xef, 0xc2, 0x13, 0x6d, 0xbf, 0xd0, 0x51, 0xd3, 0x1c, 0xd7, 0xf3, 0xfb, 0x26, 0xbb, 0xfa, 0x23,
+	0x70, 0x6d, 0x86, 0xa6, 0x48, 0xaf, 0x61, 0xa5, 0xaf, 0x64, 0xed, 0xd6, 0xd8, 0x5f, 0xcb, 0xec,
+	0x98, 0x1e, 0x02, 0x1b, 0x17, 0xf4, 0x06, 0x56, 0x87, 0xb3, 0x28, 0x0a, 0x24, 0x27, 0xb1, 0x20,
+	0xa1, 0x1c, 0xb3, 0x58, 0xe8, 0xf4, 0x6b, 0xb8, 0xa3, 0x0c, 0x57, 0x19, 0xbd, 0xff, 0xad, 0x03,
+	0x95, 0x53, 0x4a, 0x06, 0x94, 0x23, 0x64, 0x4f, 0x6a, 0x8a, 0x65, 0xce, 0xf9, 0x02, 0x1a, 0x53,
+	0xc2, 0x69, 0x2c, 0x83, 0x79, 0x11, 0x30, 0x18, 0xd5, 0xa9, 0x72, 0x58, 0x87, 0x0a, 0xa7, 0xb7,
+	0x84, 0x0f, 0x92, 0x5e, 0x18, 0x09, 0x6d, 0x02, 0x08, 0x49, 0x24, 0x0d, 0x38, 0x63, 0xd2, 0x73,
+	0xb4, 0xad, 0xae, 0x35, 0x98, 0x31, 0xa9, 0x72, 0xcc, 0xa6, 0x67, 0xbc, 0x56, 0xb4, 0x57, 0x27,
+	0x6b, 0xd0, 0xce, 0x7f, 0x85, 0x26, 0xa7, 0x21, 0x1d, 0x4f, 0xa5, 0x75, 0xac, 0x68, 0x47, 0x37,
+	0x51, 0x6a, 0xa7, 0x4d, 0x80, 0x88, 0x8d, 0x44, 0xd0, 0x8f, 0x18, 0x9b, 0x78, 0x55, 0xb3, 0xa1,
+	0xd2, 0x74, 0x95, 0x42, 0x1d, 0x44, 0x57, 0x27, 0x10, 0x21, 0xe3, 0xd4, 0xab, 0x99, 0x83, 0x68,
+	0xd5, 0xa5, 0xd2, 0x64, 0x3a, 0x51, 0xcf, 0x76, 0x02, 0x3d, 0x85, 0xda, 0x88, 0x88, 0x60, 0x26,
+	0xe8, 0xc0, 0x03, 0x6d, 0xa9, 0x8e, 0x88, 0xf8, 0xbf, 0xa0, 0x03, 0xf4, 0x1c, 0xea, 0x72, 0x3c,
+	0xa1, 0x42, 0x92, 0xc9, 0xd4, 0x6b, 0x68, 0xdb, 0x5c, 0xa1, 0xb2, 0x4e, 0x85, 0x60, 0xc8, 0x84,
+	0xe7, 0x6e, 0x15, 0x77, 0x9a, 0xd8, 0x4d, 0x95, 0xc7, 0x4c, 0xa8, 0xac, 0xe9, 0x9d, 0xe4, 0x24,
+	0x18, 0x10, 0x49, 0xbc, 0xa6, 0xc9, 0x5a, 0x6b, 0x3e, 0x10, 0x49, 0xd0, 0x36, 0xb4, 0x47, 0xec,
+	0x86, 0xf2, 0x98, 0xc4, 0x21, 0x35, 0x3e, 0x2d, 0xed, 0xd3, 0x9a, 0xab, 0xb5, 0xe3, 0x33, 0xa8,
+	0xdf, 0x30, 0x69, 0x5d, 0xda, 0xda, 0xa5, 0xa6, 0x14, 0x36, 0x4a, 0xa7, 0x4f, 0x04, 0x0d, 0x86,
+	0x94, 0x06, 0x53, 0xca, 0x83, 0x11, 0x11, 0x5e, 0x47, 0xfb, 0x34, 0x95, 0xfe, 0x98, 0xd2, 0x1e,
+	0xe5, 0x27, 0x44, 0xf8, 0xbf, 0x14, 0x61, 0x45, 0x63, 0x0a, 0xbd, 0x84, 0xca, 0xb5, 0x46, 0x85,
+	0x05, 0x9c, 0x6b, 0x00, 0x67, 0x90, 0x82, 0xad, 0x0d, 0xbd, 0x86, 0x55, 0xc9, 0x24, 0x89, 0x82,
+	0x6c, 0x69, 0x0d, 0x46, 0xda, 0xda, 0xd0, 0x9d, 0xd7, 0x17, 0x81, 0x23, 0xc6, 0xdf, 0x50, 0x0d,
+	0x13, 0x07, 0xeb, 0x6f, 0xb4, 0x0b, 0x28, 0xd3, 0x6c, 0x0d, 0x31, 0x2a, 0x3c, 0x67, 0xab, 0xbc,
+	0xe3, 0xe2, 0x2c, 0x3e, 0x4e, 0xb5, 0x01, 0xfd, 0x0b, 0xdc, 0x1c, 0xa6, 0x57, 0xb6, 0xca, 0x3b,
+	0x8d, 0xfd, 0x55, 0x93, 0x5a, 0x06, 0xd5, 0x38, 0xe7, 0xe6, 0xff, 0x5c, 0x86, 0x46, 0xc6, 0xba,
+	0x14, 0xe7, 0x08, 0x1c, 0x79, 0x3f, 0x35, 0xc9, 0x37, 0xb1, 0xfe, 0x56, 0x35, 0x55, 0xbf, 0x41,
+	0x4c, 0x26, 0x26, 0xed, 0x3a, 0xae, 0x29, 0xc5, 0x05, 0x99, 0xe8, 0xe3, 0x0c, 0x39, 0x9b, 0x58,
+	0x64, 0xeb, 0x6f, 0xd4, 0x82, 0x92, 0x64, 0x16, 0xc5, 0x25, 0xc9, 0xd0, 0x1a, 0xac, 0xc4, 0x2c,
+	0x0e, 0xa9, 0xc6, 0xab, 0x83, 0x8d, 0x80, 0x3a, 0x50, 0x56, 0x0d, 0xa8, 0x6a, 0x9d, 0xfa, 0x54,
+	0x1b, 0x29, 0x88, 0x4d, 0xf9, 0x38, 0x4c, 0x90, 0xa9, 0x30, 0xd7, 0x53, 0xb2, 0x0a, 0x72, 0x43,
+	0xa2, 0x19, 0xd5, 0xb0, 0x74, 0xb1, 0x11, 0x94, 0x76, 0x1c, 0x4f, 0x67, 0x52, 0x43, 0xd2, 0xc5,
+	0x46, 0x50, 0x81, 0x74, 0x8f, 0xc9, 0x3d, 0xe5, 0x1a, 0x90, 0x2e, 0xae, 0x0d, 0x29, 0xed, 0x29,
+	0x39, 0x31, 0x72, 0x22, 0xc7, 0xcc, 0x62, 0x51, 0x19, 0xb1, 0x92, 0xd1, 0x4b, 0x68, 0x09, 0x1a,
+	0x0f, 0x28, 0x0f, 0xe4, 0x9d, 0x19, 0x75, 0x83, 0x45, 0xd7, 0x68, 0xaf, 0xee, 0xf4, 0xb0, 0x6f,
+	0x82, 0x99, 0x18, 0xe3, 0x61, 0x90, 0x58, 0xd7, 0x1a, 0x6d, 0xfe, 0x0b, 0xb8, 0xc6, 0x6c, 0x07,
+	0xa9, 0xad, 0x8f, 0xd8, 0xe8, 0xcf, 0x79, 0xef, 0xc1, 0xdc, 0x07, 0xe3, 0x78, 0x40, 0xef, 0x34,
+	0x16, 0x9d, 0xdc, 0xdc, 0x9f, 0x29, 0xbd, 0xaa, 0x14, 0x27, 0xb7, 0xde, 0xaa, 0xde, 0x47, 0x7d,
+	0xfa, 0x7f, 0x87, 0xf5, 0xab, 0x3c, 0x2c, 0x12, 0x82, 0x5c, 0xd2, 0x54, 0x7f, 0x17, 0xfe, 0xbc,
+	0xe0, 0x6d, 0x79, 0x77, 0x99, 0xfb, 0x77, 0x25, 0x28, 0x9f, 0xb3, 0x91, 0xba, 0x37, 0xc8, 0x60,
+	0xc0, 0xa9, 0x10, 0xc9, 0xbd, 0x61, 0x45, 0xc5, 0x11, 0x92, 0x4d, 0xc7, 0xa1, 0xa2, 0x53, 0x85,
+	0x51, 0x2b, 0xa9, 0x68, 0x7a, 0xf0, 0x0c, 0x05, 0xea, 0xef, 0x85, 0x62, 0x38, 0x8b, 0xc5, 0x78,
+	0x05, 0x9d, 0x87, 0xf0, 0xb7, 0xe8, 0x69, 0x3f, 0x00, 0xff, 0xf2, 0xba, 0x55, 0x74, 0x13, 0x17,
+	0xeb, 0x96, 0x6f, 0x53, 0xf5, 0x61, 0x9b, 0x9e, 0x81, 0xe2, 0x45, 0x1b, 0xa3, 0x66, 0x80, 0x10,
+	0xb1, 0x91, 0x59, 0xeb, 0x41, 0x95, 0xd3, 0x09, 0xbb, 0xa1, 0x03, 0x0d, 0xb8, 0x1a, 0x4e, 0x44,
+	0xff, 0x87, 0x12, 0x54, 0xb1, 0x61, 0x5c, 0x74, 0x00, 0x8d, 0xcc, 0xae, 0x96, 0x23, 0x96, 0x0c,
+	0x62, 0xd6, 0x4b, 0x55, 0x4f, 0x48, 0x22, 0x67, 0xe6, 0x32, 0x72, 0xb0, 0x95, 0x14, 0xc3, 0xca,
+	0xbb, 0x80, 0x72, 0xce, 0xb8, 0x65, 0x87, 0xaa, 0xbc, 0x3b, 0x52, 0x62, 0x8e, 0x7c, 0x9d, 0x3c,
+	0xf9, 0xee, 0xc1, 0x13, 0x3a, 0x1c, 0xd2, 0x50, 0x8e, 0x6f, 0x68, 0x30, 0x1f, 0x1f, 0x53, 0xbf,
+	0xd5, 0xd4, 0x74, 0x92, 0xcc, 0xd1, 0x2b, 0xe8, 0x84, 0x2c, 0x96, 0x9c, 0x84, 0x32, 0x48, 0xda,
+	0x6b, 0xee, 0x91, 0x76, 0xa2, 0x3f, 0xb4, 0x6d, 0xfe, 0xca, 0x55, 0xb2, 0x09, 0x8e, 0x12, 0xbc,
+	0x9a, 0xa6, 0x9f, 0xba, 0x39, 0xf5, 0x39, 0x1b, 0x61, 0xad, 0xf6, 0x3f, 0x42, 0xeb, 0x30, 0x0c,
+	0xd9, 0x2c, 0x96, 0x99, 0x17, 0xce, 0x23, 0x80, 0x4a, 0xaf, 0xf5, 0xd2, 0x57, 0xaf, 0x75, 0x7f,
+	0x04, 0x55, 0x1b, 0xf7, 0x0b, 0x01, 0x3d, 0xa8, 0xf6, 0x49, 0xa4, 0xae, 0x05, 0xcb, 0xc3, 0x89,
+	0x38, 0x27, 0xa3, 0x72, 0x96, 0x8c, 0x10, 0x38, 0x21, 0x1b, 0xd0, 0x84, 0xc6, 0xd4, 0xb7, 0xff,
+	0x53, 0x11, 0x1a, 0xef, 0x49, 0x14, 0x65, 0x46, 0x4b, 0x53, 0x5d, 0x71, 0x81, 0xea, 0x4a, 0x29,
+	0xd5, 0x59, 0x52, 0x2b, 0x3f, 0x42, 0x6a, 0xce, 0x63, 0xa4, 0xb6, 0xb2, 0x94, 0xd4, 0x2a, 0x59,
+	0x52, 0x4b, 0x6b, 0x54, 0xfd, 0x7a, 0x8d, 0xfe, 0x06, 0xae, 0xc9, 0x7c, 0xfe, 0xbc, 0xe2, 0x54,
+	0xcc, 0x22, 0x69, 0x93, 0xb7, 0x92, 0xbf, 0x0d, 0x4f, 0x8e, 0x84, 0x1c, 0x4f, 0x88, 0x54, 0x00,
+	0x49, 0xdd, 0xed, 0x29, 0x8a, 0xe9, 0x29, 0xfc, 0x0f, 0xf0, 0xf4, 0x92, 0xc6, 0x03, 0x4c, 0x6e,
+	0xb3, 0xb0, 0xb6, 0x85, 0xd9, 0x86, 0x36, 0x27, 0xb7, 0xc1, 0xc3, 0x49, 0x70, 0x71, 0x8b, 0xe7,
+	0xfc, 0xfd, 0x2d, 0xa8, 0x5c, 0x19, 0xa6, 0x98, 0x33, 0x48, 0x31, 0xcb, 0x20, 0xfe, 0xff, 0xa0,
+	0x7e, 0xce, 0x46, 0xc7, 0xe3, 0x48, 0x52, 0xae, 0xde, 0x15, 0xb6, 0x9f, 0x34, 0xf1, 0x9b, 0x2b,
+	0xd4, 0xd5, 0x9c, 0x21, 0xa1, 0xf4, 0x6a, 0x36, 0x1b, 0x24, 0x01, 0x5f, 0xff, 0x03, 0x6a, 0xc9,
+	0x7b, 0x14, 0x01, 0x54, 0xce, 0x0f, 0xaf, 0x8e, 0x2e, 0xaf, 0x3a, 0x05, 0xd4, 0x80, 0x6a, 0xef,
+	0xe8, 0xe2, 0xc3, 0xd9, 0xc5, 0x49, 0xa7, 0x88, 0x5c, 0xa8, 0x1d, 0x1d, 0xe2, 0xf3, 0x33, 0x65,
+	0x2a, 0xed, 0xff, 0x58, 0x04, 0xf8, 0xaf, 0x7e, 0xb6, 0x5f, 0xb0, 0x81, 0xba, 0x9c, 0x1d, 0x55,
+	0x4b, 0xd4, 0x31, 0xf1, 0xe7, 0x2f, 0xf6, 0x8d, 0xd5, 0x8c, 0xc6, 0x54, 0xce, 0x2f, 0xa0, 0x7f,
+	0x42, 0xfd, 0x72, 0xd6, 0x17, 0x21, 0x1f, 0xf7, 0xe9, 0xef, 0x5c, 0xf3, 0xae, 0x88, 0x0e, 0xa0,
+	0xd2, 0x1d, 0xff, 0x81, 0x6d, 0x76, 0x8a, 0xef, 0x8a, 0xfb, 0xbf, 0x3a, 0x50, 0x55, 0x89, 0x1e,
+	0xf6, 0xce, 0xd0, 0xbf, 0xa1, 0x91, 0x79, 0x57, 0xa3, 0x86, 0x59, 0xa3, 0xff, 0x67, 0x6c, 0x3c,
+	0xcd, 0x40, 0x25, 0xff, 0xee, 0xf6, 0x0b, 0x68, 0x17, 0x6a, 0x27, 0x54, 0x6a, 0x1b, 0x42, 0x39,
+	0x4c, 0x99, 0xdd, 0x1b, 0x19, 0x9d, 0x5f, 0x40, 0x67, 0xb0, 0x76, 0x42, 0x65, 0xa6, 0xa9, 0xdd,
+	0x7b, 0x4d, 0xa6, 0xcf, 0x17, 0x48, 0x2f, 0x73, 0x2b, 0x6d, 0x2c, 0x52, 0xa2, 0x5f, 0x40, 0xc7,
+	0xf0, 0xa7, 0x7c, 0xa8, 0x84, 0x55, 0xbf, 0x1c, 0xab, 0x69, 0xcb, 0x61, 0x9c, 0xfd, 0x02, 0x3a,
+	0x00, 0x38, 0xa1, 0x32, 0xe1, 0x04, 0x3b, 0x17, 0x79, 0xea, 0xd9, 0x68, 0xe6, 0xb4, 0x7e, 0x01,
+	0xbd, 0xb5, 0x5d, 0xb5, 0x99, 0x65, 0xe6, 0x7c, 0x03, 0x65, 0x55, 0x69, 0x9d, 0xfe, 0x03, 0x8d,
+	0xcc, 0xa8, 0x2c, 0x5b, 0x67, 0xcb, 0xbc, 0x64, 0xa0, 0xfc, 0x02, 0xfa, 0x08, 0x68, 0x71, 0x80,
+	0xd0, 0x0b, 0xb3, 0xe4, 0xd1, 0xd1, 0xda, 0xd8, 0x7c, 0xa4, 0x14, 0x69, 0xdc, 0x7d, 0x58, 0x4d,
+	0xe1, 0x76, 0x41, 0x6f, 0xd5, 0xc3, 0x54, 0xe4, 0xbb, 0x9f, 0x7b, 0xb2, 0x6a, 0xb0, 0xbd, 0x85,
+	0x66, 0xba, 0xe6, 0x9c, 0x8d, 0x04, 0x6a, 0xa7, 0xdc, 0x6d, 0x26, 0x6f, 0x63, 0x4e, 0xe6, 0x6a,
+	0x41, 0xf7, 0x0d, 0xb4, 0x43, 0x36, 0xd9, 0xb3, 0xff, 0x65, 0x95, 0xa9, 0xdb, 0x9e, 0x4f, 0x48,
+	0x4f, 0xfd, 0xb7, 0xed, 0x15, 0xbf, 0x2f, 0x39, 0x4a, 0xd7, 0xaf, 0xe8, 0xff, 0xba, 0x07, 0xbf,
+	0x0d, 0x00, 0x71, 0x85, 0xa6, 0x4c, 0xfb, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// KlaytnNodeClient is the client API for KlaytnNode service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KlaytnNodeClient interface {
+	Call(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (*RPCResponse, error)
+	Subscribe(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (KlaytnNode_SubscribeClient, error)
+	BiCall(ctx context.Context, opts ...grpc.CallOption) (KlaytnNode_BiCallClient, error)
+}
+
+type klaytnNodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewKlaytnNodeClient(cc *grpc.ClientConn) KlaytnNodeClient {
+	return &klaytnNodeClient{cc}
+}
+
+func (c *klaytnNodeClient) Call(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (*RPCResponse, error) {
+	out := new(RPCResponse)
+	err := c.cc.Invoke(ctx, "/grpc.KlaytnNode/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klaytnNodeClient) Subscribe(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (KlaytnNode_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_KlaytnNode_serviceDesc.Streams[0], "/grpc.KlaytnNode/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &klaytnNodeSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KlaytnNode_SubscribeClient interface {
+	Recv() (*RPCResponse, error)
+	grpc.ClientStream
+}
+
+type klaytnNodeSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *klaytnNodeSubscribeClient) Recv() (*RPCResponse, error) {
+	m := new(RPCResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *klaytnNodeClient) BiCall(ctx context.Context, opts ...grpc.CallOption) (KlaytnNode_BiCallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_KlaytnNode_serviceDesc.Streams[1], "/grpc.KlaytnNode/BiCall", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &klaytnNodeBiCallClient{stream}
+	return x, nil
+}
+
+type KlaytnNode_BiCallClient interface {
+	Send(*RPCRequest) error
+	Recv() (*RPCResponse, error)
+	grpc.ClientStream
+}
+
+type klaytnNodeBiCallClient struct {
+	grpc.ClientStream
+}
+
+func (x *klaytnNodeBiCallClient) Send(m *RPCRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *klaytnNodeBiCallClient) Recv() (*RPCResponse, error) {
+	m := new(RPCResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// KlaytnNodeServer is the server API for KlaytnNode service.
+type KlaytnNodeServer interface {
+	Call(context.Context, *RPCRequest) (*RPCResponse, error)
+	Subscribe(*RPCRequest, KlaytnNode_SubscribeServer) error
+	BiCall(KlaytnNode_BiCallServer) error
+}
+
+func RegisterKlaytnNodeServer(s *grpc.Server, srv KlaytnNodeServer) {
+	s.RegisterService(&_KlaytnNode_serviceDesc, srv)
+}
+
+func _KlaytnNode_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RPCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlaytnNodeServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlaytnNode/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlaytnNodeServer).Call(ctx, req.(*RPCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlaytnNode_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RPCRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KlaytnNodeServer).Subscribe(m, &klaytnNodeSubscribeServer{stream})
+}
+
+type KlaytnNode_SubscribeServer interface {
+	Send(*RPCResponse) error
+	grpc.ServerStream
+}
+
+type klaytnNodeSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *klaytnNodeSubscribeServer) Send(m *RPCResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KlaytnNode_BiCall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KlaytnNodeServer).BiCall(&klaytnNodeBiCallServer{stream})
+}
+
+type KlaytnNode_BiCallServer interface {
+	Send(*RPCResponse) error
+	Recv() (*RPCRequest, error)
+	grpc.ServerStream
+}
+
+type klaytnNodeBiCallServer struct {
+	grpc.ServerStream
+}
+
+func (x *klaytnNodeBiCallServer) Send(m *RPCResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *klaytnNodeBiCallServer) Recv() (*RPCRequest, error) {
+	m := new(RPCRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _KlaytnNode_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.KlaytnNode",
+	HandlerType: (*KlaytnNodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Call",
+			Handler:    _KlaytnNode_Call_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _KlaytnNode_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BiCall",
+			Handler:       _KlaytnNode_BiCall_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "klaytn.proto",
+}
+
+// KlayAPIClient is the client API for KlayAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KlayAPIClient interface {
+	BlockNumber(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockNumberResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error)
+	GetTransactionByHash(ctx context.Context, in *TransactionHashRequest, opts ...grpc.CallOption) (*Transaction, error)
+	GetTransactionReceipt(ctx context.Context, in *TransactionHashRequest, opts ...grpc.CallOption) (*Receipt, error)
+	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Account, error)
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	EstimateGas(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*TransactionHashResponse, error)
+	SubscribeNewHeads(ctx context.Context, in *Empty, opts ...grpc.CallOption) (KlayAPI_SubscribeNewHeadsClient, error)
+	SubscribeLogs(ctx context.Context, in *LogFilter, opts ...grpc.CallOption) (KlayAPI_SubscribeLogsClient, error)
+}
+
+type klayAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewKlayAPIClient(cc *grpc.ClientConn) KlayAPIClient {
+	return &klayAPIClient{cc}
+}
+
+func (c *klayAPIClient) BlockNumber(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockNumberResponse, error) {
+	out := new(BlockNumberResponse)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/BlockNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) GetTransactionByHash(ctx context.Context, in *TransactionHashRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/GetTransactionByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) GetTransactionReceipt(ctx context.Context, in *TransactionHashRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/GetTransactionReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) EstimateGas(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*TransactionHashResponse, error) {
+	out := new(TransactionHashResponse)
+	err := c.cc.Invoke(ctx, "/grpc.KlayAPI/SendRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klayAPIClient) SubscribeNewHeads(ctx context.Context, in *Empty, opts ...grpc.CallOption) (KlayAPI_SubscribeNewHeadsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_KlayAPI_serviceDesc.Streams[0], "/grpc.KlayAPI/SubscribeNewHeads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &klayAPISubscribeNewHeadsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KlayAPI_SubscribeNewHeadsClient interface {
+	Recv() (*Header, error)
+	grpc.ClientStream
+}
+
+type klayAPISubscribeNewHeadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *klayAPISubscribeNewHeadsClient) Recv() (*Header, error) {
+	m := new(Header)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *klayAPIClient) SubscribeLogs(ctx context.Context, in *LogFilter, opts ...grpc.CallOption) (KlayAPI_SubscribeLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_KlayAPI_serviceDesc.Streams[1], "/grpc.KlayAPI/SubscribeLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &klayAPISubscribeLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KlayAPI_SubscribeLogsClient interface {
+	Recv() (*Log, error)
+	grpc.ClientStream
+}
+
+type klayAPISubscribeLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *klayAPISubscribeLogsClient) Recv() (*Log, error) {
+	m := new(Log)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// KlayAPIServer is the server API for KlayAPI service.
+type KlayAPIServer interface {
+	BlockNumber(context.Context, *Empty) (*BlockNumberResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*Block, error)
+	GetTransactionByHash(context.Context, *TransactionHashRequest) (*Transaction, error)
+	GetTransactionReceipt(context.Context, *TransactionHashRequest) (*Receipt, error)
+	GetAccount(context.Context, *AccountRequest) (*Account, error)
+	Call(context.Context, *CallRequest) (*CallResponse, error)
+	EstimateGas(context.Context, *CallRequest) (*EstimateGasResponse, error)
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*TransactionHashResponse, error)
+	SubscribeNewHeads(*Empty, KlayAPI_SubscribeNewHeadsServer) error
+	SubscribeLogs(*LogFilter, KlayAPI_SubscribeLogsServer) error
+}
+
+func RegisterKlayAPIServer(s *grpc.Server, srv KlayAPIServer) {
+	s.RegisterService(&_KlayAPI_serviceDesc, srv)
+}
+
+func _KlayAPI_BlockNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).BlockNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/BlockNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).BlockNumber(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_GetTransactionByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).GetTransactionByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/GetTransactionByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).GetTransactionByHash(ctx, req.(*TransactionHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).GetTransactionReceipt(ctx, req.(*TransactionHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).EstimateGas(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlayAPIServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.KlayAPI/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlayAPIServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlayAPI_SubscribeNewHeads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KlayAPIServer).SubscribeNewHeads(m, &klayAPISubscribeNewHeadsServer{stream})
+}
+
+type KlayAPI_SubscribeNewHeadsServer interface {
+	Send(*Header) error
+	grpc.ServerStream
+}
+
+type klayAPISubscribeNewHeadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *klayAPISubscribeNewHeadsServer) Send(m *Header) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KlayAPI_SubscribeLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KlayAPIServer).SubscribeLogs(m, &klayAPISubscribeLogsServer{stream})
+}
+
+type KlayAPI_SubscribeLogsServer interface {
+	Send(*Log) error
+	grpc.ServerStream
+}
+
+type klayAPISubscribeLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *klayAPISubscribeLogsServer) Send(m *Log) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _KlayAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.KlayAPI",
+	HandlerType: (*KlayAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockNumber",
+			Handler:    _KlayAPI_BlockNumber_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _KlayAPI_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetTransactionByHash",
+			Handler:    _KlayAPI_GetTransactionByHash_Handler,
+		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _KlayAPI_GetTransactionReceipt_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _KlayAPI_GetAccount_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _KlayAPI_Call_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _KlayAPI_EstimateGas_Handler,
+		},
+		{
+			MethodName: "SendRawTransaction",
+			Handler:    _KlayAPI_SendRawTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeNewHeads",
+			Handler:       _KlayAPI_SubscribeNewHeads_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeLogs",
+			Handler:       _KlayAPI_SubscribeLogs_Handler,
+			ServerStreams: true,
 		},
 	},
 	Metadata: "klaytn.proto",
@@ -0,0 +1,206 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// jwtIssuedAtWindow is the allowed difference between the issued-at claim of a token
+	// and the local time, which limits the replay of a captured token.
+	jwtIssuedAtWindow = 60 * time.Second
+
+	// JWTSecretLength is the length of the shared secret in bytes.
+	JWTSecretLength = 32
+)
+
+var (
+	errMissingToken      = errors.New("missing token")
+	errMalformedToken    = errors.New("malformed token")
+	errUnsupportedJWTAlg = errors.New("unsupported signing algorithm")
+	errInvalidSignature  = errors.New("invalid token signature")
+	errMissingIssuedAt   = errors.New("missing issued-at claim")
+	errStaleToken        = errors.New("stale token")
+	errExpiredToken      = errors.New("token is expired")
+)
+
+// jwtHeader is the header of a JSON web token.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// jwtClaims are the claims of a JSON web token checked by the authenticated endpoint.
+// If Namespaces is empty, all namespaces of the endpoint are allowed.
+type jwtClaims struct {
+	IssuedAt   *int64   `json:"iat"`
+	ExpiresAt  *int64   `json:"exp,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// verifyJWT checks the HS256 signature and the time claims of the token, and returns its claims.
+func verifyJWT(token string, secret []byte, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errMalformedToken
+	}
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, errMalformedToken
+	}
+	if header.Alg != "HS256" {
+		return nil, errUnsupportedJWTAlg
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errMalformedToken
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errInvalidSignature
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, errMalformedToken
+	}
+	if claims.IssuedAt == nil {
+		return nil, errMissingIssuedAt
+	}
+	if diff := now.Sub(time.Unix(*claims.IssuedAt, 0)); diff > jwtIssuedAtWindow || diff < -jwtIssuedAtWindow {
+		return nil, errStaleToken
+	}
+	if claims.ExpiresAt != nil && !now.Before(time.Unix(*claims.ExpiresAt, 0)) {
+		return nil, errExpiredToken
+	}
+	return &claims, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+type authNamespacesKey struct{}
+
+// withAuthNamespaces returns a context restricting the requests to the given namespaces.
+func withAuthNamespaces(ctx context.Context, namespaces []string) context.Context {
+	allowed := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		allowed[ns] = true
+	}
+	return context.WithValue(ctx, authNamespacesKey{}, allowed)
+}
+
+// authorizedNamespace returns whether the namespace is allowed by the token of the request.
+// The metadata namespace is always allowed.
+func authorizedNamespace(ctx context.Context, namespace string) bool {
+	allowed, ok := ctx.Value(authNamespacesKey{}).(map[string]bool)
+	return !ok || namespace == MetadataApi || allowed[namespace]
+}
+
+// jwtHandler is a http.Handler authenticating the requests by the bearer token
+// in the Authorization header.
+type jwtHandler struct {
+	secret []byte
+	next   http.Handler
+}
+
+func newJWTHandler(secret []byte, next http.Handler) http.Handler {
+	return &jwtHandler{secret: secret, next: next}
+}
+
+func (h *jwtHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		http.Error(w, errMissingToken.Error(), http.StatusUnauthorized)
+		return
+	}
+	claims, err := verifyJWT(strings.TrimPrefix(auth, "Bearer "), h.secret, time.Now())
+	if err != nil {
+		logger.Debug("Rejected an unauthenticated RPC request", "remote", r.RemoteAddr, "err", err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if len(claims.Namespaces) > 0 {
+		r = r.WithContext(withAuthNamespaces(r.Context(), claims.Namespaces))
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// NewAuthHandler returns a http.Handler serving the JSON-RPC requests over HTTP and WebSocket
+// to the clients authenticated by a JWT signed with the shared secret. The namespaces
+// of the requests are restricted by the "namespaces" claim of the token if it is given.
+func NewAuthHandler(secret []byte, vhosts []string, srv *Server) http.Handler {
+	ws := srv.WebsocketHandler([]string{"*"})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			ws.ServeHTTP(w, r)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	})
+	return newVHostHandler(vhosts, newJWTHandler(secret, handler))
+}
+
+// StartAuthEndpoint starts the JWT-authenticated HTTP and WebSocket RPC endpoint serving the APIs
+// of the given modules. If the module list is empty, all APIs are served.
+func StartAuthEndpoint(endpoint string, apis []API, modules []string, vhosts []string, secret []byte, timeouts HTTPTimeouts) (net.Listener, *Server, error) {
+	if len(secret) != JWTSecretLength {
+		return nil, nil, errors.New("invalid JWT secret length")
+	}
+	whitelist := make(map[string]bool)
+	for _, module := range modules {
+		whitelist[module] = true
+	}
+	handler := NewServer()
+	for _, api := range apis {
+		if whitelist[api.Namespace] || len(whitelist) == 0 {
+			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
+				return nil, nil, err
+			}
+			logger.Debug("Authenticated endpoint registered", "namespace", api.Namespace)
+		}
+	}
+	listener, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	// WriteTimeout is not applied not to close the websocket connections
+	timeouts = sanitizeTimeouts(timeouts)
+	srv := &http.Server{
+		Handler:     NewAuthHandler(secret, vhosts, handler),
+		ReadTimeout: timeouts.ReadTimeout,
+		IdleTimeout: timeouts.IdleTimeout,
+	}
+	go srv.Serve(listener)
+	return listener, handler, nil
+}
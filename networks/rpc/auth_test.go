@@ -0,0 +1,131 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func makeTestJWT(t *testing.T, alg string, secret []byte, claims interface{}) string {
+	header, err := json.Marshal(jwtHeader{Alg: alg, Typ: "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyJWT(t *testing.T) {
+	now := time.Now()
+	iat := now.Unix()
+	past := now.Add(-time.Hour).Unix()
+
+	testcases := []struct {
+		token string
+		err   error
+	}{
+		{makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": iat}), nil},
+		{makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": iat, "exp": now.Add(time.Minute).Unix()}), nil},
+		{makeTestJWT(t, "HS256", []byte("wrong secret"), map[string]interface{}{"iat": iat}), errInvalidSignature},
+		{makeTestJWT(t, "none", testJWTSecret, map[string]interface{}{"iat": iat}), errUnsupportedJWTAlg},
+		{makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{}), errMissingIssuedAt},
+		{makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": past}), errStaleToken},
+		{makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": iat, "exp": past}), errExpiredToken},
+		{"invalid", errMalformedToken},
+		{"a.b.c", errMalformedToken},
+	}
+	for i, tc := range testcases {
+		_, err := verifyJWT(tc.token, testJWTSecret, now)
+		assert.Equal(t, tc.err, err, "testcase %d", i)
+	}
+
+	claims, err := verifyJWT(makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": iat, "namespaces": []string{"klay"}}), testJWTSecret, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"klay"}, claims.Namespaces)
+}
+
+func TestAuthHandler(t *testing.T) {
+	server := newTestServer("test", new(Service))
+	require.NoError(t, server.RegisterName("other", new(Service)))
+	defer server.Stop()
+
+	hs := httptest.NewServer(NewAuthHandler(testJWTSecret, []string{"*"}, server))
+	defer hs.Close()
+
+	client, err := DialHTTP(hs.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Requests without a valid token are rejected
+	var result Result
+	err = client.Call(&result, "test_echo", "hello", 10, &Args{"world"})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "401"))
+
+	client.SetHeader("Authorization", "Bearer "+makeTestJWT(t, "HS256", []byte("wrong secret"), map[string]interface{}{"iat": time.Now().Unix()}))
+	assert.Error(t, client.Call(&result, "test_echo", "hello", 10, &Args{"world"}))
+
+	// A token without the namespaces claim allows all namespaces
+	client.SetHeader("Authorization", "Bearer "+makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": time.Now().Unix()}))
+	assert.NoError(t, client.Call(&result, "test_echo", "hello", 10, &Args{"world"}))
+	assert.NoError(t, client.Call(&result, "other_echo", "hello", 10, &Args{"world"}))
+
+	// A token with the namespaces claim restricts the namespaces
+	client.SetHeader("Authorization", "Bearer "+makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": time.Now().Unix(), "namespaces": []string{"test"}}))
+	assert.NoError(t, client.Call(&result, "test_echo", "hello", 10, &Args{"world"}))
+	err = client.Call(&result, "other_echo", "hello", 10, &Args{"world"})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not allowed"))
+	var modules map[string]string
+	assert.NoError(t, client.Call(&modules, "rpc_modules"))
+
+	// The websocket handshake is authenticated in the same way
+	wsURL := "ws:" + strings.TrimPrefix(hs.URL, "http:")
+	config, err := websocket.NewConfig(wsURL, "http://localhost")
+	require.NoError(t, err)
+	_, err = wsDialContext(context.Background(), config)
+	assert.Error(t, err)
+
+	config.Header.Set("Authorization", "Bearer "+makeTestJWT(t, "HS256", testJWTSecret, map[string]interface{}{"iat": time.Now().Unix(), "namespaces": []string{"test"}}))
+	wsClient, err := NewClient(context.Background(), func(ctx context.Context) (net.Conn, error) {
+		return wsDialContext(ctx, config)
+	})
+	require.NoError(t, err)
+	defer wsClient.Close()
+	assert.NoError(t, wsClient.Call(&result, "test_echo", "hello", 10, &Args{"world"}))
+	err = wsClient.Call(&result, "other_echo", "hello", 10, &Args{"world"})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not allowed"))
+}
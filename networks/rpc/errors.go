@@ -58,6 +58,22 @@ func (e *callbackError) ErrorCode() int { return defaultErrorCode }
 
 func (e *callbackError) Error() string { return e.message }
 
+// the method is denied for the client by the access control
+type accessDeniedError struct{ method string }
+
+func (e *accessDeniedError) ErrorCode() int { return -32004 }
+
+func (e *accessDeniedError) Error() string {
+	return fmt.Sprintf("The method %s is not allowed for the client", e.method)
+}
+
+// the request exceeds a limit of the method
+type limitExceededError struct{ message string }
+
+func (e *limitExceededError) ErrorCode() int { return -32005 }
+
+func (e *limitExceededError) Error() string { return e.message }
+
 // issued when a request is received after the server is issued to stop.
 type shutdownError struct{}
 
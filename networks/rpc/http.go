@@ -226,17 +226,22 @@ func (t *httpReadWriteNopCloser) Close() error {
 // Deprecated: Server implements http.Handler
 func NewHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, srv *Server) *http.Server {
 	timeouts = sanitizeTimeouts(timeouts)
-	// Wrap the CORS-handler within a host-handler
-	handler := newCorsHandler(srv, cors)
-	handler = newVHostHandler(vhosts, handler)
 	return &http.Server{
-		Handler:      handler,
+		Handler:      NewHTTPHandlerStack(srv, cors, vhosts),
 		ReadTimeout:  timeouts.ReadTimeout,
 		WriteTimeout: timeouts.WriteTimeout,
 		IdleTimeout:  timeouts.IdleTimeout,
 	}
 }
 
+// NewHTTPHandlerStack returns wrapped http-related handlers validating the CORS and
+// the Host-header of incoming requests.
+func NewHTTPHandlerStack(srv http.Handler, cors []string, vhosts []string) http.Handler {
+	// Wrap the CORS-handler within a host-handler
+	handler := newCorsHandler(srv, cors)
+	return newVHostHandler(vhosts, handler)
+}
+
 func NewFastHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, srv *Server) *fasthttp.Server {
 	timeouts = sanitizeTimeouts(timeouts)
 	if len(cors) == 0 {
@@ -294,6 +299,7 @@ func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx = context.WithValue(ctx, "remote", r.RemoteAddr)
 	ctx = context.WithValue(ctx, "scheme", r.Proto)
 	ctx = context.WithValue(ctx, "local", r.Host)
+	ctx = WithClientInfo(ctx, r.RemoteAddr, r.Header.Get(APIKeyHeader()))
 
 	body := io.LimitReader(r.Body, int64(common.MaxRequestContentLength))
 	codec := NewJSONCodec(&httpReadWriteNopCloser{body, w})
@@ -303,6 +309,23 @@ func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	srv.ServeSingleRequest(ctx, codec, OptionMethodInvocation)
 }
 
+// RemoteIPFromContext returns the IP address of the client of the request.
+// It returns an empty string if the IP address is unknown.
+func RemoteIPFromContext(ctx context.Context) string {
+	if c, ok := clientInfoFromContext(ctx); ok && c.ip != "" {
+		return c.ip
+	}
+	remote, ok := ctx.Value("remote").(string)
+	if !ok || remote == "" {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(remote)
+	if err != nil {
+		return remote
+	}
+	return host
+}
+
 func (srv *Server) HandleFastHTTP(requestCtx *fasthttp.RequestCtx) {
 	r := &requestCtx.Request
 	w := &requestCtx.Response
@@ -326,6 +349,7 @@ func (srv *Server) HandleFastHTTP(requestCtx *fasthttp.RequestCtx) {
 	ctx = context.WithValue(ctx, "remote", requestCtx.RemoteAddr().String())
 	ctx = context.WithValue(ctx, "scheme", string(requestCtx.URI().Scheme()))
 	ctx = context.WithValue(ctx, "local", requestCtx.LocalAddr().String())
+	ctx = WithClientInfo(ctx, requestCtx.RemoteAddr().String(), string(r.Header.Peek(APIKeyHeader())))
 
 	reader := bufio.NewReaderSize(bytes.NewReader(r.Body()), common.MaxRequestContentLength)
 	codec := NewJSONCodec(&httpReadWriteNopCloser{reader, w.BodyWriter()})
@@ -371,7 +395,7 @@ func validateFastRequest(requestCtx *fasthttp.RequestCtx) (int, error) {
 	return 0, nil
 }
 
-func newCorsHandler(srv *Server, allowedOrigins []string) http.Handler {
+func newCorsHandler(srv http.Handler, allowedOrigins []string) http.Handler {
 	// disable CORS support if user has not specified a custom CORS configuration
 	if len(allowedOrigins) == 0 {
 		return srv
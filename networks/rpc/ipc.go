@@ -38,7 +38,8 @@ func (srv *Server) ServeListener(l net.Listener) error {
 			return err
 		}
 		logger.Trace("Accepted connection", "addr", conn.RemoteAddr())
-		go srv.ServeCodec(NewJSONCodec(conn), OptionMethodInvocation|OptionSubscriptions)
+		ctx := WithClientInfo(context.Background(), conn.RemoteAddr().String(), "")
+		go srv.serveCodec(ctx, NewJSONCodec(conn), OptionMethodInvocation|OptionSubscriptions)
 	}
 }
 
@@ -0,0 +1,474 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAPIKeyHeader is the HTTP header carrying the API key of a client
+// if LimitConfig.APIKeyHeader is not set.
+const DefaultAPIKeyHeader = "X-API-Key"
+
+const (
+	// maxLimitClients is the maximum number of the clients tracked by the limiter.
+	// The requests of a new client are rejected if the limiter is full even after pruning.
+	maxLimitClients = 100000
+
+	// limitPruneInterval is the interval to remove the idle clients from the limiter.
+	limitPruneInterval = time.Minute
+
+	// limitFullPruneInterval is the minimum interval to remove the idle clients when the limiter is full.
+	limitFullPruneInterval = time.Second
+)
+
+var (
+	accessLimiter   *limiter = nil
+	accessLimiterMu          = new(sync.RWMutex)
+)
+
+// LimitRule restricts the RPC methods for the matched clients.
+type LimitRule struct {
+	// Methods are the method names like "klay_call", the namespaces like "debug_*",
+	// or "*" for all methods. The GraphQL requests are limited as "graphql_query".
+	Methods []string `json:"methods"`
+
+	// IPs are the IP addresses or the CIDR ranges, and APIKeys are the API keys of the
+	// clients to which the rule applies. The rule applies to all clients if both are empty.
+	IPs     []string `json:"ips,omitempty"`
+	APIKeys []string `json:"apiKeys,omitempty"`
+
+	// Deny rejects the methods for the matched clients.
+	Deny bool `json:"deny,omitempty"`
+
+	// The limits below are applied to each client identified by its API key if the key is
+	// configured in any rule, or by its IP otherwise. A zero value disables the limit.
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	Burst             int     `json:"burst,omitempty"`            // defaults to RequestsPerSecond rounded up
+	MaxConcurrent     int     `json:"maxConcurrent,omitempty"`    // maximum number of the running requests
+	MaxExecutionTime  string  `json:"maxExecutionTime,omitempty"` // duration like "5s"
+	MaxBatchSize      int     `json:"maxBatchSize,omitempty"`     // maximum number of the requests in a batch
+	MaxResponseSize   int     `json:"maxResponseSize,omitempty"`  // maximum size of a result in bytes
+}
+
+// LimitConfig is the access control and the limits of the RPC methods.
+// A request is restricted by the first rule matching its method and its client.
+type LimitConfig struct {
+	APIKeyHeader string      `json:"apiKeyHeader,omitempty"`
+	Rules        []LimitRule `json:"rules"`
+}
+
+// LoadLimitConfig reads the LimitConfig from a JSON file.
+func LoadLimitConfig(path string) (*LimitConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := new(LimitConfig)
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("invalid rpc limit file %s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// SetLimits applies the access control and the limits to all RPC servers except the in-process ones.
+// A nil config removes the limits.
+func SetLimits(cfg *LimitConfig) error {
+	var l *limiter
+	if cfg != nil {
+		var err error
+		if l, err = newLimiter(cfg); err != nil {
+			return err
+		}
+	}
+	accessLimiterMu.Lock()
+	accessLimiter = l
+	accessLimiterMu.Unlock()
+	return nil
+}
+
+func getLimiter() *limiter {
+	accessLimiterMu.RLock()
+	l := accessLimiter
+	accessLimiterMu.RUnlock()
+	return l
+}
+
+// APIKeyHeader returns the HTTP header carrying the API key of the RPC clients.
+func APIKeyHeader() string {
+	if l := getLimiter(); l != nil {
+		return l.apiKeyHeader
+	}
+	return DefaultAPIKeyHeader
+}
+
+type clientInfoKey struct{}
+
+// clientInfo identifies the client of the requests to apply the limits.
+type clientInfo struct {
+	ip     string
+	apiKey string
+}
+
+// WithClientInfo returns a context carrying the remote address and the API key of an RPC client.
+// The limits are applied only to the requests served with the client info.
+func WithClientInfo(ctx context.Context, remoteAddr, apiKey string) context.Context {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+	return context.WithValue(ctx, clientInfoKey{}, &clientInfo{ip: ip, apiKey: apiKey})
+}
+
+func clientInfoFromContext(ctx context.Context) (*clientInfo, bool) {
+	c, ok := ctx.Value(clientInfoKey{}).(*clientInfo)
+	return c, ok
+}
+
+type appliedLimitKey struct{}
+
+// appliedLimit is the rule applied to a request acquired by AcquireLimit.
+type appliedLimit struct {
+	method string
+	rule   *limitRule
+}
+
+// AcquireLimit checks the access control, the rate limit and the concurrency limit of the method
+// for the client of the context. It is used by the services serving the RPC methods on behalf of
+// their clients without the RPC server.
+//
+// The returned context is bounded by the max execution time of the method, and should be used to
+// serve the request. The returned function should be called when the request is done.
+func AcquireLimit(ctx context.Context, method string) (context.Context, func(), error) {
+	rule, release, err := acquireLimit(ctx, method, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rule == nil {
+		return ctx, func() {}, nil
+	}
+	ctx = context.WithValue(ctx, appliedLimitKey{}, &appliedLimit{method: method, rule: rule})
+	if rule.maxExecutionTime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, rule.maxExecutionTime)
+		return ctx, func() { cancel(); release() }, nil
+	}
+	return ctx, release, nil
+}
+
+// CheckLimit returns an error if the request of the context acquired by AcquireLimit has exceeded
+// the max execution time, or if the size of its encoded response exceeds the max response size.
+func CheckLimit(ctx context.Context, responseSize int) error {
+	applied, ok := ctx.Value(appliedLimitKey{}).(*appliedLimit)
+	if !ok {
+		return nil
+	}
+	if err := applied.rule.checkExecutionTime(ctx, applied.method); err != nil {
+		return err
+	}
+	return applied.rule.checkResponseSize(applied.method, responseSize)
+}
+
+// acquireLimit returns the rule applied to the request and the function releasing the request.
+// It returns a nil rule if no rule is applied.
+func acquireLimit(ctx context.Context, method string, batchSize int) (*limitRule, func(), Error) {
+	l := getLimiter()
+	if l == nil {
+		return nil, nil, nil
+	}
+	client, ok := clientInfoFromContext(ctx)
+	if !ok {
+		return nil, nil, nil
+	}
+	return l.acquire(method, client, batchSize, time.Now())
+}
+
+// limitRule is the parsed LimitRule.
+type limitRule struct {
+	methods map[string]bool // method names, namespaces ending with "_*" or "*"
+	nets    []*net.IPNet
+	apiKeys map[string]bool
+	deny    bool
+
+	rate             float64
+	burst            float64
+	maxConcurrent    int
+	maxExecutionTime time.Duration
+	maxBatchSize     int
+	maxResponseSize  int
+}
+
+func newLimitRule(r *LimitRule) (*limitRule, error) {
+	if len(r.Methods) == 0 {
+		return nil, errors.New("no methods in the rpc limit rule")
+	}
+	if r.RequestsPerSecond < 0 || r.Burst < 0 || r.MaxConcurrent < 0 || r.MaxBatchSize < 0 || r.MaxResponseSize < 0 {
+		return nil, errors.New("negative limit in the rpc limit rule")
+	}
+	rule := &limitRule{
+		methods:         make(map[string]bool, len(r.Methods)),
+		deny:            r.Deny,
+		rate:            r.RequestsPerSecond,
+		burst:           float64(r.Burst),
+		maxConcurrent:   r.MaxConcurrent,
+		maxBatchSize:    r.MaxBatchSize,
+		maxResponseSize: r.MaxResponseSize,
+	}
+	for _, m := range r.Methods {
+		rule.methods[m] = true
+	}
+	for _, ip := range r.IPs {
+		if !strings.Contains(ip, "/") {
+			if strings.Contains(ip, ":") {
+				ip += "/128"
+			} else {
+				ip += "/32"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(ip)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip in the rpc limit rule: %v", err)
+		}
+		rule.nets = append(rule.nets, ipNet)
+	}
+	if len(r.APIKeys) > 0 {
+		rule.apiKeys = make(map[string]bool, len(r.APIKeys))
+		for _, key := range r.APIKeys {
+			rule.apiKeys[key] = true
+		}
+	}
+	if r.MaxExecutionTime != "" {
+		d, err := time.ParseDuration(r.MaxExecutionTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max execution time in the rpc limit rule: %v", err)
+		}
+		rule.maxExecutionTime = d
+	}
+	if rule.rate > 0 && rule.burst == 0 {
+		rule.burst = float64(int(rule.rate + 0.999999))
+	}
+	return rule, nil
+}
+
+// checkExecutionTime returns an error if the context of the request bounded by the max execution time is expired.
+func (r *limitRule) checkExecutionTime(ctx context.Context, method string) Error {
+	if r.maxExecutionTime > 0 && ctx.Err() == context.DeadlineExceeded {
+		rpcLimitedRequestsCounter.Inc(1)
+		return &limitExceededError{fmt.Sprintf("execution time of %s exceeds %v", method, r.maxExecutionTime)}
+	}
+	return nil
+}
+
+// checkResponseSize returns an error if the size of the encoded response exceeds the max response size.
+func (r *limitRule) checkResponseSize(method string, size int) Error {
+	if r.maxResponseSize > 0 && size > r.maxResponseSize {
+		rpcLimitedRequestsCounter.Inc(1)
+		return &limitExceededError{fmt.Sprintf("response size of %s exceeds %d bytes", method, r.maxResponseSize)}
+	}
+	return nil
+}
+
+// matchMethod returns whether the rule applies to the method like "klay_call".
+func (r *limitRule) matchMethod(method string) bool {
+	if r.methods["*"] || r.methods[method] {
+		return true
+	}
+	if i := strings.Index(method, serviceMethodSeparator); i >= 0 {
+		return r.methods[method[:i]+serviceMethodSeparator+"*"]
+	}
+	return false
+}
+
+// matchClient returns whether the rule applies to the client.
+func (r *limitRule) matchClient(c *clientInfo) bool {
+	if len(r.nets) == 0 && len(r.apiKeys) == 0 {
+		return true
+	}
+	if c.apiKey != "" && r.apiKeys[c.apiKey] {
+		return true
+	}
+	if ip := net.ParseIP(c.ip); ip != nil {
+		for _, n := range r.nets {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// limitKey identifies the state of a client for a rule.
+type limitKey struct {
+	rule   int
+	client string
+}
+
+// limitState is the token bucket and the number of the running requests of a client for a rule.
+type limitState struct {
+	tokens  float64
+	last    time.Time
+	running int
+}
+
+// limiter applies the access control and the limits of the RPC methods.
+type limiter struct {
+	apiKeyHeader string
+	rules        []*limitRule
+	apiKeys      map[string]bool // API keys configured in the rules
+
+	mu        sync.Mutex
+	states    map[limitKey]*limitState
+	lastPrune time.Time
+}
+
+func newLimiter(cfg *LimitConfig) (*limiter, error) {
+	l := &limiter{
+		apiKeyHeader: cfg.APIKeyHeader,
+		states:       make(map[limitKey]*limitState),
+		apiKeys:      make(map[string]bool),
+	}
+	if l.apiKeyHeader == "" {
+		l.apiKeyHeader = DefaultAPIKeyHeader
+	}
+	for i := range cfg.Rules {
+		rule, err := newLimitRule(&cfg.Rules[i])
+		if err != nil {
+			return nil, err
+		}
+		l.rules = append(l.rules, rule)
+		for key := range rule.apiKeys {
+			l.apiKeys[key] = true
+		}
+	}
+	return l, nil
+}
+
+// clientKey returns the key of the client by which the limits are counted. A client is identified
+// by its API key only if the key is configured, since a client can send any key to get a new bucket.
+func (l *limiter) clientKey(c *clientInfo) string {
+	if c.apiKey != "" && l.apiKeys[c.apiKey] {
+		return "key:" + c.apiKey
+	}
+	return "ip:" + c.ip
+}
+
+// acquire finds the first rule matching the method and the client, and checks its limits.
+func (l *limiter) acquire(method string, client *clientInfo, batchSize int, now time.Time) (*limitRule, func(), Error) {
+	idx := -1
+	for i, rule := range l.rules {
+		if rule.matchMethod(method) && rule.matchClient(client) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, nil, nil
+	}
+	rule := l.rules[idx]
+
+	if rule.deny {
+		rpcDeniedRequestsCounter.Inc(1)
+		return nil, nil, &accessDeniedError{method}
+	}
+	if rule.maxBatchSize > 0 && batchSize > rule.maxBatchSize {
+		rpcLimitedRequestsCounter.Inc(1)
+		return nil, nil, &limitExceededError{fmt.Sprintf("%s is allowed in a batch of at most %d requests", method, rule.maxBatchSize)}
+	}
+	if rule.rate == 0 && rule.maxConcurrent == 0 {
+		return rule, func() {}, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastPrune) > limitPruneInterval {
+		l.prune(now)
+	}
+	key := limitKey{rule: idx, client: l.clientKey(client)}
+	state, ok := l.states[key]
+	if !ok {
+		if len(l.states) >= maxLimitClients && now.Sub(l.lastPrune) > limitFullPruneInterval {
+			l.prune(now)
+		}
+		if len(l.states) >= maxLimitClients {
+			rpcLimitedRequestsCounter.Inc(1)
+			return nil, nil, &limitExceededError{fmt.Sprintf("too many clients of %s", method)}
+		}
+		state = &limitState{tokens: rule.burst, last: now}
+		l.states[key] = state
+	}
+	if rule.rate > 0 {
+		l.refill(rule, state, now)
+		if state.tokens < 1 {
+			rpcLimitedRequestsCounter.Inc(1)
+			return nil, nil, &limitExceededError{fmt.Sprintf("too many requests of %s", method)}
+		}
+	}
+	if rule.maxConcurrent > 0 && state.running >= rule.maxConcurrent {
+		rpcLimitedRequestsCounter.Inc(1)
+		return nil, nil, &limitExceededError{fmt.Sprintf("too many concurrent requests of %s", method)}
+	}
+	if rule.rate > 0 {
+		state.tokens--
+	}
+	state.running++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			l.mu.Lock()
+			state.running--
+			l.mu.Unlock()
+		})
+	}
+	return rule, release, nil
+}
+
+// refill adds the tokens generated since the last update to the bucket.
+func (l *limiter) refill(rule *limitRule, state *limitState, now time.Time) {
+	if elapsed := now.Sub(state.last); elapsed > 0 {
+		state.tokens += elapsed.Seconds() * rule.rate
+		if state.tokens > rule.burst {
+			state.tokens = rule.burst
+		}
+		state.last = now
+	}
+}
+
+// prune removes the states of the idle clients, which are the same as the new ones.
+// It should be called with mu locked.
+func (l *limiter) prune(now time.Time) {
+	for key, state := range l.states {
+		if state.running > 0 {
+			continue
+		}
+		rule := l.rules[key.rule]
+		l.refill(rule, state, now)
+		if state.tokens >= rule.burst {
+			delete(l.states, key)
+		}
+	}
+	l.lastPrune = now
+}
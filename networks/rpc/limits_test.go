@@ -0,0 +1,217 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitRuleMatch(t *testing.T) {
+	rule, err := newLimitRule(&LimitRule{
+		Methods: []string{"klay_call", "debug_*"},
+		IPs:     []string{"10.0.0.0/8", "192.168.0.1"},
+		APIKeys: []string{"secret"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, rule.matchMethod("klay_call"))
+	assert.True(t, rule.matchMethod("debug_traceTransaction"))
+	assert.False(t, rule.matchMethod("klay_getLogs"))
+
+	assert.True(t, rule.matchClient(&clientInfo{ip: "10.1.2.3"}))
+	assert.True(t, rule.matchClient(&clientInfo{ip: "192.168.0.1"}))
+	assert.False(t, rule.matchClient(&clientInfo{ip: "192.168.0.2"}))
+	assert.True(t, rule.matchClient(&clientInfo{ip: "192.168.0.2", apiKey: "secret"}))
+	assert.False(t, rule.matchClient(&clientInfo{apiKey: "other"}))
+
+	_, err = newLimitRule(&LimitRule{Methods: []string{"*"}, IPs: []string{"invalid"}})
+	assert.Error(t, err)
+	_, err = newLimitRule(&LimitRule{Methods: []string{"*"}, MaxExecutionTime: "1 second"})
+	assert.Error(t, err)
+	_, err = newLimitRule(&LimitRule{})
+	assert.Error(t, err)
+}
+
+func TestLimiterAcquire(t *testing.T) {
+	l, err := newLimiter(&LimitConfig{Rules: []LimitRule{
+		{Methods: []string{"admin_*"}, Deny: true},
+		{Methods: []string{"klay_getLogs"}, RequestsPerSecond: 2, MaxBatchSize: 2},
+		{Methods: []string{"debug_*"}, MaxConcurrent: 1},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAPIKeyHeader, l.apiKeyHeader)
+
+	now := time.Now()
+	client := &clientInfo{ip: "127.0.0.1"}
+
+	// Denied methods
+	_, _, limitErr := l.acquire("admin_peers", client, 1, now)
+	assert.IsType(t, &accessDeniedError{}, limitErr)
+
+	// Methods without a matched rule
+	rule, _, limitErr := l.acquire("klay_blockNumber", client, 1, now)
+	assert.Nil(t, limitErr)
+	assert.Nil(t, rule)
+
+	// Rate limit and batch size limit per client
+	_, _, limitErr = l.acquire("klay_getLogs", client, 3, now)
+	assert.IsType(t, &limitExceededError{}, limitErr)
+	for i := 0; i < 2; i++ {
+		_, release, limitErr := l.acquire("klay_getLogs", client, 1, now)
+		require.Nil(t, limitErr)
+		release()
+	}
+	_, _, limitErr = l.acquire("klay_getLogs", client, 1, now)
+	assert.IsType(t, &limitExceededError{}, limitErr)
+	// An API key not configured in the rules does not give a new bucket
+	_, _, limitErr = l.acquire("klay_getLogs", &clientInfo{ip: "127.0.0.1", apiKey: "key"}, 1, now)
+	assert.IsType(t, &limitExceededError{}, limitErr)
+	_, release, limitErr := l.acquire("klay_getLogs", client, 1, now.Add(time.Second))
+	require.Nil(t, limitErr)
+	release()
+
+	// Concurrency limit
+	_, release, limitErr = l.acquire("debug_traceTransaction", client, 1, now)
+	require.Nil(t, limitErr)
+	_, _, limitErr = l.acquire("debug_traceBlock", client, 1, now)
+	assert.IsType(t, &limitExceededError{}, limitErr)
+	release()
+	release()
+	_, _, limitErr = l.acquire("debug_traceBlock", client, 1, now)
+	assert.Nil(t, limitErr)
+
+	// Idle clients are pruned, while the client running a request is kept
+	l.mu.Lock()
+	l.prune(now.Add(time.Hour))
+	l.mu.Unlock()
+	assert.Equal(t, 1, len(l.states))
+}
+
+func TestLimiterClientKey(t *testing.T) {
+	l, err := newLimiter(&LimitConfig{Rules: []LimitRule{
+		{Methods: []string{"*"}, APIKeys: []string{"secret"}, RequestsPerSecond: 1},
+	}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "key:secret", l.clientKey(&clientInfo{ip: "127.0.0.1", apiKey: "secret"}))
+	assert.Equal(t, "ip:127.0.0.1", l.clientKey(&clientInfo{ip: "127.0.0.1", apiKey: "other"}))
+	assert.Equal(t, "ip:127.0.0.1", l.clientKey(&clientInfo{ip: "127.0.0.1"}))
+}
+
+func TestLimiterFull(t *testing.T) {
+	l, err := newLimiter(&LimitConfig{Rules: []LimitRule{
+		{Methods: []string{"*"}, RequestsPerSecond: 1},
+	}})
+	require.NoError(t, err)
+
+	now := time.Now()
+	l.lastPrune = now
+	for i := 0; i < maxLimitClients; i++ {
+		l.states[limitKey{client: fmt.Sprintf("ip:%d", i)}] = &limitState{tokens: 0, last: now}
+	}
+
+	// A new client is rejected if no client can be pruned
+	_, _, limitErr := l.acquire("klay_call", &clientInfo{ip: "127.0.0.1"}, 1, now.Add(limitFullPruneInterval/2))
+	assert.IsType(t, &limitExceededError{}, limitErr)
+
+	// The idle clients are pruned to accept a new client
+	_, release, limitErr := l.acquire("klay_call", &clientInfo{ip: "127.0.0.1"}, 1, now.Add(limitFullPruneInterval+time.Second))
+	require.Nil(t, limitErr)
+	release()
+	assert.Equal(t, 1, len(l.states))
+}
+
+func TestAcquireLimit(t *testing.T) {
+	require.NoError(t, SetLimits(&LimitConfig{Rules: []LimitRule{
+		{Methods: []string{"klay_call"}, MaxExecutionTime: "10ms", MaxResponseSize: 10},
+	}}))
+	defer SetLimits(nil)
+
+	// The requests without the client info are not limited
+	ctx, release, err := AcquireLimit(context.Background(), "klay_call")
+	require.NoError(t, err)
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+	assert.NoError(t, CheckLimit(ctx, 100))
+	release()
+
+	ctx, release, err = AcquireLimit(WithClientInfo(context.Background(), "127.0.0.1:1234", ""), "klay_call")
+	require.NoError(t, err)
+	defer release()
+	assert.NoError(t, CheckLimit(ctx, 10))
+	assert.Error(t, CheckLimit(ctx, 11))
+
+	<-ctx.Done()
+	err = CheckLimit(ctx, 0)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "execution time"))
+}
+
+func TestServerLimits(t *testing.T) {
+	require.NoError(t, SetLimits(&LimitConfig{
+		APIKeyHeader: "X-Test-Key",
+		Rules: []LimitRule{
+			{Methods: []string{"limited_echo"}, APIKeys: []string{"secret"}},
+			{Methods: []string{"limited_echo"}, Deny: true},
+			{Methods: []string{"limited_sleep"}, MaxExecutionTime: "10ms"},
+			{Methods: []string{"limited_rets"}, MaxResponseSize: 1},
+			{Methods: []string{"limited_echoWithCtx"}, MaxResponseSize: 1000},
+		},
+	}))
+	defer SetLimits(nil)
+	assert.Equal(t, "X-Test-Key", APIKeyHeader())
+
+	server := newTestServer("limited", new(Service))
+	defer server.Stop()
+
+	client, hs := httpTestClient(server, "http", nil)
+	defer hs.Close()
+	defer client.Close()
+
+	var result Result
+	err := client.Call(&result, "limited_echo", "hello", 10, &Args{"world"})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not allowed"))
+
+	client.SetHeader("X-Test-Key", "secret")
+	assert.NoError(t, client.Call(&result, "limited_echo", "hello", 10, &Args{"world"}))
+
+	err = client.Call(nil, "limited_sleep", time.Second)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "execution time"))
+
+	var rets string
+	err = client.Call(&rets, "limited_rets")
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "response size"))
+
+	result = Result{}
+	require.NoError(t, client.Call(&result, "limited_echoWithCtx", "hello", 10, &Args{"world"}))
+	assert.Equal(t, Result{"hello", 10, &Args{"world"}}, result)
+
+	// The in-process clients are not limited
+	inproc := DialInProc(server)
+	defer inproc.Close()
+	assert.NoError(t, inproc.CallContext(context.Background(), &result, "limited_echo", "hello", 10, &Args{"world"}))
+}
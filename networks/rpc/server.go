@@ -22,6 +22,7 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"runtime"
@@ -292,8 +293,13 @@ func (s *Server) serveRequest(ctx context.Context, codec ServerCodec, singleShot
 // response back using the given codec. It will block until the codec is closed or the server is
 // stopped. In either case the codec is closed.
 func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
+	s.serveCodec(context.Background(), codec, options)
+}
+
+// serveCodec is the same as ServeCodec except that the requests are served with the given context.
+func (s *Server) serveCodec(ctx context.Context, codec ServerCodec, options CodecOption) {
 	defer codec.Close()
-	s.serveRequest(context.Background(), codec, false, options)
+	s.serveRequest(ctx, codec, false, options)
 }
 
 // ServeSingleRequest reads and processes a single RPC request from the given codec. It will not
@@ -336,8 +342,8 @@ var (
 	callSendTx = 0
 )
 
-// handle executes a request and returns the response from the callback.
-func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverRequest, subCnt *int32) (interface{}, func()) {
+// handle executes a request in a batch of the given size and returns the response from the callback.
+func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverRequest, batchSize int, subCnt *int32) (interface{}, func()) {
 	method := ""
 	if req.callb != nil {
 		method = fmt.Sprintf("%s%s%s", req.svcname, serviceMethodSeparator, req.callb.method.Name)
@@ -372,6 +378,28 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 		return codec.CreateErrorResponse(&req.id, &invalidParamsError{"Expected subscription id as first argument"}), nil
 	}
 
+	// check the namespaces allowed by the authentication token
+	name := req.svcname + serviceMethodSeparator + formatName(req.callb.method.Name)
+	if !authorizedNamespace(ctx, req.svcname) {
+		rpcErrorResponsesCounter.Inc(1)
+		rpcDeniedRequestsCounter.Inc(1)
+		return codec.CreateErrorResponse(&req.id, &accessDeniedError{name}), nil
+	}
+
+	// check the access control and the limits of the method for the client
+	rule, release, limitErr := acquireLimit(ctx, name, batchSize)
+	if limitErr != nil {
+		rpcErrorResponsesCounter.Inc(1)
+		return codec.CreateErrorResponse(&req.id, limitErr), nil
+	}
+	if release != nil {
+		defer func() {
+			if release != nil {
+				release()
+			}
+		}()
+	}
+
 	if req.callb.isSubscribe {
 		if atomic.LoadInt32(subCnt) >= MaxSubscriptionPerWSConn {
 			return codec.CreateErrorResponse(&req.id, &callbackError{
@@ -406,6 +434,12 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 		return codec.CreateErrorResponse(&req.id, rpcErr), nil
 	}
 
+	if rule != nil && rule.maxExecutionTime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, rule.maxExecutionTime)
+		defer cancel()
+	}
+
 	arguments := []reflect.Value{req.callb.rcvr}
 	if req.callb.hasCtx {
 		arguments = append(arguments, reflect.ValueOf(ctx))
@@ -423,7 +457,45 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 	//logger.Error("### rpc.server", "#tx", callSendTx, "#receipt", callCount)
 
 	// execute RPC method and return result
-	reply := req.callb.method.Func.Call(arguments)
+	var reply []reflect.Value
+	if rule != nil && rule.maxExecutionTime > 0 {
+		// The method is cancelled by its context when the max execution time is exceeded, and the
+		// error is returned without waiting for it. The request is released when the method returns.
+		done := make(chan []reflect.Value, 1)
+		go func() {
+			defer func() {
+				if err := recover(); err != nil {
+					const size = 64 << 10
+					buf := make([]byte, size)
+					buf = buf[:runtime.Stack(buf, false)]
+					logger.Error(string(buf))
+					done <- nil
+				}
+			}()
+			done <- req.callb.method.Func.Call(arguments)
+		}()
+		select {
+		case reply = <-done:
+		case <-ctx.Done():
+			if release != nil {
+				go func(release func()) {
+					<-done
+					release()
+				}(release)
+				release = nil
+			}
+		}
+		if err := rule.checkExecutionTime(ctx, name); err != nil {
+			rpcErrorResponsesCounter.Inc(1)
+			return codec.CreateErrorResponse(&req.id, err), nil
+		}
+		if reply == nil {
+			rpcErrorResponsesCounter.Inc(1)
+			return codec.CreateErrorResponse(&req.id, &callbackError{fmt.Sprintf("%s is aborted", name)}), nil
+		}
+	} else {
+		reply = req.callb.method.Func.Call(arguments)
+	}
 	if len(reply) == 0 {
 		rpcSuccessResponsesCounter.Inc(1)
 		return codec.CreateResponse(req.id, nil), nil
@@ -438,6 +510,21 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 		}
 	}
 
+	if rule != nil && rule.maxResponseSize > 0 {
+		// The result is encoded once here, and the encoded result is written as it is.
+		encoded, err := json.Marshal(reply[0].Interface())
+		if err != nil {
+			rpcErrorResponsesCounter.Inc(1)
+			return codec.CreateErrorResponse(&req.id, &callbackError{err.Error()}), nil
+		}
+		if err := rule.checkResponseSize(name, len(encoded)); err != nil {
+			rpcErrorResponsesCounter.Inc(1)
+			return codec.CreateErrorResponse(&req.id, err), nil
+		}
+		rpcSuccessResponsesCounter.Inc(1)
+		return codec.CreateResponse(req.id, json.RawMessage(encoded)), nil
+	}
+
 	rpcSuccessResponsesCounter.Inc(1)
 	return codec.CreateResponse(req.id, reply[0].Interface()), nil
 }
@@ -450,7 +537,7 @@ func (s *Server) exec(ctx context.Context, codec ServerCodec, req *serverRequest
 		rpcErrorResponsesCounter.Inc(1)
 		response = codec.CreateErrorResponse(&req.id, req.err)
 	} else {
-		response, callback = s.handle(ctx, codec, req, subCnt)
+		response, callback = s.handle(ctx, codec, req, 1, subCnt)
 	}
 
 	if err := codec.Write(response); err != nil {
@@ -475,7 +562,7 @@ func (s *Server) execBatch(ctx context.Context, codec ServerCodec, requests []*s
 			responses[i] = codec.CreateErrorResponse(&req.id, req.err)
 		} else {
 			var callback func()
-			if responses[i], callback = s.handle(ctx, codec, req, subCnt); callback != nil {
+			if responses[i], callback = s.handle(ctx, codec, req, len(requests), subCnt); callback != nil {
 				callbacks = append(callbacks, callback)
 			}
 		}
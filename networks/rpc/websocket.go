@@ -92,7 +92,12 @@ func (srv *Server) WebsocketHandler(allowedOrigins []string) http.Handler {
 			decoder := func(v interface{}) error {
 				return websocketJSONCodec.Receive(conn, v)
 			}
-			srv.ServeCodec(NewCodec(conn, encoder, decoder), OptionMethodInvocation|OptionSubscriptions)
+			r := conn.Request()
+			ctx := WithClientInfo(context.Background(), r.RemoteAddr, r.Header.Get(APIKeyHeader()))
+			if allowed, ok := r.Context().Value(authNamespacesKey{}).(map[string]bool); ok {
+				ctx = context.WithValue(ctx, authNamespacesKey{}, allowed)
+			}
+			srv.serveCodec(ctx, NewCodec(conn, encoder, decoder), OptionMethodInvocation|OptionSubscriptions)
 		},
 	}
 }
@@ -109,6 +114,8 @@ func (srv *Server) FastWebsocketHandler(ctx *fasthttp.RequestCtx) {
 		ctx.Response.Header.Set("Sec-WebSocket-Protocol", string(protocol))
 	}
 
+	clientCtx := WithClientInfo(context.Background(), ctx.RemoteAddr().String(), string(ctx.Request.Header.Peek(APIKeyHeader())))
+
 	err := upgrader.Upgrade(ctx, func(conn *fastws.Conn) {
 		if atomic.LoadInt32(&srv.wsConnCount) >= MaxWebsocketConnections {
 			return
@@ -150,7 +157,7 @@ func (srv *Server) FastWebsocketHandler(ctx *fasthttp.RequestCtx) {
 		}
 
 		reader := bufio.NewReaderSize(bytes.NewReader(ctx.Request.Body()), common.MaxRequestContentLength)
-		srv.ServeCodec(NewCodec(&httpReadWriteNopCloser{reader, ctx.Response.BodyWriter()}, encoder, decoder), OptionMethodInvocation|OptionSubscriptions)
+		srv.serveCodec(clientCtx, NewCodec(&httpReadWriteNopCloser{reader, ctx.Response.BodyWriter()}, encoder, decoder), OptionMethodInvocation|OptionSubscriptions)
 	})
 	if err != nil {
 		logger.Error("FastWebsocketHandler fail to upgrade message", "err", err)
@@ -218,11 +218,8 @@ func (api *PrivateAdminAPI) StopSpamThrottler(ctx context.Context) error {
 	return nil
 }
 
+// StartSpamThrottler starts the spam throttler, or updates its config if it is already running.
 func (api *PrivateAdminAPI) StartSpamThrottler(ctx context.Context, config *blockchain.ThrottlerConfig) error {
-	throttler := blockchain.GetSpamThrottler()
-	if throttler != nil {
-		return errors.New("spam throttler is already running")
-	}
 	return api.cn.txPool.StartSpamThrottler(config)
 }
 
@@ -235,6 +235,13 @@ func (b *CNAPIBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscrip
 }
 
 func (b *CNAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction) error {
+	// Limit the txs of the RPC clients by the client IP and the sender
+	if throttler := blockchain.GetSpamThrottler(); throttler != nil {
+		signer := types.MakeSigner(b.ChainConfig(), b.CurrentBlock().Number())
+		if err := throttler.AdmitRPCTx(signer, rpc.RemoteIPFromContext(ctx), signedTx); err != nil {
+			return err
+		}
+	}
 	return b.cn.txPool.AddLocal(signedTx)
 }
 
@@ -266,6 +273,10 @@ func (b *CNAPIBackend) TxPoolContent() (map[common.Address]types.Transactions, m
 	return b.cn.TxPool().Content()
 }
 
+func (b *CNAPIBackend) TxPoolParked() map[common.Address]types.Transactions {
+	return b.cn.TxPool().Parked()
+}
+
 func (b *CNAPIBackend) SubscribeNewTxsEvent(ch chan<- blockchain.NewTxsEvent) event.Subscription {
 	return b.cn.TxPool().SubscribeNewTxsEvent(ch)
 }
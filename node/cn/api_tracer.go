@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"reflect"
 	"runtime"
@@ -43,6 +44,7 @@ import (
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/klaytn/klaytn/node/cn/tracers"
+	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
 	statedb2 "github.com/klaytn/klaytn/storage/statedb"
 )
@@ -70,6 +72,37 @@ type TraceConfig struct {
 	Reexec  *uint64
 }
 
+// TraceCallConfig holds extra parameters to trace a call. The state and the
+// block context the call is executed on can be overridden.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *klaytnapi.EthStateOverride
+	BlockOverrides *BlockOverrides
+}
+
+// BlockOverrides is a set of header fields to override when executing a call.
+type BlockOverrides struct {
+	Number  *hexutil.Big `json:"number"`
+	Time    *hexutil.Big `json:"timestamp"`
+	BaseFee *hexutil.Big `json:"baseFee"`
+}
+
+// Apply overrides the given EVM context with the specified fields.
+func (diff *BlockOverrides) Apply(vmctx *vm.Context) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		vmctx.BlockNumber = new(big.Int).Set(diff.Number.ToInt())
+	}
+	if diff.Time != nil {
+		vmctx.Time = new(big.Int).Set(diff.Time.ToInt())
+	}
+	if diff.BaseFee != nil {
+		vmctx.BaseFee = new(big.Int).Set(diff.BaseFee.ToInt())
+	}
+}
+
 // StdTraceConfig holds extra parameters to standard-json trace functions.
 type StdTraceConfig struct {
 	*vm.LogConfig
@@ -365,16 +398,8 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 // EVM and returns them as a JSON object.
 func (api *PrivateDebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) ([]*txTraceResult, error) {
 	// Fetch the block that we want to trace
-	var block *types.Block
+	block := api.blockByNumber(number)
 
-	switch number {
-	case rpc.PendingBlockNumber:
-		block = api.cn.miner.PendingBlock()
-	case rpc.LatestBlockNumber:
-		block = api.cn.blockchain.CurrentBlock()
-	default:
-		block = api.cn.blockchain.GetBlockByNumber(uint64(number))
-	}
 	// Trace the block if it was found
 	if block == nil {
 		return nil, fmt.Errorf("block #%d not found", number)
@@ -382,6 +407,19 @@ func (api *PrivateDebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.B
 	return api.traceBlock(ctx, block, config)
 }
 
+// blockByNumber returns the block of the given number, including the pending
+// and the latest block. It returns nil if the block is not found.
+func (api *PrivateDebugAPI) blockByNumber(number rpc.BlockNumber) *types.Block {
+	switch number {
+	case rpc.PendingBlockNumber:
+		return api.cn.miner.PendingBlock()
+	case rpc.LatestBlockNumber:
+		return api.cn.blockchain.CurrentBlock()
+	default:
+		return api.cn.blockchain.GetBlockByNumber(uint64(number))
+	}
+}
+
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (api *PrivateDebugAPI) TraceBlockByHash(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
@@ -752,6 +790,92 @@ func (api *PrivateDebugAPI) TraceTransaction(ctx context.Context, hash common.Ha
 	return api.traceTx(ctx, msg, vmctx, statedb, config)
 }
 
+// TraceCall lets you trace a given call on top of the state of the given block.
+// The state and the block context can be overridden by the config, so that an
+// unsigned call can be debugged without submitting a transaction.
+func (api *PrivateDebugAPI) TraceCall(ctx context.Context, args klaytnapi.CallArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
+	// Try to retrieve the specified block and its state
+	var (
+		block   *types.Block
+		statedb *state.StateDB
+		release = func() {}
+		err     error
+	)
+	if number, ok := blockNrOrHash.Number(); ok && number == rpc.PendingBlockNumber {
+		block, statedb = api.cn.miner.Pending()
+		if block == nil || statedb == nil {
+			return nil, errors.New("pending block is not available")
+		}
+	} else {
+		if hash, ok := blockNrOrHash.Hash(); ok {
+			block = api.cn.blockchain.GetBlockByHash(hash)
+		} else if number, ok := blockNrOrHash.Number(); ok {
+			block = api.blockByNumber(number)
+		}
+		if block == nil {
+			blockNrOrHashString, _ := blockNrOrHash.NumberOrHashString()
+			return nil, fmt.Errorf("block %v not found", blockNrOrHashString)
+		}
+		reexec := defaultTraceReexec
+		if config != nil && config.Reexec != nil {
+			reexec = *config.Reexec
+		}
+		if statedb, release, err = api.stateAt(block, reexec); err != nil {
+			return nil, err
+		}
+	}
+	defer release()
+
+	var traceConfig *TraceConfig
+	if config != nil {
+		if err := config.StateOverrides.Apply(statedb); err != nil {
+			return nil, err
+		}
+		traceConfig = &config.TraceConfig
+	}
+
+	// The block number deciding the rules and the base fee used to set the
+	// default gas price should also be overridden
+	header := block.Header()
+	number := header.Number
+	baseFee := new(big.Int).SetUint64(params.ZeroBaseFee)
+	if header.BaseFee != nil {
+		baseFee = header.BaseFee
+	}
+	if config != nil && config.BlockOverrides != nil {
+		if config.BlockOverrides.Number != nil {
+			number = config.BlockOverrides.Number.ToInt()
+		}
+		if config.BlockOverrides.BaseFee != nil {
+			baseFee = config.BlockOverrides.BaseFee.ToInt()
+		}
+	}
+	data := args.Input
+	if data == nil {
+		data = args.Data
+	}
+	intrinsicGas, err := types.IntrinsicGas(data, nil, args.To == nil, api.config.Rules(number))
+	if err != nil {
+		return nil, err
+	}
+	gasCap := uint64(0)
+	if rpcGasCap := api.cn.APIBackend.RPCGasCap(); rpcGasCap != nil {
+		gasCap = rpcGasCap.Uint64()
+	}
+	msg, err := args.ToMessage(gasCap, baseFee, intrinsicGas)
+	if err != nil {
+		return nil, err
+	}
+	// Add gas fee to sender for tracing a call by an insufficient balance sender, as klay_call does.
+	statedb.AddBalance(msg.ValidatedSender(), new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice()))
+
+	vmctx := blockchain.NewEVMContext(msg, header, api.cn.blockchain, nil)
+	if config != nil {
+		config.BlockOverrides.Apply(&vmctx)
+	}
+	return api.traceTx(ctx, msg, vmctx, statedb, traceConfig)
+}
+
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -773,6 +897,8 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message blockchain.Mess
 
 		if *config.Tracer == fastCallTracer {
 			tracer = vm.NewInternalTxTracer()
+		} else if nativeTracer, ok := tracers.NewNativeTracer(*config.Tracer); ok {
+			tracer = nativeTracer
 		} else {
 			// Constuct the JavaScript tracer to execute with
 			if tracer, err = tracers.New(*config.Tracer); err != nil {
@@ -788,6 +914,8 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message blockchain.Mess
 				t.Stop(errors.New("execution timeout"))
 			case *vm.InternalTxTracer:
 				t.Stop(errors.New("execution timeout"))
+			case tracers.NativeTracer:
+				t.Stop(errors.New("execution timeout"))
 			default:
 				logger.Warn("unknown tracer type", "type", reflect.TypeOf(t).String())
 			}
@@ -821,6 +949,8 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message blockchain.Mess
 		return tracer.GetResult()
 	case *vm.InternalTxTracer:
 		return tracer.GetResult()
+	case tracers.NativeTracer:
+		return tracer.GetResult()
 
 	default:
 		panic(fmt.Sprintf("bad tracer type %T", tracer))
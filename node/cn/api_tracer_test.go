@@ -19,17 +19,25 @@ package cn
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	klaytnapi "github.com/klaytn/klaytn/api"
 	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
 	mocks2 "github.com/klaytn/klaytn/consensus/mocks"
 	"github.com/klaytn/klaytn/networks/rpc"
 	mocks3 "github.com/klaytn/klaytn/node/cn/mocks"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
 	"github.com/klaytn/klaytn/work/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createCNMocks(t *testing.T) (*gomock.Controller, *PrivateDebugAPI, *mocks2.MockEngine, *mocks.MockBlockChain, *mocks3.MockMiner) {
@@ -231,3 +239,125 @@ func TestPrivateDebugAPI_computeTxEnv(t *testing.T) {
 		mockCtrl.Finish()
 	}
 }
+
+func TestPrivateDebugAPI_TraceCall(t *testing.T) {
+	blockNumber := rpc.BlockNumber(123)
+	{
+		mockCtrl, api, _, _, mockMiner := createCNMocks(t)
+		mockMiner.EXPECT().Pending().Return(nil, nil).Times(1)
+		result, err := api.TraceCall(context.Background(), klaytnapi.CallArgs{}, rpc.NewBlockNumberOrHashWithNumber(rpc.PendingBlockNumber), nil)
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		mockCtrl.Finish()
+	}
+	{
+		mockCtrl, api, _, mockBlockChain, _ := createCNMocks(t)
+		mockBlockChain.EXPECT().GetBlockByNumber(uint64(blockNumber)).Return(nil).Times(1)
+		result, err := api.TraceCall(context.Background(), klaytnapi.CallArgs{}, rpc.NewBlockNumberOrHashWithNumber(blockNumber), nil)
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		mockCtrl.Finish()
+	}
+	{
+		mockCtrl, api, _, mockBlockChain, _ := createCNMocks(t)
+		mockBlockChain.EXPECT().GetBlockByHash(hashes[0]).Return(nil).Times(1)
+		result, err := api.TraceCall(context.Background(), klaytnapi.CallArgs{}, rpc.NewBlockNumberOrHashWithHash(hashes[0], false), nil)
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		mockCtrl.Finish()
+	}
+}
+
+// TestPrivateDebugAPI_TraceCall_overrides checks that the state and the block
+// overrides change the result of the traced call.
+func TestPrivateDebugAPI_TraceCall_overrides(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x2eaad2bf70a070aaa2e007beee99c6148f47718e")
+		contract = common.HexToAddress("0x9712f943b296758aaae79944ec975884188d3a96")
+
+		// The code returns NUMBER, TIMESTAMP, BASEFEE, SLOAD(0) and BALANCE(ADDRESS).
+		code = hexutil.Bytes(common.FromHex("0x436000524260205248604052600054606052303160805260a06000f3"))
+	)
+	config := &params.ChainConfig{
+		ChainID:                  big.NewInt(1),
+		IstanbulCompatibleBlock:  big.NewInt(0),
+		LondonCompatibleBlock:    big.NewInt(0),
+		EthTxTypeCompatibleBlock: big.NewInt(0),
+		CancunCompatibleBlock:    big.NewInt(100),
+		UnitPrice:                1,
+	}
+	header := &types.Header{Number: big.NewInt(5), Time: big.NewInt(10), BlockScore: big.NewInt(1)}
+	block := types.NewBlockWithHeader(header)
+
+	traceCall := func(args klaytnapi.CallArgs, overrides *TraceCallConfig) *klaytnapi.ExecutionResult {
+		mockCtrl, mockEngine, mockBlockChain, _ := newMocks(t)
+		defer mockCtrl.Finish()
+		mockMiner := mocks3.NewMockMiner(mockCtrl)
+		cn := &CN{config: &Config{}, miner: mockMiner, blockchain: mockBlockChain, engine: mockEngine}
+		cn.APIBackend = &CNAPIBackend{cn: cn}
+		api := NewPrivateDebugAPI(config, cn)
+
+		statedb, err := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil)
+		require.NoError(t, err)
+		mockMiner.EXPECT().Pending().Return(block, statedb)
+		mockBlockChain.EXPECT().Engine().Return(mockEngine).AnyTimes()
+		mockEngine.EXPECT().Author(gomock.Any()).Return(common.Address{}, nil).AnyTimes()
+
+		result, err := api.TraceCall(context.Background(), args, rpc.NewBlockNumberOrHashWithNumber(rpc.PendingBlockNumber), overrides)
+		require.NoError(t, err)
+		return result.(*klaytnapi.ExecutionResult)
+	}
+	word := func(n int64) string { return fmt.Sprintf("%064x", n) }
+
+	// Without the overrides, the contract does not exist
+	call := klaytnapi.CallArgs{From: from, To: &contract, Gas: hexutil.Uint64(100000)}
+	result := traceCall(call, nil)
+	assert.False(t, result.Failed)
+	assert.Equal(t, "", result.ReturnValue)
+
+	// The state overrides set the code, the storage and the balance of the contract
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	storage := map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(42))}
+	stateOverrides := &klaytnapi.EthStateOverride{
+		contract: klaytnapi.EthOverrideAccount{Code: &code, Balance: &balance, State: &storage},
+	}
+	result = traceCall(call, &TraceCallConfig{StateOverrides: stateOverrides})
+	assert.False(t, result.Failed)
+	assert.Equal(t, word(5)+word(10)+word(0)+word(42)+word(1000), result.ReturnValue)
+
+	// The block overrides change the number, the timestamp and the base fee seen by the call
+	blockOverrides := &BlockOverrides{
+		Number:  (*hexutil.Big)(big.NewInt(200)),
+		Time:    (*hexutil.Big)(big.NewInt(300)),
+		BaseFee: (*hexutil.Big)(big.NewInt(25)),
+	}
+	result = traceCall(call, &TraceCallConfig{StateOverrides: stateOverrides, BlockOverrides: blockOverrides})
+	assert.False(t, result.Failed)
+	assert.Equal(t, word(200)+word(300)+word(25)+word(42)+word(1000), result.ReturnValue)
+
+	// The intrinsic gas of a contract creation follows the rules of the overridden block number
+	create := klaytnapi.CallArgs{From: from, Gas: hexutil.Uint64(100000), Data: hexutil.Bytes{0x00}}
+	beforeCancun := traceCall(create, nil)
+	afterCancun := traceCall(create, &TraceCallConfig{BlockOverrides: &BlockOverrides{Number: (*hexutil.Big)(big.NewInt(100))}})
+	assert.False(t, beforeCancun.Failed)
+	assert.False(t, afterCancun.Failed)
+	assert.Equal(t, beforeCancun.Gas+params.InitCodeWordGas, afterCancun.Gas)
+}
+
+func TestBlockOverrides_Apply(t *testing.T) {
+	vmctx := vm.Context{BlockNumber: big.NewInt(1), Time: big.NewInt(2), BaseFee: big.NewInt(3)}
+
+	// nil overrides do not change anything
+	var nilOverrides *BlockOverrides
+	nilOverrides.Apply(&vmctx)
+	assert.Equal(t, big.NewInt(1), vmctx.BlockNumber)
+
+	overrides := &BlockOverrides{
+		Number:  (*hexutil.Big)(big.NewInt(10)),
+		BaseFee: (*hexutil.Big)(big.NewInt(30)),
+	}
+	overrides.Apply(&vmctx)
+	assert.Equal(t, big.NewInt(10), vmctx.BlockNumber)
+	assert.Equal(t, big.NewInt(2), vmctx.Time)
+	assert.Equal(t, big.NewInt(30), vmctx.BaseFee)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/bloombits"
 	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/state/pruner"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
@@ -197,91 +198,137 @@ func setEngineType(chainConfig *params.ChainConfig) {
 	}
 }
 
-// New creates a new CN object (including the
-// initialisation of the common CN object)
-func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
-	if err := checkSyncMode(config); err != nil {
-		return nil, err
-	}
+// cnChain is the blockchain of the CN service with the components it is built with.
+type cnChain struct {
+	chainDB     database.DBManager
+	chainConfig *params.ChainConfig
+	genesisHash common.Hash
+	compatErr   *params.ConfigCompatError // not nil if the stored chain configuration is incompatible
+	governance  *governance.MixedEngine
+	engine      consensus.Engine
+	blockchain  *blockchain.BlockChain
+}
 
+// setupChain opens the chain database and creates the blockchain with the
+// governance and the consensus engine. It is shared by New and NewChain so that
+// the offline commands handle the chain in the same way as the node.
+// The chain database is closed if it fails.
+func setupChain(ctx *node.ServiceContext, config *Config) (*cnChain, error) {
 	chainDB := CreateDB(ctx, config, "chaindata")
 
+	// Resume the state pruning if it was interrupted in the middle.
+	if err := pruner.RecoverPruning(ctx.ResolvePath(""), chainDB, config.TrieNodeCacheConfig.FastCacheFileDir); err != nil {
+		logger.Error("Failed to recover state", "error", err)
+	}
+
 	chainConfig, genesisHash, genesisErr := blockchain.SetupGenesisBlock(chainDB, config.Genesis, config.NetworkId, config.IsPrivate, false)
-	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
+	compatErr, ok := genesisErr.(*params.ConfigCompatError)
+	if genesisErr != nil && !ok {
+		chainDB.Close()
 		return nil, genesisErr
 	}
 
 	setEngineType(chainConfig)
 
 	// load governance state
-	governance := governance.NewMixedEngine(chainConfig, chainDB)
+	gov := governance.NewMixedEngine(chainConfig, chainDB)
 
 	// Set latest unitPrice/gasPrice
-	chainConfig.UnitPrice = governance.UnitPrice()
-	config.GasPrice = new(big.Int).SetUint64(chainConfig.UnitPrice)
+	chainConfig.UnitPrice = gov.UnitPrice()
 
 	chainConfig.Governance.KIP71 = &params.KIP71Config{
-		LowerBoundBaseFee:         governance.LowerBoundBaseFee(),
-		UpperBoundBaseFee:         governance.UpperBoundBaseFee(),
-		GasTarget:                 governance.GasTarget(),
-		MaxBlockGasUsedForBaseFee: governance.MaxBlockGasUsedForBaseFee(),
-		BaseFeeDenominator:        governance.BaseFeeDenominator(),
+		LowerBoundBaseFee:         gov.LowerBoundBaseFee(),
+		UpperBoundBaseFee:         gov.UpperBoundBaseFee(),
+		GasTarget:                 gov.GasTarget(),
+		MaxBlockGasUsedForBaseFee: gov.MaxBlockGasUsedForBaseFee(),
+		BaseFeeDenominator:        gov.BaseFeeDenominator(),
 	}
 	logger.Info("Initialised chain configuration", "config", chainConfig)
 
-	cn := &CN{
-		config:            config,
-		chainDB:           chainDB,
-		chainConfig:       chainConfig,
-		eventMux:          ctx.EventMux,
-		accountManager:    ctx.AccountManager,
-		engine:            CreateConsensusEngine(ctx, config, chainConfig, chainDB, governance, ctx.NodeType()),
-		networkId:         config.NetworkId,
-		gasPrice:          config.GasPrice,
-		rewardbase:        config.Rewardbase,
-		bloomRequests:     make(chan chan *bloombits.Retrieval),
-		bloomIndexer:      NewBloomIndexer(chainDB, params.BloomBitsBlocks),
-		closeBloomHandler: make(chan struct{}),
-		governance:        governance,
-	}
+	engine := CreateConsensusEngine(ctx, config, chainConfig, chainDB, gov, ctx.NodeType())
 
 	// istanbul BFT. Derive and set node's address using nodekey
-	if cn.chainConfig.Istanbul != nil {
-		governance.SetNodeAddress(crypto.PubkeyToAddress(ctx.NodeKey().PublicKey))
+	if chainConfig.Istanbul != nil {
+		gov.SetNodeAddress(crypto.PubkeyToAddress(ctx.NodeKey().PublicKey))
 	}
 
-	logger.Info("Initialising Klaytn protocol", "versions", cn.engine.Protocol().Versions, "network", config.NetworkId)
-
 	if !config.SkipBcVersionCheck {
 		if err := blockchain.CheckBlockChainVersion(chainDB); err != nil {
+			chainDB.Close()
 			return nil, err
 		}
 	}
-	var (
-		vmConfig    = config.getVMConfig()
-		cacheConfig = &blockchain.CacheConfig{
-			ArchiveMode: config.NoPruning, CacheSize: config.TrieCacheSize,
-			BlockInterval: config.TrieBlockInterval, TriesInMemory: config.TriesInMemory,
-			TrieNodeCacheConfig: &config.TrieNodeCacheConfig, SenderTxHashIndexing: config.SenderTxHashIndexing, SnapshotCacheSize: config.SnapshotCacheSize,
-		}
-	)
-
-	bc, err := blockchain.NewBlockChain(chainDB, cacheConfig, cn.chainConfig, cn.engine, vmConfig)
+	cacheConfig := &blockchain.CacheConfig{
+		ArchiveMode: config.NoPruning, CacheSize: config.TrieCacheSize,
+		BlockInterval: config.TrieBlockInterval, TriesInMemory: config.TriesInMemory,
+		TrieNodeCacheConfig: &config.TrieNodeCacheConfig, SenderTxHashIndexing: config.SenderTxHashIndexing, SnapshotCacheSize: config.SnapshotCacheSize,
+	}
+	bc, err := blockchain.NewBlockChain(chainDB, cacheConfig, chainConfig, engine, config.getVMConfig())
 	if err != nil {
+		chainDB.Close()
 		return nil, err
 	}
 	bc.SetCanonicalBlock(config.StartBlockNumber)
 
-	cn.blockchain = bc
-	governance.SetBlockchain(cn.blockchain)
+	gov.SetBlockchain(bc)
 	// Synchronize proposerpolicy & useGiniCoeff
-	if cn.blockchain.Config().Istanbul != nil {
-		cn.blockchain.Config().Istanbul.ProposerPolicy = governance.ProposerPolicy()
+	if bc.Config().Istanbul != nil {
+		bc.Config().Istanbul.ProposerPolicy = gov.ProposerPolicy()
+	}
+	if bc.Config().Governance.Reward != nil {
+		bc.Config().Governance.Reward.UseGiniCoeff = gov.UseGiniCoeff()
+	}
+	if gov.ProposerPolicy() == uint64(istanbul.WeightedRandom) {
+		// NewStakingManager is called with proper non-nil parameters
+		reward.NewStakingManager(bc, gov, chainDB)
+	}
+
+	return &cnChain{
+		chainDB:     chainDB,
+		chainConfig: chainConfig,
+		genesisHash: genesisHash,
+		compatErr:   compatErr,
+		governance:  gov,
+		engine:      engine,
+		blockchain:  bc,
+	}, nil
+}
+
+// New creates a new CN object (including the
+// initialisation of the common CN object)
+func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
+	if err := checkSyncMode(config); err != nil {
+		return nil, err
 	}
-	if cn.blockchain.Config().Governance.Reward != nil {
-		cn.blockchain.Config().Governance.Reward.UseGiniCoeff = governance.UseGiniCoeff()
+
+	chain, err := setupChain(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainDB, governance := chain.chainDB, chain.governance
+	config.GasPrice = new(big.Int).SetUint64(chain.chainConfig.UnitPrice)
+
+	cn := &CN{
+		config:            config,
+		chainDB:           chainDB,
+		chainConfig:       chain.chainConfig,
+		eventMux:          ctx.EventMux,
+		accountManager:    ctx.AccountManager,
+		engine:            chain.engine,
+		networkId:         config.NetworkId,
+		gasPrice:          config.GasPrice,
+		rewardbase:        config.Rewardbase,
+		bloomRequests:     make(chan chan *bloombits.Retrieval),
+		bloomIndexer:      NewBloomIndexer(chainDB, params.BloomBitsBlocks),
+		closeBloomHandler: make(chan struct{}),
+		governance:        governance,
 	}
 
+	logger.Info("Initialising Klaytn protocol", "versions", cn.engine.Protocol().Versions, "network", config.NetworkId)
+
+	bc := chain.blockchain
+	cn.blockchain = bc
+
 	if config.SenderTxHashIndexing {
 		ch := make(chan blockchain.ChainEvent, 255)
 		chainEventSubscription := cn.blockchain.SubscribeChainEvent(ch)
@@ -289,23 +336,26 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	}
 
 	// Rewind the chain in case of an incompatible config upgrade.
-	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
+	if compat := chain.compatErr; compat != nil {
 		logger.Error("Rewinding chain to upgrade configuration", "err", compat)
 		cn.blockchain.SetHead(compat.RewindTo)
-		chainDB.WriteChainConfig(genesisHash, cn.chainConfig)
+		chainDB.WriteChainConfig(chain.genesisHash, cn.chainConfig)
 	}
 	cn.bloomIndexer.Start(cn.blockchain)
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.RemoteJournal != "" {
+		config.TxPool.RemoteJournal = ctx.ResolvePath(config.TxPool.RemoteJournal)
+	}
 	// TODO-Klaytn-ServiceChain: add account creation prevention in the txPool if TxTypeAccountCreation is supported.
 	config.TxPool.NoAccountCreation = config.NoAccountCreation
 	cn.txPool = blockchain.NewTxPool(config.TxPool, cn.chainConfig, bc)
 	governance.SetTxPool(cn.txPool)
 
 	// Permit the downloader to use the trie cache allowance during fast sync
-	cacheLimit := cacheConfig.TrieNodeCacheConfig.LocalCacheSizeMiB
+	cacheLimit := config.TrieNodeCacheConfig.LocalCacheSizeMiB
 	if cn.protocolManager, err = NewProtocolManager(cn.chainConfig, config.SyncMode, config.NetworkId, cn.eventMux, cn.txPool, cn.engine, cn.blockchain, chainDB, cacheLimit, ctx.NodeType(), config); err != nil {
 		return nil, err
 	}
@@ -320,11 +370,6 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 		logger.Error("Error happened while setting the reward wallet", "err", err)
 	}
 
-	if governance.ProposerPolicy() == uint64(istanbul.WeightedRandom) {
-		// NewStakingManager is called with proper non-nil parameters
-		reward.NewStakingManager(cn.blockchain, governance, cn.chainDB)
-	}
-
 	// set worker
 	if config.WorkerDisable {
 		cn.miner = work.NewFakeWorker()
@@ -356,6 +401,7 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	cn.addComponent(cn.txPool)
 	cn.addComponent(cn.APIs())
 	cn.addComponent(cn.ChainDB())
+	cn.addComponent(cn.APIBackend)
 
 	if config.AutoRestartFlag {
 		daemonPath := config.DaemonPathFlag
@@ -395,6 +441,24 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	return cn, nil
 }
 
+// NewChain creates the blockchain of the CN service without the networking, the
+// transaction pool and the worker, so that the chain database can be handled offline.
+// The returned database should be closed after the blockchain is stopped.
+func NewChain(ctx *node.ServiceContext, config *Config) (*blockchain.BlockChain, database.DBManager, error) {
+	chain, err := setupChain(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if compat := chain.compatErr; compat != nil {
+		// Unlike New, the chain is not rewound since it is not expected by an offline command.
+		logger.Warn("Chain configuration is incompatible with the stored chain", "err", compat)
+	}
+	if istBackend, ok := chain.engine.(consensus.Istanbul); ok {
+		istBackend.SetChain(chain.blockchain)
+	}
+	return chain.blockchain, chain.chainDB, nil
+}
+
 // setAcceptTxs sets AcceptTxs flag in 1CN case to receive tx propagation.
 func (s *CN) setAcceptTxs() error {
 	if s.chainConfig.Istanbul != nil {
@@ -471,6 +535,9 @@ func CreateConsensusEngine(ctx *node.ServiceContext, config *Config, chainConfig
 	if chainConfig.Governance == nil {
 		chainConfig.Governance = params.GetDefaultGovernanceConfig()
 	}
+	if config.Istanbul.Journal != "" {
+		config.Istanbul.Journal = ctx.ResolvePath(config.Istanbul.Journal)
+	}
 	return istanbulBackend.New(config.Rewardbase, &config.Istanbul, ctx.NodeKey(), db, gov, nodetype)
 }
 
@@ -358,10 +358,16 @@ func (api *PublicFilterAPI) NewFilter(crit FilterCriteria) (rpc.ID, error) {
 	return logsSub.ID, nil
 }
 
+// NewGetLogsContext returns a context bounded by the execution deadline and the
+// maximum number of return items of getLogs and getFilterLogs APIs.
+func NewGetLogsContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx = context.WithValue(ctx, getLogsCxtKeyMaxItems, GetLogsMaxItems)
+	return context.WithTimeout(ctx, GetLogsDeadline)
+}
+
 // GetLogs returns logs matching the given argument that are stored within the state.
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) ([]*types.Log, error) {
-	ctx = context.WithValue(ctx, getLogsCxtKeyMaxItems, GetLogsMaxItems)
-	ctx, cancelFnc := context.WithTimeout(ctx, GetLogsDeadline)
+	ctx, cancelFnc := NewGetLogsContext(ctx)
 	defer cancelFnc()
 
 	// Convert the RPC block numbers into internal representations
@@ -400,8 +406,7 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 // GetFilterLogs returns the logs for the filter with the given id.
 // If the filter could not be found an empty array of logs is returned.
 func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*types.Log, error) {
-	ctx = context.WithValue(ctx, getLogsCxtKeyMaxItems, GetLogsMaxItems)
-	ctx, cancelFnc := context.WithTimeout(ctx, GetLogsDeadline)
+	ctx, cancelFnc := NewGetLogsContext(ctx)
 	defer cancelFnc()
 
 	api.filtersMu.Lock()
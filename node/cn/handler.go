@@ -1247,7 +1247,7 @@ func handleTxMsg(pm *ProtocolManager, p Peer, msg p2p.Msg) error {
 		validTxs = append(validTxs, tx)
 		txReceiveCounter.Inc(1)
 	}
-	pm.txpool.HandleTxMsg(validTxs)
+	pm.txpool.HandleTxMsg(p.GetID(), validTxs)
 	return err
 }
 
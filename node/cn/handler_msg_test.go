@@ -269,9 +269,10 @@ func TestHandleTxMsg(t *testing.T) {
 
 		// The time field in received transaction through pm.handleMsg() has different value from generated transaction(`tx1`).
 		// It can check whether the transaction created `HandleTxMsg()` is the same as `tx1` through `AddToKnownTxs(txs[0].Hash())`.
-		mockTxPool.EXPECT().HandleTxMsg(gomock.Any()).AnyTimes()
+		mockTxPool.EXPECT().HandleTxMsg(nodeids[0].String(), gomock.Any()).AnyTimes()
 		pm.txpool = mockTxPool
 
+		mockPeer.EXPECT().GetID().Return(nodeids[0].String()).AnyTimes()
 		mockPeer.EXPECT().AddToKnownTxs(txs[0].Hash()).Times(1)
 		assert.NoError(t, pm.handleMsg(mockPeer, addrs[0], msg))
 	}
@@ -16,11 +16,16 @@
 
 /*
 Package tracers provides implementation of Tracer that evaluates a Javascript
-function for each VM execution step.
+function for each VM execution step, and native Go tracers producing the same
+output as the built-in JavaScript tracers.
 
 Source Files
 
-  - tracer.go  : implementation of Tracer
-  - tracers.go : provides managing functions of tracers
+  - tracer.go          : implementation of Tracer
+  - tracers.go         : provides managing functions of tracers
+  - native.go          : registry of the native tracers and the native callTracer
+  - prestate_tracer.go : native version of prestate_tracer.js
+  - fourbyte_tracer.go : native version of 4byte_tracer.js
+  - revert_tracer.go   : native version of revert_tracer.js
 */
 package tracers
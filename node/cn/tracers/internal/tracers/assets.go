@@ -6,7 +6,7 @@
 // evmdis_tracer.js (4.194kB)
 // noop_tracer.js (1.26kB)
 // opcount_tracer.js (1.372kB)
-// prestate_tracer.js (4.237kB)
+// prestate_tracer.js (4.603kB)
 // revert_tracer.js (2.248kB)
 // trigram_tracer.js (2.362kB)
 // unigram_tracer.js (1.994kB)
@@ -198,7 +198,7 @@ func opcount_tracerJs() (*asset, error) {
 	return a, nil
 }
 
-var _prestate_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\xdd\x6f\xdb\x38\x12\x7f\x96\xfe\x8a\x41\x5f\x6c\xa3\xae\xdc\x66\x81\x3d\xc0\xb9\x1c\xa0\xba\x6e\x1b\x20\x9b\x04\xb6\x7b\xb9\xdc\x62\x1f\x28\x72\x24\x73\x4d\x93\x02\x49\xd9\xf1\x15\xf9\xdf\x0f\x43\x7d\xf8\xa3\x49\x93\xdd\x37\x9b\x1c\xfe\xe6\xfb\x37\xa3\xd1\x08\x26\xa6\xdc\x59\x59\x2c\x3d\x9c\xbd\xff\xf0\x0f\x58\x2c\x11\x0a\xf3\x0e\xfd\x12\x2d\x56\x6b\x48\x2b\xbf\x34\xd6\xc5\xa3\x11\x2c\x96\xd2\x41\x2e\x15\x82\x74\x50\x32\xeb\xc1\xe4\xe0\x4f\xe4\x95\xcc\x2c\xb3\xbb\x24\x1e\x8d\xea\x37\x4f\x5e\x13\x42\x6e\x11\xc1\x99\xdc\x6f\x99\xc5\x31\xec\x4c\x05\x9c\x69\xb0\x28\xa4\xf3\x56\x66\x95\x47\x90\x1e\x98\x16\x23\x63\x61\x6d\x84\xcc\x77\x04\x29\x3d\x54\x5a\xa0\x0d\xaa\x3d\xda\xb5\x6b\xed\xf8\x72\xfd\x0d\xae\xd0\x39\xb4\xf0\x05\x35\x5a\xa6\xe0\xb6\xca\x94\xe4\x70\x25\x39\x6a\x87\xc0\x1c\x94\x74\xe2\x96\x28\x20\x0b\x70\xf4\xf0\x33\x99\x32\x6f\x4c\x81\xcf\xa6\xd2\x82\x79\x69\xf4\x10\x50\x92\xe5\xb0\x41\xeb\xa4\xd1\xf0\x4b\xab\xaa\x01\x1c\x82\xb1\x04\xd2\x67\x9e\x1c\xb0\x60\x4a\x7a\x37\x00\xa6\x77\xa0\x98\xdf\x3f\x7d\x45\x40\xf6\x7e\x0b\x90\x3a\xa8\x59\x9a\x12\xc1\x2f\x99\x27\xaf\xb7\x52\x29\xc8\x10\x2a\x87\x79\xa5\x86\x84\x96\x55\x1e\xee\x2e\x17\x5f\x6f\xbe\x2d\x20\xbd\xbe\x87\xbb\x74\x36\x4b\xaf\x17\xf7\xe7\xb0\x95\x7e\x69\x2a\x0f\xb8\xc1\x1a\x4a\xae\x4b\x25\x51\xc0\x96\x59\xcb\xb4\xdf\x81\xc9\x09\xe1\xb7\xe9\x6c\xf2\x35\xbd\x5e\xa4\x1f\x2f\xaf\x2e\x17\xf7\x60\x2c\x7c\xbe\x5c\x5c\x4f\xe7\x73\xf8\x7c\x33\x83\x14\x6e\xd3\xd9\xe2\x72\xf2\xed\x2a\x9d\xc1\xed\xb7\xd9\xed\xcd\x7c\x9a\xc0\x1c\xc9\x2a\xa4\xf7\x2f\xc7\x3c\x0f\xd9\xb3\x08\x02\x3d\x93\xca\xb5\x91\xb8\x37\x15\xb8\xa5\xa9\x94\x80\x25\xdb\x20\x58\xe4\x28\x37\x28\x80\x01\x37\xe5\xee\xd5\x49\x25\x2c\xa6\x8c\x2e\x82\xcf\xcf\x16\x24\x5c\xe6\xa0\x8d\x1f\x82\x43\x84\x7f\x2e\xbd\x2f\xc7\xa3\xd1\x76\xbb\x4d\x0a\x5d\x25\xc6\x16\x23\x55\xc3\xb9\xd1\xbf\x92\x98\x30\x4b\x8b\xce\x33\x8f\x0b\xcb\x38\x5a\x30\x95\x2f\x2b\xef\xc0\x55\x79\x2e\xb9\x44\xed\x41\xea\xdc\xd8\x75\xa8\x14\xf0\x06\xb8\x45\xe6\x11\x18\x28\xc3\x99\x02\x7c\x40\x5e\x85\xbb\x3a\xd2\xa1\x5c\x2d\xd3\x8e\xf1\x70\x9a\x5b\xb3\x26\x5f\x2b\xe7\xe9\x87\x73\xb8\xce\x14\x0a\x28\x50\xa3\x93\x0e\x32\x65\xf8\x2a\x89\xbf\xc7\xd1\x81\x31\x54\x27\xc1\xc3\x46\x28\xd4\xc6\x16\x7b\x16\x21\xab\xa4\x12\x52\x17\x49\x1c\xb5\xd2\x63\xd0\x95\x52\xc3\x38\x40\x28\x63\x56\x55\x99\x72\x6e\xaa\x60\xfb\x9f\xc8\x7d\x0d\xe6\x4a\xe4\x32\xa7\xe2\x60\xdd\xad\x37\xe1\xaa\xd3\x6b\x32\x92\x4f\xe2\xe8\x08\x66\x0c\x79\xa5\x83\x3b\x7d\x26\x84\x1d\x82\xc8\x06\xdf\xe3\x28\xda\x30\x4b\x58\x70\x01\xde\x7c\xc5\x87\x70\x39\x38\x8f\xa3\x48\xe6\xd0\xf7\x4b\xe9\x92\x16\xf8\x77\xc6\xf9\x1f\x70\x71\x71\x11\x9a\x3a\x97\x1a\xc5\x00\x08\x22\x7a\x4a\xac\xbe\x89\x32\xa6\x98\xe6\x38\x86\xde\xfb\x87\x1e\xbc\x05\x91\x25\x05\xfa\x8f\xf5\x69\xad\x2c\xf1\x66\xee\xad\xd4\x45\xff\xc3\xaf\x83\x61\x78\xa5\x4d\x78\x03\x8d\xf8\xb5\xe9\x84\xeb\x7b\x6e\x44\xb8\x6e\x6c\xae\xa5\x26\x46\x34\x42\x8d\x94\xf3\xc6\xb2\x02\xc7\xf0\xfd\x91\xfe\x3f\x92\x57\x8f\x71\xf4\x78\x14\xe5\x79\x2d\xf4\x4c\x94\x1b\x08\x40\xed\x6d\x57\xe7\x85\xa4\x4e\x3d\x4c\x40\xc0\xfb\x59\x12\xe6\xad\x29\x27\x49\x58\xe1\xee\xe5\x4c\xd0\x85\x14\x0f\xdd\xc5\x0a\x77\x83\xf3\xf8\xd9\x14\x25\x8d\xd1\xbf\x4b\xf1\xf0\xda\x7c\x9d\xbc\x39\x8a\xeb\x9c\xa4\xf6\xf6\x0e\x06\x27\x71\xb4\xe8\x2a\xe5\xa9\xdc\xa5\xde\x98\x15\x11\xd7\x92\xe2\xa3\x54\x08\x89\x29\x29\x5b\xae\x66\x8e\x0c\x51\x83\xf4\x68\x19\x51\xa7\xd9\xa0\xa5\xa9\x01\x16\x7d\x65\xb5\xeb\xc2\x98\x4b\xcd\x54\x0b\xdc\x44\xdd\x5b\xc6\xeb\x9e\xa9\xcf\x0f\x62\xc9\xfd\x43\x88\x62\xf0\x6e\x34\x82\xd4\x03\xb9\x08\xa5\x91\xda\x0f\x61\x8b\xa0\x11\x05\x35\xbe\x40\x51\x71\x1f\xf0\x7a\x1b\xa6\x2a\xec\xd5\xcd\x4d\x14\x19\x9e\x9a\x8a\x26\xc1\x41\xf3\x0f\x83\x81\x6b\xb3\x09\x23\x2e\x63\x7c\x05\x4d\xc3\x19\x2b\x0b\xa9\xe3\x26\x9c\x47\xcd\x46\x16\x25\x04\x1c\xcc\x0a\xb9\xa2\x24\xd2\xc9\x47\xa6\xe0\x02\x32\x59\x5c\x6a\x7f\x92\xbc\x3a\xe8\xed\xd3\xc1\x1f\x49\xd3\x3c\x89\x23\xc2\xeb\x9f\x0d\x86\xf0\xe1\xd7\xae\x22\xbc\x21\x28\x78\x19\xcc\x9b\xe7\xa1\xe2\xd3\x62\x78\xfa\x59\x50\x43\x1d\xfc\x36\x68\x4d\x5c\x95\x51\x3a\x6a\x3f\x43\x1c\x8f\xbb\xf8\xfc\x27\xb8\xc7\xbe\xb5\xb8\x4d\x68\x12\x26\xc4\xf3\xa0\x75\x8a\x3e\x21\xb7\xb8\x26\x56\xa7\x2c\x70\xa6\x14\xda\x9e\x83\xc0\x19\xc3\xa6\x9c\x42\xbe\x70\x5d\xfa\x5d\xcb\xf5\x9e\xd9\x02\xbd\x7b\xd9\xb0\x80\xf3\xee\x5d\x4b\x81\x21\x14\xbb\x12\xe1\xe2\x02\x7a\x93\xd9\x34\x5d\x4c\x7b\x4d\x1b\x8d\x46\x70\x87\x61\x13\xca\x94\xcc\x84\xda\x81\x40\x85\x1e\x6b\xbb\x8c\x0e\x21\xea\x28\x61\x48\x2b\x0d\x2d\x1b\xf8\x20\x9d\x97\xba\x80\x9a\x29\xb6\x34\x57\x1b\xb8\xd0\x23\x9c\x55\x8e\xaa\xf5\x64\x08\x79\x43\x1b\x85\x45\xe2\x15\xe2\xff\xd0\x6e\x4c\xc9\x6e\x03\xc9\xa5\x75\x1e\x4a\xc5\x38\x26\x84\xd7\x19\xf3\x7c\x7e\x9b\x4e\x26\xd5\xb3\xd0\x82\x01\x68\x3f\xe0\x98\xa2\x01\x49\xea\x1d\xf4\x5b\x8c\x41\x1c\x45\xb6\x95\x3e\xc0\x3e\xdf\x53\x82\xf3\x58\x1e\x12\x02\x2d\x16\xb8\x41\xa2\xd0\xc0\x06\xf5\x30\x24\x5d\xff\xfe\xad\x99\xbe\xe8\x92\x38\xa2\x77\x07\x7d\xad\x4c\x71\xdc\xd7\xa2\x0e\x0b\xaf\xac\xa5\xfc\x77\x14\x9c\x53\x8f\xff\x59\x39\x4f\x31\xb5\x14\x9e\x86\x2d\x9e\x22\xc9\x40\x89\x34\x6d\x07\x3f\x92\x21\xcd\xad\x30\x27\x48\x5d\x33\xa5\xea\x6d\xae\x34\x1e\xb5\x97\x4c\xa9\x1d\xe5\x61\x6b\x69\x8d\xa1\xc5\x65\x08\x4e\x92\x54\x60\x9c\x20\x2a\x35\x57\x95\xa8\xcb\x20\xd4\x71\x83\xe7\x82\xcd\xc7\xfb\xcf\x1a\x9d\x63\x05\x26\x54\x49\xb9\x7c\x68\x36\x48\x0d\xbd\x9a\xe4\xfa\x83\x5e\xd2\x19\x79\x4c\x31\xca\x14\x49\x5b\x64\x44\xd3\xa9\x10\x16\x9d\xeb\x0f\x1a\xce\xe9\x32\x7b\xb7\x44\x4d\xc1\x07\x8d\x5b\xe8\x56\x13\xc6\x39\xad\x6a\x62\x08\x4c\x08\xa2\xb6\x93\x35\x22\x8e\x22\xb7\x95\x9e\x2f\x21\x68\x32\xe5\xbe\x17\x07\x4d\xfd\x73\xe6\x10\xde\x4c\xff\xb3\x98\xdc\x7c\x9a\x4e\x6e\x6e\xef\xdf\x8c\xe1\xe8\x6c\x7e\xf9\xdf\x69\x77\xf6\x31\xbd\x4a\xaf\x27\xd3\x37\xe3\x30\x9b\x9f\x70\xc8\x9b\xd6\x05\x52\xe8\x3c\xe3\xab\xa4\x44\x5c\xf5\xdf\x1f\xf3\xc0\xde\xc1\x28\xca\x2c\xb2\xd5\xf9\xde\x98\xba\x41\x1b\x1d\x2d\xe5\xc2\x05\x3c\x1b\xac\xf3\xe7\xad\x99\x34\xf2\xfd\x96\xc8\xf7\xab\x48\xa0\x8a\x97\xed\x38\xfb\xcb\x86\x84\xde\x61\x7c\x35\x06\xc7\x14\x6d\xc0\xf2\x7f\xf4\xe5\x92\xe7\x0e\xfd\x10\x50\x0b\xb3\x25\xe6\xeb\x50\xeb\x9b\x06\xf7\x20\x64\x1f\x06\x35\x83\xde\xe4\x2d\x32\x49\x13\xda\x8f\xb2\x67\x4f\xca\xa2\x16\x70\xd1\xe2\xbf\x0d\x4f\x5f\x11\xab\xb3\x26\x58\x27\x2a\x7e\x39\x59\xf2\xc2\xfd\x1a\xd7\xc6\xee\x9a\x89\x74\xe0\xe2\xcf\x03\x9b\x5e\x5d\x75\x25\x45\x7f\xa8\xce\xba\x83\x4f\xd3\xab\xe9\x97\x74\x31\x3d\x92\x9a\x2f\xd2\xc5\xe5\xa4\x3e\xfa\xcb\xb5\xf7\xe1\xd5\xb5\xd7\x9b\xcf\x17\x37\xb3\x69\x6f\xdc\xfc\xbb\xba\x49\x3f\xf5\x7e\x50\xd8\x2c\x82\x3f\xeb\x5e\x6f\xee\x8c\x15\x7f\xa7\x09\x0e\x96\xb2\x9c\x3d\xb5\x93\x05\x76\xe7\xbe\x3a\xf9\xe6\x01\xa6\x5b\x62\xce\xeb\xef\xbe\x28\xbc\x7f\x92\x8a\x1f\xe3\xc7\xf8\xff\x01\x00\x00\xff\xff\x0a\x4c\x81\x61\x8d\x10\x00\x00")
+var _prestate_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\xdf\x8f\x1a\x39\x12\x7e\xee\xfe\x2b\x4a\x79\x01\x14\xd2\x24\x59\x69\x4f\x9a\xb9\x39\x89\x10\x92\x8c\x34\x99\x89\x80\x6c\x2e\xb7\xda\x07\xb7\x5d\x0d\x5e\x8c\xdd\xb2\xab\xf9\x71\xd1\xfc\xef\xa7\x72\x77\x33\xc0\x0e\x99\xd9\x7b\x03\xbb\xfc\xb9\xfc\xf9\xab\xcf\xd5\x83\x01\x8c\x5c\xb9\xf3\x7a\xbe\x20\x78\xfb\xfa\xcd\x3f\x60\xb6\x40\x98\xbb\x57\x48\x0b\xf4\x58\xad\x60\x58\xd1\xc2\xf9\x90\x0e\x06\x30\x5b\xe8\x00\x85\x36\x08\x3a\x40\x29\x3c\x81\x2b\x80\x4e\xe2\x8d\xce\xbd\xf0\xbb\x2c\x1d\x0c\xea\x35\x8f\x4e\x33\x42\xe1\x11\x21\xb8\x82\x36\xc2\xe3\x05\xec\x5c\x05\x52\x58\xf0\xa8\x74\x20\xaf\xf3\x8a\x10\x34\x81\xb0\x6a\xe0\x3c\xac\x9c\xd2\xc5\x8e\x21\x35\x41\x65\x15\xfa\xb8\x35\xa1\x5f\x85\x36\x8f\x8f\xb7\x5f\xe1\x06\x43\x40\x0f\x1f\xd1\xa2\x17\x06\xbe\x54\xb9\xd1\x12\x6e\xb4\x44\x1b\x10\x44\x80\x92\x47\xc2\x02\x15\xe4\x11\x8e\x17\x7e\xe0\x54\xa6\x4d\x2a\xf0\xc1\x55\x56\x09\xd2\xce\xf6\x01\x35\x67\x0e\x6b\xf4\x41\x3b\x0b\xbf\xb4\x5b\x35\x80\x7d\x70\x9e\x41\xba\x82\xf8\x00\x1e\x5c\xc9\xeb\x7a\x20\xec\x0e\x8c\xa0\x87\xa5\xcf\x20\xe4\xe1\xdc\x0a\xb4\x8d\xdb\x2c\x5c\x89\x40\x0b\x41\x7c\xea\x8d\x36\x06\x72\x84\x2a\x60\x51\x99\x3e\xa3\xe5\x15\xc1\xb7\xeb\xd9\xa7\xbb\xaf\x33\x18\xde\x7e\x87\x6f\xc3\xc9\x64\x78\x3b\xfb\x7e\x09\x1b\x4d\x0b\x57\x11\xe0\x1a\x6b\x28\xbd\x2a\x8d\x46\x05\x1b\xe1\xbd\xb0\xb4\x03\x57\x30\xc2\xe7\xf1\x64\xf4\x69\x78\x3b\x1b\xbe\xbb\xbe\xb9\x9e\x7d\x07\xe7\xe1\xc3\xf5\xec\x76\x3c\x9d\xc2\x87\xbb\x09\x0c\xe1\xcb\x70\x32\xbb\x1e\x7d\xbd\x19\x4e\xe0\xcb\xd7\xc9\x97\xbb\xe9\x38\x83\x29\x72\x56\xc8\xeb\x9f\xe6\xbc\x88\xb7\xe7\x11\x14\x92\xd0\x26\xb4\x4c\x7c\x77\x15\x84\x85\xab\x8c\x82\x85\x58\x23\x78\x94\xa8\xd7\xa8\x40\x80\x74\xe5\xee\xd9\x97\xca\x58\xc2\x38\x3b\x8f\x67\x3e\x2b\x48\xb8\x2e\xc0\x3a\xea\x43\x40\x84\x7f\x2e\x88\xca\x8b\xc1\x60\xb3\xd9\x64\x73\x5b\x65\xce\xcf\x07\xa6\x86\x0b\x83\x7f\x65\x29\x63\x96\x1e\x03\x09\xc2\x99\x17\x12\x3d\xb8\x8a\xca\x8a\x02\x84\xaa\x28\xb4\xd4\x68\x09\xb4\x2d\x9c\x5f\x45\xa5\x00\x39\x90\x1e\x05\x21\x08\x30\x4e\x0a\x03\xb8\x45\x59\xc5\xb9\x9a\xe9\x28\x57\x2f\x6c\x10\x32\x8e\x16\xde\xad\xf8\xac\x55\x20\xfe\x11\x02\xae\x72\x83\x0a\xe6\x68\x31\xe8\x00\xb9\x71\x72\x99\xa5\x3f\xd2\xe4\x20\x19\xd6\x49\x3c\x61\x13\x14\xb5\xb1\xc1\x8e\x47\xc8\x2b\x6d\x94\xb6\xf3\x2c\x4d\xda\xe8\x0b\xb0\x95\x31\xfd\x34\x42\x18\xe7\x96\x55\x39\x94\xd2\x55\x31\xf7\x3f\x51\x52\x0d\x16\x4a\x94\xba\x60\x71\x88\xfd\x2c\xb9\x38\xb5\xdf\xd7\xe5\x1c\x9f\xa5\xc9\x11\xcc\x05\x14\x95\x8d\xc7\xe9\x0a\xa5\x7c\x1f\x54\xde\xfb\x91\x26\xc9\x5a\x78\xc6\x82\x2b\x20\xf7\x09\xb7\x71\xb2\x77\x99\x26\x89\x2e\xa0\x4b\x0b\x1d\xb2\x16\xf8\x77\x21\xe5\x1f\x70\x75\x75\x15\x8b\xba\xd0\x16\x55\x0f\x18\x22\x79\x2c\xac\x9e\x49\x72\x61\x84\x95\x78\x01\x9d\xd7\xdb\x0e\xbc\x04\x95\x67\x73\xa4\x77\xf5\x68\xbd\x59\x46\x6e\x4a\x5e\xdb\x79\xf7\xcd\xaf\xbd\x7e\x5c\x65\x5d\x5c\x03\x4d\xf8\xad\xdb\x07\xd7\xf3\xd2\xa9\x38\xdd\xe4\x5c\x47\x8d\x9c\x6a\x82\x9a\xa8\x40\xce\x8b\x39\x5e\xc0\x8f\x7b\xfe\x7f\xcf\xa7\xba\x4f\x93\xfb\x23\x96\xa7\x75\xd0\x19\x96\x1b\x08\x40\x4b\x7e\xaf\xf3\xb9\xe6\x4a\x3d\xbc\x80\x88\xf7\xb3\x4b\x98\xb6\xa9\x9c\x5c\xc2\x12\x77\x4f\xdf\x04\x4f\x68\xb5\xdd\x4f\x2c\x71\xd7\xbb\x4c\xcf\x5e\x51\xd6\x24\xfd\xbb\x56\xdb\xe7\xde\xd7\xc9\x9a\x23\x5e\xa7\x1c\xf5\x90\x6f\xaf\x77\xc2\xa3\xc7\x50\x19\x62\xb9\x6b\xbb\x76\x4b\x36\xae\x05\xf3\x63\x4c\xa4\xc4\x95\x7c\x5b\xa1\x76\x8e\x1c\xd1\x82\x26\xf4\x82\x50\x81\x5b\xa3\xe7\x57\x03\x3c\x52\xe5\x6d\xd8\xd3\x58\x68\x2b\x4c\x0b\xdc\xb0\x4e\x5e\xc8\xba\x66\xea\xf1\x03\x2e\x25\x6d\x23\x8b\xf0\xe3\x31\x52\x22\x05\x5c\x5d\x8f\x9d\x9e\x85\x1a\x85\xc1\x5b\x47\xdf\x69\x12\x86\x85\x08\x75\xba\xb5\x3b\xa0\x82\x2e\x66\xf3\x0c\x04\xac\x85\xa9\x1a\x83\x28\xd0\xf7\xfa\x10\x08\x4b\xd0\xc1\x76\xa8\x01\x92\xc2\x18\x54\x7d\x08\x75\x69\x7a\x94\xba\x8c\x3e\xb4\x17\x4d\x88\xf2\x43\x05\x55\x09\x6c\x7f\x19\xdc\x3a\x5a\x68\x3b\x8f\x2f\x6b\x8e\x0d\xd0\x43\x90\x6e\x58\xd8\xc6\xc4\xac\xa3\x3a\x39\x5f\x59\xc8\x77\x71\x6a\xfc\xdb\x67\x10\xc4\xbc\x67\x69\x52\xf3\x20\x69\x9b\x45\xf3\x7a\x4c\x05\x49\xcd\x3a\x1c\xf1\x11\xa9\xb8\xdf\xd3\x74\x64\x21\x11\x8e\x5c\xa4\xba\xd1\x00\xe7\x38\xa4\x08\x01\xa5\xd3\x96\xfa\xb0\x41\xb0\x88\x0a\xc8\x81\x42\x55\x49\x8a\xc9\x75\x22\x69\x9d\xda\x4a\xf9\x41\x8a\x4b\x5d\x45\xe8\x0f\xad\xb6\x1f\xe5\xb0\x72\xeb\xd8\x50\xe4\x42\x2e\xa1\xb1\x37\xe7\xf5\x5c\xdb\xf4\x6c\x5e\x0c\xdc\x64\xd6\x94\x0c\x8f\xbc\x13\x06\xae\x20\xd7\xf3\x6b\x4b\x27\xa5\x52\x4b\xbc\x5d\xda\xfb\x23\x6b\xac\x2a\x0b\xfc\xbc\x74\xdf\xf6\xfa\xf0\xe6\xd7\x7d\xfd\x91\x63\x28\x78\x1a\x8c\xdc\x79\xa8\x34\x49\x9e\xb3\x2c\x6e\xc3\x7e\xf9\x32\xee\x9a\x85\x2a\x67\xf1\xd7\xe7\x8c\x3c\x1e\x7b\xe6\xe5\x4f\x70\x8f\xcf\xd6\xe2\x36\xd4\x64\x42\xa9\xf3\xa0\xf5\x15\xbd\x47\xe9\x71\xc5\xda\xe5\x5b\x88\xc2\xf6\x1d\x16\xa0\x95\xd8\x6f\x8a\x37\xde\x17\xae\x4a\xda\xb5\x2f\x2b\x09\x3f\x47\x0a\x4f\x27\x16\x71\x5e\xbd\x6a\x1f\x1c\x9e\xa1\x5d\xc9\x35\x0b\x9d\xd1\x64\x3c\x9c\x8d\x3b\x8d\x5c\x07\x03\xf8\x86\x75\x75\x18\x9d\x2b\xb3\x03\x85\x06\x09\xeb\xbc\x9c\x8d\x14\xed\x0d\xb8\x0f\x22\xc4\xd6\x0e\xb7\x3a\x10\xd7\x55\x1c\x86\x0d\x77\x31\x0d\x5c\x74\x24\x29\xaa\x80\xaa\xae\xad\x83\x27\x9f\x1c\xe4\x5c\xb8\xec\xe2\xfc\xda\x46\x73\x13\x46\xef\xfb\xbd\x42\xfb\x40\x50\x1a\x21\x31\x96\xdb\x3e\x99\xf3\xf7\x7b\x50\x33\x93\xb6\xf4\xf0\xa0\x9d\x10\x86\xdb\x11\xde\x3e\x40\xb7\xc5\xe8\xa5\x67\x0b\xb5\x35\xe0\xc6\x7e\xf6\xf6\xcb\x6d\x1c\xae\xd1\xef\x5a\x2b\x8b\xad\x07\xef\xf5\xdb\xe7\xd6\xcd\x42\x96\x26\xbc\xee\xc0\x45\x8d\x9b\x3f\xb8\x28\xd7\xb5\xaa\x69\x91\x95\xf7\x47\xde\x55\x70\x8d\xff\x59\x05\x62\x4e\x3d\xd3\xd3\x78\xf3\xcf\xdd\xf7\x09\xf3\x6d\x7a\x82\xba\x77\x2e\x1d\xa1\x25\x2d\x8c\xd9\xf1\x3d\x6c\x3c\x37\x8d\xec\x93\x7d\x08\x9a\xa3\x18\xa7\x0e\xd5\x56\x9a\x4a\xd5\x32\x88\x3a\x6e\xf0\x42\xcc\xf9\xb8\xdb\x5c\x61\x08\x62\x8e\x19\x2b\xa9\xd0\xdb\xa6\x5f\xb7\xd0\xa9\x9f\x94\x6e\xaf\x93\x9d\xb1\x3e\xe3\xe6\x59\x2b\x32\x7e\x14\x87\x4a\x79\x0c\xa1\xdb\x3b\x75\xc3\x6f\x0b\xb4\x4c\x3e\x58\xdc\xc0\xbe\x11\x14\x52\x72\x63\xac\xfa\x20\x94\x02\x4d\x70\xd2\xb4\xa5\x49\x12\x36\x9a\xe4\x02\xe2\x4e\xae\x7c\xa8\xc5\x5e\xa3\x7f\x29\x02\xc2\x8b\xf1\xbf\x67\xa3\xbb\xf7\xe3\xd1\xdd\x97\xef\x2f\x2e\xe0\x68\x6c\x7a\xfd\x9f\xf1\x7e\xec\xdd\xf0\x66\x78\x3b\x1a\xbf\xb8\x48\x93\xc7\x0f\x44\xae\x3d\x02\x6f\x18\x48\xc8\x65\x56\x22\x2e\xbb\xaf\x8f\x7d\xe0\xe1\x80\x49\x92\x7b\x14\xcb\xcb\x87\x64\xea\x02\x6d\xf6\x68\x2d\x17\xae\xe0\x2c\x59\x97\xe7\xb3\x19\x35\xf1\xdd\xd6\xc8\x1f\x1a\x3f\x1e\x79\x46\x1e\x6f\xff\x76\x22\xb1\x76\x84\x5c\x5e\x40\x10\x86\xbf\x37\xf4\x7f\xf9\x3b\xb1\x28\x02\x52\x1f\xd0\x2a\xb7\x61\xe7\xdb\xa3\xd6\x33\x0d\xee\x01\x65\x6f\x7a\xb5\x83\xde\x15\x2d\x32\x47\x33\xda\x5f\x63\xdf\x3e\x1a\x8b\x56\xc1\x55\x8b\xff\x32\x2e\x7d\x06\x57\x6f\x1b\xb2\x4e\xb6\xf8\xe5\xa4\xa5\x8e\xf3\x2b\x5c\x39\xbf\x6b\x5e\xa4\x83\x23\xfe\x9c\xd8\xe1\xcd\xcd\x5e\x52\xfc\x87\x75\xb6\x1f\x78\x3f\xbe\x19\x7f\x1c\xce\xc6\x47\x51\xd3\xd9\x70\x76\x3d\xaa\x87\xfe\xb6\xf6\xde\x3c\x5b\x7b\x9d\xe9\x74\x76\x37\x19\x77\x2e\x9a\x7f\x37\x77\xc3\xf7\x9d\xbf\x6c\xd8\xb4\xdd\x3f\xab\x5e\x72\xdf\x9c\x57\xff\x4f\x11\x1c\xb4\xc0\x85\x78\xac\x03\x8e\xee\x2e\xa9\x3a\xf9\xc2\x04\x61\x5b\x63\x2e\xea\xaf\xec\x24\xae\x7f\xd4\x8a\xef\xd3\xfb\xf4\x7f\x03\x00\xf7\xf8\x37\xec\xfb\x11\x00\x00")
 
 func prestate_tracerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -214,7 +214,7 @@ func prestate_tracerJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "prestate_tracer.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3f, 0xa9, 0x15, 0x3d, 0xeb, 0xb2, 0xb6, 0xbc, 0x53, 0xbd, 0x27, 0x12, 0xa1, 0x9c, 0x3f, 0xf8, 0x1, 0x8e, 0x6, 0xa0, 0xed, 0x8b, 0x2e, 0x19, 0xaf, 0x71, 0xec, 0xbb, 0x84, 0x77, 0x1c, 0xbc}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6c, 0x95, 0x9, 0xaa, 0xb6, 0x31, 0xcc, 0x8b, 0xe7, 0xbd, 0x43, 0x39, 0xa7, 0xc8, 0x9f, 0xdc, 0xa, 0xa9, 0x5b, 0x27, 0x83, 0x7b, 0x12, 0x16, 0x9c, 0xe5, 0x79, 0x6b, 0xff, 0x7a, 0xcf, 0xa}}
 	return a, nil
 }
 
@@ -47,6 +47,16 @@
 	// result is invoked when all the opcodes have been iterated over and returns
 	// the final result of the tracing.
 	result: function(ctx, db) {
+		if (this.prestate === null) {
+			this.prestate = {};
+			// If no opcode has been executed (e.g. a value transfer), step isn't
+			// called, so the recipient account is looked up here. Nothing can be
+			// looked up if the tx has not been run by the EVM at all.
+			if (ctx.from === undefined) {
+				return this.prestate;
+			}
+			this.lookupAccount(ctx.to, db);
+		}
 		// At this point, we need to deduct the 'value' from the
 		// outer transaction, and move it back to the origin
 		this.lookupAccount(ctx.from, db);
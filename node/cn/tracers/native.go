@@ -0,0 +1,102 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+)
+
+// jsTracerSuffix is appended to the name of a built-in JavaScript tracer which
+// is shadowed by a native tracer, so that the JavaScript version can still be
+// selected as a fallback.
+const jsTracerSuffix = "Js"
+
+// NativeTracer is a vm.Tracer implemented in Go. It produces the same JSON
+// output as the JavaScript tracer of the same name, without the overhead of
+// running every opcode through the duktape engine.
+type NativeTracer interface {
+	vm.Tracer
+
+	// GetResult returns the JSON encoded result of the tracing.
+	GetResult() (json.RawMessage, error)
+
+	// Stop terminates the tracing at the first opportune moment.
+	Stop(err error)
+}
+
+// nativeTracers contains the constructors of the native tracers by name.
+var nativeTracers = map[string]func() NativeTracer{
+	"callTracer":     newCallTracer,
+	"prestateTracer": newPrestateTracer,
+	"4byteTracer":    newFourByteTracer,
+	"revertTracer":   newRevertTracer,
+}
+
+// NewNativeTracer returns a new native tracer registered by the given name.
+// The second return value is false if there is no such native tracer.
+func NewNativeTracer(name string) (NativeTracer, bool) {
+	if ctor, ok := nativeTracers[name]; ok {
+		return ctor(), true
+	}
+	return nil, false
+}
+
+// callTracer is the native version of call_tracer.js. The tracing logic is
+// shared with vm.InternalTxTracer which is a Go port of the same script.
+type callTracer struct {
+	*vm.InternalTxTracer
+}
+
+func newCallTracer() NativeTracer {
+	return &callTracer{vm.NewInternalTxTracer()}
+}
+
+// GetResult returns the JSON encoded call frames.
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	result, err := t.InternalTxTracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// peekStack returns the nth-from-the-top element of the stack, or zero if the
+// stack is not deep enough.
+func peekStack(stack *vm.Stack, idx int) *big.Int {
+	data := stack.Data()
+	if len(data) <= idx || idx < 0 {
+		logger.Warn("Tracer accessed out of bound stack", "size", len(data), "index", idx)
+		return new(big.Int)
+	}
+	return data[len(data)-idx-1]
+}
+
+// sliceMemory returns a copy of the requested range of memory, or nil if the
+// range is out of bound.
+func sliceMemory(memory *vm.Memory, begin, end int64) []byte {
+	if end == begin {
+		return []byte{}
+	}
+	if end < begin || begin < 0 || memory.Len() < int(end) {
+		logger.Warn("Tracer accessed out of bound memory", "available", memory.Len(), "offset", begin, "end", end)
+		return nil
+	}
+	return memory.GetCopy(begin, end-begin)
+}
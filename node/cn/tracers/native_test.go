@@ -0,0 +1,231 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/math"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/fork"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/klaytn/klaytn/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runTracer executes the transaction of the given test case with the tracer
+// and returns the trace result.
+func runTracer(t *testing.T, test *callTracerTest, tracer NativeTracer) json.RawMessage {
+	signer := types.MakeSigner(test.Genesis.Config, new(big.Int).SetUint64(uint64(test.Context.Number)))
+	tx := new(types.Transaction)
+	if test.Input != "" {
+		require.NoError(t, rlp.DecodeBytes(common.FromHex(test.Input), tx))
+	} else {
+		value := new(big.Int)
+		gasPrice := new(big.Int)
+		require.NoError(t, value.UnmarshalJSON([]byte(test.Transaction["value"])))
+		require.NoError(t, gasPrice.UnmarshalJSON([]byte(test.Transaction["gasPrice"])))
+		nonce, ok := math.ParseUint64(test.Transaction["nonce"])
+		require.True(t, ok)
+		gas, ok := math.ParseUint64(test.Transaction["gas"])
+		require.True(t, ok)
+
+		to := common.HexToAddress(test.Transaction["to"])
+		input := common.FromHex(test.Transaction["input"])
+		tx = types.NewTransaction(nonce, to, value, gas, gasPrice, input)
+
+		testKey, err := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		require.NoError(t, err)
+		require.NoError(t, tx.Sign(signer, testKey))
+	}
+	origin, _ := signer.Sender(tx)
+
+	context := vm.Context{
+		CanTransfer: blockchain.CanTransfer,
+		Transfer:    blockchain.Transfer,
+		Origin:      origin,
+		BlockNumber: new(big.Int).SetUint64(uint64(test.Context.Number)),
+		Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+		BlockScore:  (*big.Int)(test.Context.BlockScore),
+		GasLimit:    uint64(test.Context.GasLimit),
+		GasPrice:    tx.GasPrice(),
+	}
+	statedb := tests.MakePreState(database.NewMemoryDBManager(), test.Genesis.Alloc)
+	evm := vm.NewEVM(context, statedb, test.Genesis.Config, &vm.Config{Debug: true, Tracer: tracer})
+
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{})
+	msg, err := tx.AsMessageWithAccountKeyPicker(signer, statedb, context.BlockNumber.Uint64())
+	require.NoError(t, err)
+	st := blockchain.NewStateTransition(evm, msg)
+	_, _, kerr := st.TransitionDb()
+	require.NoError(t, kerr.ErrTxInvalid)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	return res
+}
+
+// Iterates over all the input-output datasets in the tracer test harness and
+// checks that every native tracer produces the same result as its JavaScript
+// counterpart.
+func TestNativeTracers(t *testing.T) {
+	files, err := ioutil.ReadDir("testdata")
+	if err != nil {
+		t.Fatalf("failed to retrieve tracer test suite: %v", err)
+	}
+	for _, file := range files {
+		if !strings.HasPrefix(file.Name(), "call_tracer_") {
+			continue
+		}
+		blob, err := ioutil.ReadFile(filepath.Join("testdata", file.Name()))
+		if err != nil {
+			t.Fatalf("failed to read testcase: %v", err)
+		}
+		test := new(callTracerTest)
+		if err := json.Unmarshal(blob, test); err != nil {
+			t.Fatalf("failed to parse testcase: %v", err)
+		}
+		for name := range nativeTracers {
+			name := name // capture range variable
+			testName := camel(strings.TrimSuffix(strings.TrimPrefix(file.Name(), "call_tracer_"), ".json"))
+			t.Run(name+"/"+testName, func(t *testing.T) {
+				jsTracer, err := New(name + jsTracerSuffix)
+				require.NoError(t, err)
+				nativeTracer, ok := NewNativeTracer(name)
+				require.True(t, ok)
+
+				var want, have map[string]interface{}
+				if name == "revertTracer" {
+					var wantStr, haveStr string
+					require.NoError(t, json.Unmarshal(runTracer(t, test, jsTracer), &wantStr))
+					require.NoError(t, json.Unmarshal(runTracer(t, test, nativeTracer), &haveStr))
+					assert.Equal(t, wantStr, haveStr)
+					return
+				}
+				require.NoError(t, json.Unmarshal(runTracer(t, test, jsTracer), &want))
+				require.NoError(t, json.Unmarshal(runTracer(t, test, nativeTracer), &have))
+
+				// The execution time differs on every run.
+				delete(want, "time")
+				delete(have, "time")
+				assert.Equal(t, want, have)
+			})
+		}
+	}
+}
+
+func TestNewNativeTracer(t *testing.T) {
+	for name := range nativeTracers {
+		nativeTracer, ok := NewNativeTracer(name)
+		assert.True(t, ok)
+		assert.NotNil(t, nativeTracer)
+
+		// The JavaScript version should still be available as a fallback.
+		_, ok = tracer(name + jsTracerSuffix)
+		assert.True(t, ok)
+	}
+	_, ok := NewNativeTracer("noopTracer")
+	assert.False(t, ok)
+}
+
+// newNativeTracerTest returns a test case sending a value from the test account
+// to the given account which has the given code.
+func newNativeTracerTest(to common.Address, code []byte) *callTracerTest {
+	testKey, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	alloc := blockchain.GenesisAlloc{
+		crypto.PubkeyToAddress(testKey.PublicKey): {Balance: big.NewInt(params.KLAY), Nonce: 1},
+	}
+	if code != nil {
+		alloc[to] = blockchain.GenesisAccount{Balance: big.NewInt(0), Code: code, Nonce: 1}
+	}
+	return &callTracerTest{
+		Genesis: &blockchain.Genesis{Config: &params.ChainConfig{ChainID: big.NewInt(1)}, Alloc: alloc},
+		Context: &callContext{Number: 1, GasLimit: 1000000, BlockScore: (*math.HexOrDecimal256)(big.NewInt(1))},
+		Transaction: map[string]string{
+			"nonce":    "1",
+			"to":       to.Hex(),
+			"value":    "16",
+			"gas":      "100000",
+			"gasPrice": "1",
+			"input":    "0x",
+		},
+	}
+}
+
+// TestPrestateTracerValueTransfer checks that the prestate of the sender and the
+// recipient is returned even if no opcode has been executed.
+func TestPrestateTracerValueTransfer(t *testing.T) {
+	to := common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+	test := newNativeTracerTest(to, nil)
+
+	jsTracer, err := New("prestateTracer" + jsTracerSuffix)
+	require.NoError(t, err)
+	nativeTracer, ok := NewNativeTracer("prestateTracer")
+	require.True(t, ok)
+
+	var want, have map[common.Address]map[string]interface{}
+	require.NoError(t, json.Unmarshal(runTracer(t, test, jsTracer), &want))
+	require.NoError(t, json.Unmarshal(runTracer(t, test, nativeTracer), &have))
+	assert.Equal(t, want, have)
+
+	// The value is moved back to the sender, and the nonce is decremented.
+	require.Equal(t, 2, len(have))
+	for addr, account := range test.Genesis.Alloc {
+		assert.Equal(t, float64(account.Nonce), have[addr]["nonce"])
+	}
+	assert.Equal(t, "0x0", have[to]["balance"])
+}
+
+// TestRevertTracerNonASCII checks that every byte of the revert reason is
+// converted to a character as the JavaScript version does.
+func TestRevertTracerNonASCII(t *testing.T) {
+	reason := []byte("caf\xc3\xa9 \xff")
+	payload := append(common.CopyBytes(revertSelector), common.LeftPadBytes([]byte{0x20}, 32)...)
+	payload = append(payload, common.LeftPadBytes([]byte{byte(len(reason))}, 32)...)
+	payload = append(payload, common.RightPadBytes(reason, 32)...)
+
+	// CODECOPY the payload following the code to the memory and REVERT with it
+	code := []byte{
+		byte(vm.PUSH2), 0, byte(len(payload)), byte(vm.DUP1), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0,
+		byte(vm.CODECOPY), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}
+	code = append(code, payload...)
+	test := newNativeTracerTest(common.HexToAddress("0x00000000000000000000000000000000c0ffee00"), code)
+
+	jsTracer, err := New("revertTracer" + jsTracerSuffix)
+	require.NoError(t, err)
+	nativeTracer, ok := NewNativeTracer("revertTracer")
+	require.True(t, ok)
+
+	var want, have string
+	require.NoError(t, json.Unmarshal(runTracer(t, test, jsTracer), &want))
+	require.NoError(t, json.Unmarshal(runTracer(t, test, nativeTracer), &have))
+	assert.Equal(t, "caf\u00c3\u00a9 \u00ff", want)
+	assert.Equal(t, want, have)
+}
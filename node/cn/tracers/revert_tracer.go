@@ -0,0 +1,113 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This file is derived from internal/tracers/revert_tracer.js.
+
+package tracers
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+)
+
+// revertSelector is the selector of Error(string), i.e. crypto.Keccak256([]byte("Error(string)"))[:4].
+var revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// revertTracer returns the string of REVERT.
+// If not reverted, returns an empty string "".
+type revertTracer struct {
+	output []byte
+	err    error
+}
+
+func newRevertTracer() NativeTracer {
+	return &revertTracer{}
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *revertTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *revertTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *revertTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *revertTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	t.output = common.CopyBytes(output)
+	t.err = err
+	return nil
+}
+
+// GetResult returns the revert reason if the execution has been reverted.
+func (t *revertTracer) GetResult() (json.RawMessage, error) {
+	return json.Marshal(t.revertString())
+}
+
+// revertString decodes the Error(string) encoded output of a reverted execution.
+// Like the JavaScript version, the length is assumed to follow the offset word
+// and the string starts after the offset, so a malformed output results in an
+// empty or a truncated string instead of an error. Every byte of the string is
+// converted to the character of the same code point as toAscii does, instead
+// of being decoded as UTF-8.
+func (t *revertTracer) revertString() string {
+	if t.err != vm.ErrExecutionReverted || len(t.output) < 4 || !bytes.Equal(t.output[:4], revertSelector) {
+		return ""
+	}
+	data := t.output[4:]
+	if len(data) < 64 {
+		return ""
+	}
+	offset := new(big.Int).SetBytes(data[:32])
+	length := new(big.Int).SetBytes(data[32:64])
+	if !offset.IsUint64() || !length.IsUint64() {
+		return ""
+	}
+	start := 32 + offset.Uint64()
+	end := start + length.Uint64()
+	if start > uint64(len(data)) || end < start {
+		return ""
+	}
+	if end > uint64(len(data)) {
+		end = uint64(len(data))
+	}
+	return toASCII(data[start:end])
+}
+
+// toASCII converts every byte to the character of the same code point.
+func toASCII(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// Stop does nothing since the revert tracer does not trace any step.
+func (t *revertTracer) Stop(err error) {}
@@ -18,7 +18,7 @@
 // This file is derived from eth/tracers/tracers.go (2018/06/04).
 // Modified and improved for the klaytn development.
 
-// Package tracers is a collection of JavaScript transaction tracers.
+// Package tracers is a collection of JavaScript and native transaction tracers.
 package tracers
 
 import (
@@ -41,10 +41,15 @@ func camel(str string) string {
 }
 
 // init retrieves the JavaScript transaction tracers included in Klaytn.
+// A JavaScript tracer shadowed by a native tracer is also registered with
+// jsTracerSuffix, so that it can be selected explicitly.
 func init() {
 	for _, file := range tracers.AssetNames() {
 		name := camel(strings.TrimSuffix(file, ".js"))
 		all[name] = string(tracers.MustAsset(file))
+		if _, ok := nativeTracers[name]; ok {
+			all[name+jsTracerSuffix] = all[name]
+		}
 	}
 }
 
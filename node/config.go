@@ -46,6 +46,7 @@ const (
 	datadirStaticNodes     = "static-nodes.json"  // Path within the datadir to the static node list
 	datadirTrustedNodes    = "trusted-nodes.json" // Path within the datadir to the trusted node list
 	datadirNodeDatabase    = "nodes"              // Path within the datadir to store the node infos
+	datadirJWTSecret       = "jwtsecret"          // Path within the datadir to the JWT secret of the authenticated RPC endpoint
 )
 
 // Config represents a small collection of configuration values to fine tune the
@@ -166,6 +167,26 @@ type Config struct {
 	// ephemeral nodes).
 	GRPCPort int `toml:",omitempty"`
 
+	// AuthHost is the host interface on which to start the JWT-authenticated HTTP and
+	// websocket RPC server. If this field is empty, no authenticated endpoint will be started.
+	AuthHost string `toml:",omitempty"`
+
+	// AuthPort is the TCP port number on which to start the authenticated RPC server.
+	AuthPort int `toml:",omitempty"`
+
+	// AuthVirtualHosts is the list of virtual hostnames which are allowed on incoming
+	// requests to the authenticated RPC server.
+	AuthVirtualHosts []string `toml:",omitempty"`
+
+	// AuthModules is a list of API modules to expose via the authenticated RPC interface.
+	// If the module list is empty, all RPC API endpoints will be exposed.
+	AuthModules []string `toml:",omitempty"`
+
+	// JWTSecret is the path to the file of the hex-encoded 32-byte secret which the tokens
+	// of the authenticated RPC requests are signed with. A new secret is generated if the
+	// file does not exist. If empty, the file in the instance directory is used.
+	JWTSecret string `toml:",omitempty"`
+
 	// Logger is a custom logger to use with the p2p.Server.
 	Logger log.Logger `toml:",omitempty"`
 }
@@ -259,6 +280,23 @@ func DefaultGRPCEndpoint() string {
 	return config.GRPCEndpoint()
 }
 
+// AuthEndpoint resolves the authenticated RPC endpoint based on the configured host
+// interface and port parameters.
+func (c *Config) AuthEndpoint() string {
+	if c.AuthHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.AuthHost, c.AuthPort)
+}
+
+// JWTSecretPath returns the path to the JWT secret of the authenticated RPC endpoint.
+func (c *Config) JWTSecretPath() string {
+	if c.JWTSecret != "" {
+		return c.JWTSecret
+	}
+	return c.ResolvePath(datadirJWTSecret)
+}
+
 // NodeName returns the devp2p node identifier.
 func (c *Config) NodeName() string {
 	name := c.name()
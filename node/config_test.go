@@ -155,3 +155,38 @@ func TestNodeKeyPersistency(t *testing.T) {
 		}
 	*/
 }
+
+// Tests that the JWT secret is generated if missing, and the persisted one is loaded.
+func TestJWTSecretPersistency(t *testing.T) {
+	dir, err := ioutil.TempDir("", "node-test")
+	if err != nil {
+		t.Fatalf("failed to create temporary data directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := &Config{Name: "unit-test", DataDir: dir}
+	path := config.JWTSecretPath()
+	if path != filepath.Join(dir, "unit-test", datadirJWTSecret) {
+		t.Fatalf("JWT secret path mismatch: have %s", path)
+	}
+
+	secret1, err := obtainJWTSecret(path)
+	if err != nil {
+		t.Fatalf("failed to generate JWT secret: %v", err)
+	}
+	secret2, err := obtainJWTSecret(path)
+	if err != nil {
+		t.Fatalf("failed to load JWT secret: %v", err)
+	}
+	if !bytes.Equal(secret1, secret2) {
+		t.Fatalf("persisted JWT secret mismatch: have %x, want %x", secret2, secret1)
+	}
+
+	// Secrets of an invalid length are rejected
+	if err := ioutil.WriteFile(path, []byte("0x1234"), 0o600); err != nil {
+		t.Fatalf("failed to write JWT secret: %v", err)
+	}
+	if _, err := obtainJWTSecret(path); err == nil {
+		t.Fatalf("invalid JWT secret loaded")
+	}
+}
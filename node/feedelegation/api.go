@@ -0,0 +1,57 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+// PublicFeeDelegationAPI provides the APIs co-signing the sender-signed fee-delegated transactions.
+type PublicFeeDelegationAPI struct {
+	fd *FeeDelegation
+}
+
+func NewPublicFeeDelegationAPI(fd *FeeDelegation) *PublicFeeDelegationAPI {
+	return &PublicFeeDelegationAPI{fd: fd}
+}
+
+// FeePayer returns the address of the fee payer signing the transactions.
+func (api *PublicFeeDelegationAPI) FeePayer() common.Address {
+	return api.fd.config.FeePayer
+}
+
+// SendRawTransaction signs the RLP-encoded sender-signed fee-delegated transaction as the
+// fee payer and submits it to the transaction pool. It returns the hash of the transaction.
+func (api *PublicFeeDelegationAPI) SendRawTransaction(encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx, err := api.fd.signTransaction(encodedTx, true)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// SignRawTransaction signs the RLP-encoded sender-signed fee-delegated transaction as the
+// fee payer and returns the RLP-encoded transaction without submitting it.
+func (api *PublicFeeDelegationAPI) SignRawTransaction(encodedTx hexutil.Bytes) (hexutil.Bytes, error) {
+	tx, err := api.fd.signTransaction(encodedTx, false)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(tx)
+}
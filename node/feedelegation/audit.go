@@ -0,0 +1,85 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+)
+
+const (
+	auditStatusSigned    = "signed"
+	auditStatusSubmitted = "submitted"
+	auditStatusRejected  = "rejected"
+)
+
+// auditRecord is a line of the audit log describing a request and its result.
+type auditRecord struct {
+	Time         time.Time       `json:"time"`
+	Status       string          `json:"status"`
+	Error        string          `json:"error,omitempty"`
+	Type         string          `json:"type,omitempty"`
+	Sender       *common.Address `json:"sender,omitempty"`
+	To           *common.Address `json:"to,omitempty"`
+	Method       string          `json:"method,omitempty"`
+	Gas          uint64          `json:"gas,omitempty"`
+	FeeRatio     types.FeeRatio  `json:"feeRatio,omitempty"`
+	SenderTxHash *common.Hash    `json:"senderTxHash,omitempty"`
+	TxHash       *common.Hash    `json:"txHash,omitempty"`
+}
+
+// auditLog appends the records to a file as JSON lines.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+func newAuditLog(path string) (*auditLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLog{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+func (l *auditLog) write(record *auditRecord) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.enc.Encode(record); err != nil {
+		logger.Error("Failed to write the audit log", "err", err)
+	}
+}
+
+func (l *auditLog) close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.file.Close()
+}
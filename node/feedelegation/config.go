@@ -0,0 +1,53 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+)
+
+const (
+	DefaultSenderRateLimit   = 10
+	DefaultRateLimitInterval = time.Minute
+	DefaultAuditLogFile      = "feedelegation_audit.log"
+)
+
+type Config struct {
+	EnabledFeeDelegation bool
+
+	FeePayer   common.Address // Keystore account signing the transactions as the fee payer
+	PolicyFile string         // JSON file of the allowed contracts and methods (empty = any contract and method)
+
+	MaxFeeRatio types.FeeRatio // Maximum ratio of the fee paid by the fee payer in percentage
+	MaxGas      uint64         // Maximum gas limit of a transaction (0 = no limit)
+
+	SenderRateLimit   int           // Maximum number of transactions of a sender in RateLimitInterval (0 = no limit)
+	RateLimitInterval time.Duration // Interval in which the transactions of a sender are counted
+
+	AuditLogFile string // File to which the requests are appended as JSON lines (empty = no audit log)
+}
+
+var DefaultConfig = &Config{
+	EnabledFeeDelegation: false,
+	MaxFeeRatio:          types.MaxFeeRatio,
+	SenderRateLimit:      DefaultSenderRateLimit,
+	RateLimitInterval:    DefaultRateLimitInterval,
+	AuditLogFile:         DefaultAuditLogFile,
+}
@@ -0,0 +1,27 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package feedelegation implements a fee-delegation relay service which co-signs the
+sender-signed fee-delegated transactions with a keystore account as the fee payer.
+Source Files
+  - api.go                   : includes feedelegation-related APIs
+  - audit.go                 : implements the audit log of the requests
+  - config.go                : includes feedelegation configurations
+  - feedelegation.go         : implements feedelegation main operations
+  - policy.go                : implements the signing policy and the per-sender rate limiter
+*/
+package feedelegation
@@ -0,0 +1,216 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+var (
+	logger = log.NewModuleLogger(log.NodeFeeDelegation)
+
+	errNoFeePayer = errors.New("fee payer of the fee delegation service is not set")
+	errNotReady   = errors.New("fee delegation service is not ready")
+)
+
+// BlockChain is the interface of the blockchain used to validate the sender of a transaction.
+type BlockChain interface {
+	Config() *params.ChainConfig
+	CurrentBlock() *types.Block
+	State() (*state.StateDB, error)
+}
+
+// TxPool is the interface of the transaction pool to which the co-signed transactions are submitted.
+type TxPool interface {
+	AddLocal(tx *types.Transaction) error
+}
+
+// FeeDelegation is a node service which signs the sender-signed fee-delegated
+// transactions as the fee payer if they are allowed by the policy.
+type FeeDelegation struct {
+	config *Config
+
+	policy  *policy
+	limiter *rateLimiter
+	audit   *auditLog
+
+	wallet     accounts.Wallet
+	blockchain BlockChain
+	txPool     TxPool
+}
+
+func NewFeeDelegation(ctx *node.ServiceContext, cfg *Config) (*FeeDelegation, error) {
+	if cfg.FeePayer == (common.Address{}) {
+		return nil, errNoFeePayer
+	}
+	policy, err := newPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := ctx.AccountManager.Find(accounts.Account{Address: cfg.FeePayer})
+	if err != nil {
+		logger.Error("Failed to find the fee payer account in the keystore", "feePayer", cfg.FeePayer, "err", err)
+		return nil, err
+	}
+
+	fd := &FeeDelegation{
+		config:  cfg,
+		policy:  policy,
+		limiter: newRateLimiter(cfg.SenderRateLimit, cfg.RateLimitInterval),
+		wallet:  wallet,
+	}
+	if cfg.AuditLogFile != "" {
+		if fd.audit, err = newAuditLog(ctx.ResolvePath(cfg.AuditLogFile)); err != nil {
+			return nil, err
+		}
+	}
+	return fd, nil
+}
+
+func (fd *FeeDelegation) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+func (fd *FeeDelegation) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "feedelegation",
+			Version:   "1.0",
+			Service:   NewPublicFeeDelegationAPI(fd),
+			Public:    true,
+		},
+	}
+}
+
+func (fd *FeeDelegation) Start(server p2p.Server) error {
+	logger.Info("fee delegation service is started", "feePayer", fd.config.FeePayer,
+		"maxFeeRatio", fd.config.MaxFeeRatio, "maxGas", fd.config.MaxGas, "senderRateLimit", fd.config.SenderRateLimit)
+	return nil
+}
+
+func (fd *FeeDelegation) Stop() error {
+	if err := fd.audit.close(); err != nil {
+		logger.Error("Failed to close the audit log", "err", err)
+	}
+	logger.Info("fee delegation service is stopped")
+	return nil
+}
+
+func (fd *FeeDelegation) Components() []interface{} {
+	return nil
+}
+
+func (fd *FeeDelegation) SetComponents(components []interface{}) {
+	for _, component := range components {
+		switch v := component.(type) {
+		case *blockchain.BlockChain:
+			fd.blockchain = v
+		case *blockchain.TxPool:
+			fd.txPool = v
+		}
+	}
+}
+
+// signTransaction decodes a sender-signed fee-delegated transaction and signs it as the fee payer
+// if the transaction is allowed by the policy. The signed transaction is submitted to the
+// transaction pool if submit is true. Every request is recorded in the audit log.
+func (fd *FeeDelegation) signTransaction(encodedTx []byte, submit bool) (*types.Transaction, error) {
+	record := &auditRecord{Time: time.Now(), Status: auditStatusRejected}
+	defer fd.audit.write(record)
+
+	tx, err := fd.signTransactionWithRecord(encodedTx, submit, record)
+	if err != nil {
+		record.Error = err.Error()
+		logger.Debug("Rejected the fee delegation request", "sender", record.Sender, "err", err)
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (fd *FeeDelegation) signTransactionWithRecord(encodedTx []byte, submit bool, record *auditRecord) (*types.Transaction, error) {
+	if fd.blockchain == nil || fd.txPool == nil {
+		return nil, errNotReady
+	}
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return nil, err
+	}
+	record.Type = tx.Type().String()
+	record.To = tx.To()
+	record.Gas = tx.Gas()
+	if senderTxHash, ok := tx.SenderTxHash(); ok {
+		record.SenderTxHash = &senderTxHash
+	}
+	if ratio, ok := tx.FeeRatio(); ok {
+		record.FeeRatio = ratio
+	}
+
+	method, err := fd.policy.check(tx)
+	if err != nil {
+		return nil, err
+	}
+	record.Method = method
+
+	// The sender is validated before being rate-limited, so that a sender cannot be forged.
+	head := fd.blockchain.CurrentBlock()
+	statedb, err := fd.blockchain.State()
+	if err != nil {
+		return nil, err
+	}
+	signer := types.MakeSigner(fd.blockchain.Config(), head.Number())
+	if _, err := tx.ValidateSender(signer, statedb, head.NumberU64()); err != nil {
+		return nil, err
+	}
+	sender, err := tx.From()
+	if err != nil {
+		return nil, err
+	}
+	record.Sender = &sender
+	if !fd.limiter.allow(sender, record.Time) {
+		return nil, errSenderRateLimited
+	}
+
+	signedTx, err := fd.wallet.SignTxAsFeePayer(accounts.Account{Address: fd.config.FeePayer}, tx, fd.blockchain.Config().ChainID)
+	if err != nil {
+		return nil, err
+	}
+	txHash := signedTx.Hash()
+	record.TxHash = &txHash
+	record.Status = auditStatusSigned
+
+	if submit {
+		if err := fd.txPool.AddLocal(signedTx); err != nil {
+			record.Status = auditStatusRejected
+			return nil, err
+		}
+		record.Status = auditStatusSubmitted
+	}
+	return signedTx, nil
+}
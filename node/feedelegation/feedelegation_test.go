@@ -0,0 +1,180 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/node"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlockChain struct {
+	db state.Database
+}
+
+func (bc *testBlockChain) Config() *params.ChainConfig { return params.TestChainConfig }
+
+func (bc *testBlockChain) CurrentBlock() *types.Block {
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0)})
+}
+
+func (bc *testBlockChain) State() (*state.StateDB, error) {
+	return state.New(common.Hash{}, bc.db, nil)
+}
+
+type testTxPool struct {
+	txs []*types.Transaction
+}
+
+func (pool *testTxPool) AddLocal(tx *types.Transaction) error {
+	pool.txs = append(pool.txs, tx)
+	return nil
+}
+
+func newTestFeeDelegation(t *testing.T, cfg *Config) (*FeeDelegation, *testTxPool, string) {
+	dir, err := ioutil.TempDir("", "feedelegation")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	ks := keystore.NewKeyStore(filepath.Join(dir, "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	account, err := ks.ImportECDSA(key, "")
+	require.NoError(t, err)
+	require.NoError(t, ks.Unlock(account, ""))
+
+	cfg.FeePayer = account.Address
+	ctx := node.NewServiceContext(&node.Config{DataDir: dir}, nil, nil, accounts.NewManager(ks))
+	// The instance directory is created by the node before the services in production.
+	auditPath := ctx.ResolvePath(cfg.AuditLogFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(auditPath), 0o700))
+	fd, err := NewFeeDelegation(ctx, cfg)
+	require.NoError(t, err)
+
+	pool := &testTxPool{}
+	fd.blockchain = &testBlockChain{db: state.NewDatabase(database.NewMemoryDBManager())}
+	fd.txPool = pool
+	return fd, pool, auditPath
+}
+
+func readAuditRecords(t *testing.T, path string) []auditRecord {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []auditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record auditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNewFeeDelegation_UnknownFeePayer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feedelegation")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+	ctx := node.NewServiceContext(&node.Config{DataDir: dir}, nil, nil, accounts.NewManager(ks))
+
+	cfg := *DefaultConfig
+	_, err = NewFeeDelegation(ctx, &cfg)
+	assert.Equal(t, errNoFeePayer, err)
+
+	cfg.FeePayer = testFeePayer
+	_, err = NewFeeDelegation(ctx, &cfg)
+	assert.Equal(t, accounts.ErrUnknownAccount, err)
+}
+
+func TestFeeDelegation_SignTransaction(t *testing.T) {
+	cfg := *DefaultConfig
+	cfg.SenderRateLimit = 1
+	fd, pool, auditPath := newTestFeeDelegation(t, &cfg)
+	api := NewPublicFeeDelegationAPI(fd)
+	assert.Equal(t, cfg.FeePayer, api.FeePayer())
+
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+
+	tx := newTestTx(t, types.TxTypeFeeDelegatedValueTransfer, sender, testContract, cfg.FeePayer, 50000, nil, 0)
+	require.NoError(t, tx.Sign(signer, senderKey))
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	require.NoError(t, err)
+
+	// SignRawTransaction returns the transaction signed by the fee payer without submitting it.
+	signed, err := api.SignRawTransaction(encodedTx)
+	require.NoError(t, err)
+	signedTx := new(types.Transaction)
+	require.NoError(t, rlp.DecodeBytes(signed, signedTx))
+	feePayer, err := types.SenderFeePayer(signer, signedTx)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.FeePayer, feePayer)
+	assert.Empty(t, pool.txs)
+
+	// The sender has reached the rate limit.
+	_, err = api.SendRawTransaction(encodedTx)
+	assert.Equal(t, errSenderRateLimited, err)
+	assert.Empty(t, pool.txs)
+
+	// A transaction whose sender signature is not valid is rejected.
+	forged := newTestTx(t, types.TxTypeFeeDelegatedValueTransfer, testSender, testContract, cfg.FeePayer, 50000, nil, 0)
+	require.NoError(t, forged.Sign(signer, senderKey))
+	encodedForged, err := rlp.EncodeToBytes(forged)
+	require.NoError(t, err)
+	_, err = api.SendRawTransaction(encodedForged)
+	assert.Error(t, err)
+
+	// SendRawTransaction submits the signed transaction to the transaction pool.
+	fd.limiter = newRateLimiter(0, cfg.RateLimitInterval)
+	hash, err := api.SendRawTransaction(encodedTx)
+	require.NoError(t, err)
+	require.Len(t, pool.txs, 1)
+	assert.Equal(t, hash, pool.txs[0].Hash())
+
+	require.NoError(t, fd.Stop())
+	records := readAuditRecords(t, auditPath)
+	require.Len(t, records, 4)
+	assert.Equal(t, auditStatusSigned, records[0].Status)
+	assert.Equal(t, sender, *records[0].Sender)
+	assert.Equal(t, auditStatusRejected, records[1].Status)
+	assert.Equal(t, errSenderRateLimited.Error(), records[1].Error)
+	assert.Equal(t, auditStatusRejected, records[2].Status)
+	assert.Equal(t, auditStatusSubmitted, records[3].Status)
+	assert.Equal(t, hash, *records[3].TxHash)
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+)
+
+var (
+	errNotFeeDelegated     = errors.New("transaction is not a fee-delegated transaction")
+	errFeePayerMismatch    = errors.New("fee payer of the transaction is not the fee payer of the service")
+	errFeeRatioExceeded    = errors.New("fee ratio of the transaction exceeds the maximum fee ratio")
+	errGasLimitExceeded    = errors.New("gas limit of the transaction exceeds the maximum gas")
+	errContractNotAllowed  = errors.New("transaction does not execute an allowed contract")
+	errMethodNotAllowed    = errors.New("transaction does not call an allowed method")
+	errSenderRateLimited   = errors.New("too many transactions of the sender")
+	errInvalidMaxFeeRatio  = errors.New("maximum fee ratio should be in the range [1, 100]")
+	errMethodsWithoutABI   = errors.New("abi is required to allow the methods")
+	errUnknownPolicyMethod = errors.New("method is not found in the abi")
+)
+
+// ContractPolicy allows the fee-delegated executions of a contract.
+// If Methods is empty, any method of the contract is allowed.
+type ContractPolicy struct {
+	Address common.Address  `json:"address"`
+	ABI     json.RawMessage `json:"abi,omitempty"`
+	Methods []string        `json:"methods,omitempty"`
+}
+
+// PolicyFile is the content of the policy file. If Contracts is empty, any
+// fee-delegated transaction is allowed regardless of its recipient.
+type PolicyFile struct {
+	Contracts []ContractPolicy `json:"contracts"`
+}
+
+// contractRule is the allowed methods of a contract. A nil methods allows any method.
+type contractRule struct {
+	methods map[string]string // method ID => method name
+}
+
+// policy decides whether a sender-signed transaction is signed by the fee payer.
+type policy struct {
+	feePayer    common.Address
+	maxFeeRatio types.FeeRatio
+	maxGas      uint64
+	contracts   map[common.Address]*contractRule // nil allows any recipient
+}
+
+func newPolicy(cfg *Config) (*policy, error) {
+	if cfg.MaxFeeRatio < 1 || cfg.MaxFeeRatio > types.MaxFeeRatio {
+		return nil, errInvalidMaxFeeRatio
+	}
+	p := &policy{
+		feePayer:    cfg.FeePayer,
+		maxFeeRatio: cfg.MaxFeeRatio,
+		maxGas:      cfg.MaxGas,
+	}
+	if cfg.PolicyFile == "" {
+		return p, nil
+	}
+
+	data, err := ioutil.ReadFile(cfg.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	var file PolicyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %v", cfg.PolicyFile, err)
+	}
+	if err := p.setContracts(file.Contracts); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *policy) setContracts(contracts []ContractPolicy) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+	p.contracts = make(map[common.Address]*contractRule, len(contracts))
+	for _, c := range contracts {
+		rule := &contractRule{}
+		if len(c.Methods) > 0 {
+			if len(c.ABI) == 0 {
+				return fmt.Errorf("%v: %s", errMethodsWithoutABI, c.Address.String())
+			}
+			parsed, err := abi.JSON(bytes.NewReader(c.ABI))
+			if err != nil {
+				return fmt.Errorf("invalid abi of %s: %v", c.Address.String(), err)
+			}
+			rule.methods = make(map[string]string, len(c.Methods))
+			for _, name := range c.Methods {
+				method, ok := parsed.Methods[name]
+				if !ok {
+					return fmt.Errorf("%v: %s of %s", errUnknownPolicyMethod, name, c.Address.String())
+				}
+				rule.methods[string(method.ID)] = method.Sig
+			}
+		}
+		p.contracts[c.Address] = rule
+	}
+	return nil
+}
+
+// check returns an error if the transaction is not allowed to be signed by the fee payer.
+// It returns the signature of the called method if the method is restricted by the policy.
+func (p *policy) check(tx *types.Transaction) (string, error) {
+	if !tx.IsFeeDelegatedTransaction() {
+		return "", errNotFeeDelegated
+	}
+	if feePayer, err := tx.FeePayer(); err != nil || feePayer != p.feePayer {
+		return "", errFeePayerMismatch
+	}
+	// The fee payer pays the whole fee if the transaction has no fee ratio.
+	if ratio, _ := tx.FeeRatio(); ratio > p.maxFeeRatio {
+		return "", errFeeRatioExceeded
+	}
+	if p.maxGas != 0 && tx.Gas() > p.maxGas {
+		return "", errGasLimitExceeded
+	}
+	if p.contracts == nil {
+		return "", nil
+	}
+
+	switch tx.Type() {
+	case types.TxTypeFeeDelegatedSmartContractExecution, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio:
+	default:
+		return "", errContractNotAllowed
+	}
+	rule, ok := p.contracts[*tx.To()]
+	if !ok {
+		return "", errContractNotAllowed
+	}
+	if rule.methods == nil {
+		return "", nil
+	}
+	data := tx.Data()
+	if len(data) < 4 {
+		return "", errMethodNotAllowed
+	}
+	sig, ok := rule.methods[string(data[:4])]
+	if !ok {
+		return "", errMethodNotAllowed
+	}
+	return sig, nil
+}
+
+// rateLimiter limits the number of the transactions of a sender in a fixed time window.
+type rateLimiter struct {
+	limit    int
+	interval time.Duration
+
+	mu          sync.Mutex
+	windowStart time.Time
+	counts      map[common.Address]int
+}
+
+func newRateLimiter(limit int, interval time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:    limit,
+		interval: interval,
+		counts:   make(map[common.Address]int),
+	}
+}
+
+// allow returns true and counts the transaction if the sender has not reached the limit.
+func (l *rateLimiter) allow(sender common.Address, now time.Time) bool {
+	if l.limit <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// The counts of all senders are reset together, so the memory is bounded by the window.
+	if now.Sub(l.windowStart) >= l.interval {
+		l.windowStart = now
+		l.counts = make(map[common.Address]int)
+	}
+	if l.counts[sender] >= l.limit {
+		return false
+	}
+	l.counts[sender]++
+	return true
+}
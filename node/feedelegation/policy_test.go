@@ -0,0 +1,217 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feedelegation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[
+	{"constant":false,"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"type":"function"},
+	{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}
+]`
+
+var (
+	testContract = common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	testFeePayer = common.HexToAddress("0x000000000000000000000000000000000000fee0")
+	testSender   = common.HexToAddress("0x0000000000000000000000000000000000005e0d")
+
+	transferID = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	approveID  = crypto.Keccak256([]byte("approve(address,uint256)"))[:4]
+)
+
+func newTestTx(t *testing.T, txType types.TxType, from, to, feePayer common.Address, gas uint64, data []byte, ratio types.FeeRatio) *types.Transaction {
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyFrom:     from,
+		types.TxValueKeyTo:       to,
+		types.TxValueKeyAmount:   big.NewInt(0),
+		types.TxValueKeyGasLimit: gas,
+		types.TxValueKeyGasPrice: big.NewInt(25000000000),
+	}
+	switch txType {
+	case types.TxTypeValueTransfer:
+	case types.TxTypeFeeDelegatedValueTransfer:
+		values[types.TxValueKeyFeePayer] = feePayer
+	case types.TxTypeFeeDelegatedSmartContractExecution:
+		values[types.TxValueKeyFeePayer] = feePayer
+		values[types.TxValueKeyData] = data
+	case types.TxTypeFeeDelegatedSmartContractExecutionWithRatio:
+		values[types.TxValueKeyFeePayer] = feePayer
+		values[types.TxValueKeyData] = data
+		values[types.TxValueKeyFeeRatioOfFeePayer] = ratio
+	default:
+		t.Fatalf("unsupported tx type %v", txType)
+	}
+	tx, err := types.NewTransactionWithMap(txType, values)
+	require.NoError(t, err)
+	return tx
+}
+
+func writePolicyFile(t *testing.T, file PolicyFile) string {
+	data, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "feedelegation-policy")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "policy.json")
+	require.NoError(t, ioutil.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestNewPolicy_Errors(t *testing.T) {
+	cfg := *DefaultConfig
+	cfg.FeePayer = testFeePayer
+
+	cfg.MaxFeeRatio = 0
+	_, err := newPolicy(&cfg)
+	assert.Equal(t, errInvalidMaxFeeRatio, err)
+
+	cfg.MaxFeeRatio = types.MaxFeeRatio + 1
+	_, err = newPolicy(&cfg)
+	assert.Equal(t, errInvalidMaxFeeRatio, err)
+
+	cfg.MaxFeeRatio = types.MaxFeeRatio
+	cfg.PolicyFile = writePolicyFile(t, PolicyFile{Contracts: []ContractPolicy{
+		{Address: testContract, Methods: []string{"transfer"}},
+	}})
+	_, err = newPolicy(&cfg)
+	assert.Error(t, err)
+
+	cfg.PolicyFile = writePolicyFile(t, PolicyFile{Contracts: []ContractPolicy{
+		{Address: testContract, ABI: json.RawMessage(testABI), Methods: []string{"transferFrom"}},
+	}})
+	_, err = newPolicy(&cfg)
+	assert.Error(t, err)
+}
+
+func TestPolicy_Check(t *testing.T) {
+	cfg := *DefaultConfig
+	cfg.FeePayer = testFeePayer
+	cfg.MaxFeeRatio = 50
+	cfg.MaxGas = 100000
+	cfg.PolicyFile = writePolicyFile(t, PolicyFile{Contracts: []ContractPolicy{
+		{Address: testContract, ABI: json.RawMessage(testABI), Methods: []string{"transfer"}},
+	}})
+	p, err := newPolicy(&cfg)
+	require.NoError(t, err)
+
+	other := common.HexToAddress("0x0000000000000000000000000000000000000bad")
+	transfer := append(common.CopyBytes(transferID), make([]byte, 64)...)
+	approve := append(common.CopyBytes(approveID), make([]byte, 64)...)
+
+	testcases := []struct {
+		name   string
+		tx     *types.Transaction
+		method string
+		err    error
+	}{
+		{
+			"allowed method",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, testContract, testFeePayer, 50000, transfer, 30),
+			"transfer(address,uint256)", nil,
+		},
+		{
+			"not fee-delegated",
+			newTestTx(t, types.TxTypeValueTransfer, testSender, testContract, testFeePayer, 50000, nil, 0),
+			"", errNotFeeDelegated,
+		},
+		{
+			"other fee payer",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, testContract, other, 50000, transfer, 30),
+			"", errFeePayerMismatch,
+		},
+		{
+			"fee ratio exceeded",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, testContract, testFeePayer, 50000, transfer, 51),
+			"", errFeeRatioExceeded,
+		},
+		{
+			"full fee exceeds the ratio",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecution, testSender, testContract, testFeePayer, 50000, transfer, 0),
+			"", errFeeRatioExceeded,
+		},
+		{
+			"gas exceeded",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, testContract, testFeePayer, 100001, transfer, 30),
+			"", errGasLimitExceeded,
+		},
+		{
+			"other contract",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, other, testFeePayer, 50000, transfer, 30),
+			"", errContractNotAllowed,
+		},
+		{
+			"other method",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, testContract, testFeePayer, 50000, approve, 30),
+			"", errMethodNotAllowed,
+		},
+		{
+			"short data",
+			newTestTx(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, testSender, testContract, testFeePayer, 50000, transferID[:3], 30),
+			"", errMethodNotAllowed,
+		},
+	}
+	for _, tc := range testcases {
+		method, err := p.check(tc.tx)
+		assert.Equal(t, tc.err, err, tc.name)
+		assert.Equal(t, tc.method, method, tc.name)
+	}
+
+	// A full-fee value transfer is allowed only if any recipient is allowed.
+	cfg.MaxFeeRatio = types.MaxFeeRatio
+	cfg.PolicyFile = ""
+	p, err = newPolicy(&cfg)
+	require.NoError(t, err)
+
+	_, err = p.check(newTestTx(t, types.TxTypeFeeDelegatedValueTransfer, testSender, other, testFeePayer, 50000, nil, 0))
+	assert.NoError(t, err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2, time.Minute)
+	other := common.HexToAddress("0x0000000000000000000000000000000000000bad")
+	now := time.Now()
+
+	assert.True(t, limiter.allow(testSender, now))
+	assert.True(t, limiter.allow(testSender, now.Add(time.Second)))
+	assert.False(t, limiter.allow(testSender, now.Add(2*time.Second)))
+	assert.True(t, limiter.allow(other, now.Add(2*time.Second)))
+
+	// The counts are reset in the next window.
+	assert.True(t, limiter.allow(testSender, now.Add(time.Minute)))
+
+	// A non-positive limit allows any number of transactions.
+	unlimited := newRateLimiter(0, time.Minute)
+	for i := 0; i < 100; i++ {
+		assert.True(t, unlimited.allow(testSender, now))
+	}
+}
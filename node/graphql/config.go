@@ -0,0 +1,38 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+const (
+	DefaultHost = "localhost" // Default host interface for the GraphQL server
+	DefaultPort = 8555        // Default TCP port for the GraphQL server
+)
+
+type Config struct {
+	EnabledGraphQL bool
+
+	Host         string   // Host interface on which to start the GraphQL server
+	Port         int      // TCP port number on which to start the GraphQL server
+	Cors         []string // Cross-Origin Resource Sharing header to send to requesting clients
+	VirtualHosts []string // Virtual hostnames which are allowed on incoming requests
+}
+
+var DefaultConfig = &Config{
+	EnabledGraphQL: false,
+	Host:           DefaultHost,
+	Port:           DefaultPort,
+	VirtualHosts:   []string{"localhost"},
+}
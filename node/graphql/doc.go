@@ -0,0 +1,26 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package graphql implements a GraphQL service serving the blocks, transactions, receipts
+and logs of the node, which is backed by the same backend as the JSON-RPC APIs.
+Source Files
+  - config.go                : includes graphql configurations
+  - graphql.go               : implements the resolvers of the GraphQL schema
+  - schema.go                : defines the GraphQL schema
+  - service.go               : implements the node service serving the GraphQL requests over HTTP
+*/
+package graphql
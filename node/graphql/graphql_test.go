@@ -0,0 +1,262 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_api "github.com/klaytn/klaytn/api/mocks"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/bloombits"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBackend is a Backend whose api.Backend methods are mocked.
+type testBackend struct {
+	*mock_api.MockBackend
+}
+
+func (b *testBackend) GetLogs(ctx context.Context, blockHash common.Hash) ([][]*types.Log, error) {
+	return nil, nil
+}
+
+func (b *testBackend) BloomStatus() (uint64, uint64) { return 0, 0 }
+
+func (b *testBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {}
+
+func (b *testBackend) SubscribeRemovedLogsEvent(ch chan<- blockchain.RemovedLogsEvent) event.Subscription {
+	return nil
+}
+
+func (b *testBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription {
+	return nil
+}
+
+func newTestBackend(t *testing.T) (*testBackend, *gomock.Controller) {
+	mockCtrl := gomock.NewController(t)
+	return &testBackend{mock_api.NewMockBackend(mockCtrl)}, mockCtrl
+}
+
+// newTestBlock returns a block holding a fee-delegated value transfer with ratio
+// and the receipt of the reverted execution.
+func newTestBlock(t *testing.T) (*types.Block, types.Receipts) {
+	blockchain.InitDeriveSha(types.ImplDeriveShaOriginal)
+
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:              uint64(3),
+		types.TxValueKeyFrom:               common.HexToAddress("0x520af902892196a3449b06ead301daeaf67e77e8"),
+		types.TxValueKeyTo:                 common.HexToAddress("0xa06fa690d92788cac4953da5f2dfbc4a2b3871db"),
+		types.TxValueKeyAmount:             big.NewInt(5),
+		types.TxValueKeyGasLimit:           uint64(100000),
+		types.TxValueKeyGasPrice:           big.NewInt(25000000000),
+		types.TxValueKeyFeePayer:           common.HexToAddress("0xa142f7b24a618778165c9b06e15a61f100c51400"),
+		types.TxValueKeyFeeRatioOfFeePayer: types.FeeRatio(30),
+	}
+	tx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransferWithRatio, values)
+	require.NoError(t, err)
+	tx.SetSignature(types.TxSignatures{&types.TxSignature{V: big.NewInt(1), R: big.NewInt(2), S: big.NewInt(3)}})
+	tx.SetFeePayerSignatures(types.TxSignatures{&types.TxSignature{V: big.NewInt(4), R: big.NewInt(5), S: big.NewInt(6)}})
+
+	receipts := types.Receipts{types.NewReceipt(types.ReceiptStatusErrExecutionReverted, tx.Hash(), 21000)}
+	header := &types.Header{Number: big.NewInt(10), BlockScore: big.NewInt(1), Time: big.NewInt(1650000000)}
+	return types.NewBlock(header, []*types.Transaction{tx}, receipts), receipts
+}
+
+func execQuery(t *testing.T, backend Backend, query string) (int, map[string]interface{}) {
+	h, err := newHandler(backend)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]interface{}{"query": query})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	return w.Code, result
+}
+
+func TestGraphQLBlock(t *testing.T) {
+	backend, mockCtrl := newTestBackend(t)
+	defer mockCtrl.Finish()
+
+	block, receipts := newTestBlock(t)
+	backend.EXPECT().HeaderByNumberOrHash(gomock.Any(), gomock.Any()).Return(block.Header(), nil).AnyTimes()
+	backend.EXPECT().BlockByNumberOrHash(gomock.Any(), gomock.Any()).Return(block, nil).AnyTimes()
+	backend.EXPECT().GetBlockReceipts(gomock.Any(), block.Hash()).Return(receipts).AnyTimes()
+
+	code, result := execQuery(t, backend, `{ block(number: 10) { number hash transactionCount transactions {
+		type typeInt feePayer { address } feeRatio key status txError gasUsed
+		signatures { v } feePayerSignatures { r } accessList { address } } } }`)
+	require.Equal(t, http.StatusOK, code, result)
+
+	expected := map[string]interface{}{
+		"block": map[string]interface{}{
+			"number":           float64(10),
+			"hash":             block.Hash().Hex(),
+			"transactionCount": float64(1),
+			"transactions": []interface{}{
+				map[string]interface{}{
+					"type":               types.TxTypeFeeDelegatedValueTransferWithRatio.String(),
+					"typeInt":            float64(types.TxTypeFeeDelegatedValueTransferWithRatio),
+					"feePayer":           map[string]interface{}{"address": "0xa142f7b24a618778165c9b06e15a61f100c51400"},
+					"feeRatio":           float64(30),
+					"key":                nil,
+					"status":             float64(0),
+					"txError":            float64(types.ReceiptStatusErrExecutionReverted),
+					"gasUsed":            float64(21000),
+					"signatures":         []interface{}{map[string]interface{}{"v": "0x1"}},
+					"feePayerSignatures": []interface{}{map[string]interface{}{"r": "0x5"}},
+					"accessList":         nil,
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, result["data"])
+}
+
+func TestGraphQLTransaction(t *testing.T) {
+	backend, mockCtrl := newTestBackend(t)
+	defer mockCtrl.Finish()
+
+	block, receipts := newTestBlock(t)
+	tx := block.Transactions()[0]
+	backend.EXPECT().GetTxAndLookupInfo(tx.Hash()).Return(tx, block.Hash(), block.NumberU64(), uint64(0))
+	backend.EXPECT().HeaderByHash(gomock.Any(), block.Hash()).Return(block.Header(), nil).AnyTimes()
+	backend.EXPECT().GetBlockReceipts(gomock.Any(), block.Hash()).Return(receipts).AnyTimes()
+
+	code, result := execQuery(t, backend, `{ transaction(hash: "`+tx.Hash().Hex()+`") {
+		hash index from { address } to { address } status block { number } } }`)
+	require.Equal(t, http.StatusOK, code, result)
+
+	expected := map[string]interface{}{
+		"transaction": map[string]interface{}{
+			"hash":   tx.Hash().Hex(),
+			"index":  float64(0),
+			"from":   map[string]interface{}{"address": "0x520af902892196a3449b06ead301daeaf67e77e8"},
+			"to":     map[string]interface{}{"address": "0xa06fa690d92788cac4953da5f2dfbc4a2b3871db"},
+			"status": float64(0),
+			"block":  map[string]interface{}{"number": float64(10)},
+		},
+	}
+	assert.Equal(t, expected, result["data"])
+
+	// Unknown transactions are resolved to null
+	unknown := common.HexToHash("0x01")
+	backend.EXPECT().GetTxAndLookupInfo(unknown).Return(nil, common.Hash{}, uint64(0), uint64(0))
+	backend.EXPECT().GetPoolTransaction(unknown).Return(nil)
+
+	code, result = execQuery(t, backend, `{ transaction(hash: "`+unknown.Hex()+`") { hash } }`)
+	require.Equal(t, http.StatusOK, code, result)
+	assert.Equal(t, map[string]interface{}{"transaction": nil}, result["data"])
+}
+
+func TestGraphQLBlocksRange(t *testing.T) {
+	backend, mockCtrl := newTestBackend(t)
+	defer mockCtrl.Finish()
+
+	block, _ := newTestBlock(t)
+	backend.EXPECT().CurrentBlock().Return(block)
+
+	// The range is clamped to the current block
+	code, result := execQuery(t, backend, `{ blocks(from: 11) { number } }`)
+	require.Equal(t, http.StatusOK, code, result)
+	assert.Equal(t, map[string]interface{}{"blocks": []interface{}{}}, result["data"])
+
+	backend.EXPECT().CurrentBlock().Return(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(maxBlocksRange)}))
+	code, result = execQuery(t, backend, `{ blocks(from: 0) { number } }`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, result["errors"].([]interface{})[0].(map[string]interface{})["message"], errBlocksRangeTooLarge.Error())
+}
+
+func TestGraphQLLogsLimits(t *testing.T) {
+	backend, mockCtrl := newTestBackend(t)
+	defer mockCtrl.Finish()
+
+	backend.EXPECT().CurrentBlock().Return(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(maxLogsRange)}))
+	code, result := execQuery(t, backend, `{ logs(filter: {fromBlock: 0}) { index } }`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, result["errors"].([]interface{})[0].(map[string]interface{})["message"], errLogsRangeTooLarge.Error())
+
+	// The limits of klay_getLogs are applied to the log queries
+	require.NoError(t, rpc.SetLimits(&rpc.LimitConfig{Rules: []rpc.LimitRule{{Methods: []string{"klay_getLogs"}, Deny: true}}}))
+	defer rpc.SetLimits(nil)
+
+	backend.EXPECT().CurrentBlock().Return(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}))
+	code, result = execQuery(t, backend, `{ logs(filter: {fromBlock: 10}) { index } }`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, result["errors"].([]interface{})[0].(map[string]interface{})["message"], "klay_getLogs")
+}
+
+func TestGraphQLHandlerBadRequest(t *testing.T) {
+	backend, mockCtrl := newTestBackend(t)
+	defer mockCtrl.Finish()
+
+	h, err := newHandler(backend)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Mutations are not supported
+	code, _ := execQuery(t, backend, `mutation { sendRawTransaction(data: "0x") }`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// The request body is limited
+	w = httptest.NewRecorder()
+	query := `{"query": "` + strings.Repeat(" ", common.MaxRequestContentLength) + `{ block { number } }"}`
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(query)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// The depth of a query is limited
+	code, result := execQuery(t, backend, `{ block { transactions { block { transactions { block { transactions { block { transactions { hash } } } } } } } } }`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, result["errors"].([]interface{})[0].(map[string]interface{})["message"], "exceeds max depth")
+}
+
+func TestGraphQLHandlerLimits(t *testing.T) {
+	backend, mockCtrl := newTestBackend(t)
+	defer mockCtrl.Finish()
+
+	require.NoError(t, rpc.SetLimits(&rpc.LimitConfig{Rules: []rpc.LimitRule{{Methods: []string{"graphql_query"}, Deny: true}}}))
+	defer rpc.SetLimits(nil)
+
+	code, result := execQuery(t, backend, `{ block { number } }`)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Contains(t, result["errors"].([]interface{})[0].(map[string]interface{})["message"], "graphql_query")
+
+	require.NoError(t, rpc.SetLimits(&rpc.LimitConfig{Rules: []rpc.LimitRule{{Methods: []string{"graphql_*"}, MaxResponseSize: 10}}}))
+	block, _ := newTestBlock(t)
+	backend.EXPECT().HeaderByNumberOrHash(gomock.Any(), gomock.Any()).Return(block.Header(), nil).AnyTimes()
+
+	code, result = execQuery(t, backend, `{ block { number hash } }`)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Contains(t, result["errors"].([]interface{})[0].(map[string]interface{})["message"], "response size")
+}
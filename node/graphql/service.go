@@ -0,0 +1,187 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This file is derived from graphql/service.go (2022/04/12).
+// Modified and improved for the klaytn development.
+
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node"
+)
+
+var (
+	logger = log.NewModuleLogger(log.NodeGraphQL)
+
+	errNoBackend = errors.New("no backend is provided for the GraphQL service")
+)
+
+const (
+	// limitMethod is the method name by which the RPC limits are applied to the GraphQL requests.
+	limitMethod = "graphql_query"
+
+	maxQueryDepth       = 8  // maximum depth of the nested fields of a query
+	maxQueryParallelism = 10 // maximum number of the resolvers running concurrently for a query
+)
+
+type handler struct {
+	Schema *graphql.Schema
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	body := http.MaxBytesReader(w, r.Body, int64(common.MaxRequestContentLength))
+	if err := json.NewDecoder(body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The limits of graphql_query are applied to the whole request, and the limits of
+	// the RPC methods are applied to the queries resolved by them.
+	ctx := rpc.WithClientInfo(r.Context(), r.RemoteAddr, r.Header.Get(rpc.APIKeyHeader()))
+	ctx, release, err := rpc.AcquireLimit(ctx, limitMethod)
+	if err != nil {
+		writeError(w, err, http.StatusTooManyRequests)
+		return
+	}
+	defer release()
+
+	response := h.Schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := rpc.CheckLimit(ctx, len(responseJSON)); err != nil {
+		writeError(w, err, http.StatusTooManyRequests)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	w.Write(responseJSON)
+}
+
+// writeError writes the error as a GraphQL response with the given status code.
+func writeError(w http.ResponseWriter, err error, code int) {
+	responseJSON, _ := json.Marshal(&graphql.Response{Errors: []*gqlerrors.QueryError{{Message: err.Error()}}})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(responseJSON)
+}
+
+// newHandler returns a new `http.Handler` that will answer GraphQL queries.
+func newHandler(backend Backend) (http.Handler, error) {
+	s, err := graphql.ParseSchema(schema, &Resolver{backend},
+		graphql.MaxDepth(maxQueryDepth), graphql.MaxParallelism(maxQueryParallelism))
+	if err != nil {
+		return nil, err
+	}
+	return handler{Schema: s}, nil
+}
+
+// GraphQL is a node service which serves the GraphQL requests over HTTP
+// next to the JSON-RPC servers.
+type GraphQL struct {
+	config *Config
+
+	backend  Backend
+	listener net.Listener
+	server   *http.Server
+}
+
+func NewGraphQL(ctx *node.ServiceContext, cfg *Config) (*GraphQL, error) {
+	return &GraphQL{config: cfg}, nil
+}
+
+// Endpoint returns the host interface and the port of the GraphQL server.
+func (g *GraphQL) Endpoint() string {
+	return fmt.Sprintf("%s:%d", g.config.Host, g.config.Port)
+}
+
+func (g *GraphQL) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+func (g *GraphQL) APIs() []rpc.API {
+	return []rpc.API{}
+}
+
+func (g *GraphQL) Start(server p2p.Server) error {
+	if g.backend == nil {
+		return errNoBackend
+	}
+	h, err := newHandler(g.backend)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", g.Endpoint())
+	if err != nil {
+		return err
+	}
+	timeouts := rpc.DefaultHTTPTimeouts
+	g.listener = listener
+	g.server = &http.Server{
+		Handler:      rpc.NewHTTPHandlerStack(h, g.config.Cors, g.config.VirtualHosts),
+		ReadTimeout:  timeouts.ReadTimeout,
+		WriteTimeout: timeouts.WriteTimeout,
+		IdleTimeout:  timeouts.IdleTimeout,
+	}
+	go g.server.Serve(listener)
+
+	logger.Info("GraphQL endpoint opened", "url", fmt.Sprintf("http://%s", listener.Addr()))
+	return nil
+}
+
+func (g *GraphQL) Stop() error {
+	if g.server != nil {
+		g.server.Close()
+		g.server = nil
+		g.listener = nil
+
+		logger.Info("GraphQL endpoint closed", "url", fmt.Sprintf("http://%s", g.Endpoint()))
+	}
+	return nil
+}
+
+func (g *GraphQL) Components() []interface{} {
+	return nil
+}
+
+func (g *GraphQL) SetComponents(components []interface{}) {
+	for _, component := range components {
+		if backend, ok := component.(Backend); ok {
+			g.backend = backend
+		}
+	}
+}
@@ -21,8 +21,10 @@
 package node
 
 import (
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
@@ -33,6 +35,8 @@ import (
 
 	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/api/debug"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/event"
 	"github.com/klaytn/klaytn/log"
 	metricutils "github.com/klaytn/klaytn/metrics/utils"
@@ -84,6 +88,10 @@ type Node struct {
 	grpcListener *grpc.Listener // gRPC listener socket to server API requests
 	grpcHandler  *rpc.Server    // gRPC request handler to process the API requests
 
+	authEndpoint string       // Authenticated RPC endpoint (interface + port) to listen at (empty = disabled)
+	authListener net.Listener // Authenticated RPC listener socket to serve API requests
+	authHandler  *rpc.Server  // Authenticated RPC request handler to process the API requests
+
 	stop chan struct{} // Channel to wait for termination notifications
 	lock sync.RWMutex
 
@@ -137,6 +145,7 @@ func New(conf *Config) (*Node, error) {
 		httpEndpoint:      conf.HTTPEndpoint(),
 		wsEndpoint:        conf.WSEndpoint(),
 		grpcEndpoint:      conf.GRPCEndpoint(),
+		authEndpoint:      conf.AuthEndpoint(),
 		eventmux:          new(event.TypeMux),
 		logger:            conf.Logger,
 	}, nil
@@ -368,7 +377,15 @@ func (n *Node) startRPC(services map[reflect.Type]Service) error {
 		}
 	}
 	// start gRPC server
-	if err := n.startgRPC(apis); err != nil {
+	if err := n.startgRPC(apis, services); err != nil {
+		n.stopHTTP()
+		n.stopIPC()
+		n.stopInProc()
+		return err
+	}
+	if err := n.startAuth(apis); err != nil {
+		n.stopgRPC()
+		n.stopWS()
 		n.stopHTTP()
 		n.stopIPC()
 		n.stopInProc()
@@ -432,7 +449,8 @@ func (n *Node) stopIPC() {
 }
 
 // startgRPC initializes and starts the gRPC endpoint.
-func (n *Node) startgRPC(apis []rpc.API) error {
+// The typed KlayAPI service is served by the API backend among the components of the services.
+func (n *Node) startgRPC(apis []rpc.API, services map[reflect.Type]Service) error {
 	if n.grpcEndpoint == "" {
 		return nil
 	}
@@ -451,12 +469,79 @@ func (n *Node) startgRPC(apis []rpc.API) error {
 	n.grpcHandler = handler
 	n.grpcListener = listener
 	listener.SetRPCServer(handler)
+	for _, service := range services {
+		for _, component := range service.Components() {
+			if backend, ok := component.(grpc.Backend); ok {
+				listener.SetBackend(backend)
+			}
+		}
+	}
 
 	go listener.Start()
 	n.logger.Info("gRPC endpoint opened", "url", n.grpcEndpoint)
 	return nil
 }
 
+// startAuth initializes and starts the JWT-authenticated RPC endpoint.
+func (n *Node) startAuth(apis []rpc.API) error {
+	if n.authEndpoint == "" {
+		return nil
+	}
+	secret, err := obtainJWTSecret(n.config.JWTSecretPath())
+	if err != nil {
+		return err
+	}
+	listener, handler, err := rpc.StartAuthEndpoint(n.authEndpoint, apis, n.config.AuthModules, n.config.AuthVirtualHosts, secret, n.config.HTTPTimeouts)
+	if err != nil {
+		return err
+	}
+	n.logger.Info("Authenticated RPC endpoint opened", "url", fmt.Sprintf("http://%s", listener.Addr()), "vhosts", strings.Join(n.config.AuthVirtualHosts, ","))
+	n.authListener = listener
+	n.authHandler = handler
+	return nil
+}
+
+// stopAuth terminates the authenticated RPC endpoint.
+func (n *Node) stopAuth() {
+	if n.authListener != nil {
+		n.authListener.Close()
+		n.authListener = nil
+
+		n.logger.Info("Authenticated RPC endpoint closed", "url", fmt.Sprintf("http://%s", n.authEndpoint))
+	}
+	if n.authHandler != nil {
+		n.authHandler.Stop()
+		n.authHandler = nil
+	}
+}
+
+// obtainJWTSecret loads the hex-encoded JWT secret from the file, or generates a new
+// secret and stores it to the file if the file does not exist.
+func obtainJWTSecret(path string) ([]byte, error) {
+	if data, err := ioutil.ReadFile(path); err == nil {
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != rpc.JWTSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret in %s: length %d", path, len(secret))
+		}
+		logger.Info("Loaded JWT secret file", "path", path)
+		return secret, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	secret := make([]byte, rpc.JWTSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	logger.Info("Generated JWT secret", "path", path)
+	return secret, nil
+}
+
 // startHTTP initializes and starts the HTTP RPC endpoint.
 func (n *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) error {
 	// Short circuit if the HTTP endpoint isn't being exposed
@@ -591,6 +676,7 @@ func (n *Node) Stop() error {
 	n.stopHTTP()
 	n.stopIPC()
 	n.stopgRPC()
+	n.stopAuth()
 	n.rpcAPIs = nil
 	failure := &StopError{
 		Services: make(map[reflect.Type]error),
@@ -153,6 +153,25 @@ const (
 	// computation costs for opcode added at londonCompatible Protocol Upgrade
 	BaseFeeComputationCost = 198
 
+	// computation costs for opcode added at cancunCompatible Protocol Upgrade
+	Push0ComputationCost  = 80
+	TloadComputationCost  = 280
+	TstoreComputationCost = 280
+	McopyComputationCost  = 250
+
+	// computation costs for precompiled contract added at rip7212Compatible Protocol Upgrade
+	P256VerifyComputationCost = 150000
+
+	// computation costs for precompiled contracts added at pragueCompatible Protocol Upgrade
+	Bls12381G1AddComputationCost          = 17000
+	Bls12381G1MulComputationCost          = 1250000
+	Bls12381G2AddComputationCost          = 26000
+	Bls12381G2MulComputationCost          = 3600000
+	Bls12381PairingBaseComputationCost    = 1750000
+	Bls12381PairingPerPairComputationCost = 2400000
+	Bls12381MapG1ComputationCost          = 230000
+	Bls12381MapG2ComputationCost          = 3450000
+
 	// Opcode Computation Cost Modification
 	AddmodComputationCost         = 3349
 	AddmodComputationCostIstanbul = 1410
@@ -172,6 +172,9 @@ type ChainConfig struct {
 	LondonCompatibleBlock    *big.Int `json:"londonCompatibleBlock,omitempty"`    // LondonCompatibleBlock switch block (nil = no fork, 0 = already on london)
 	EthTxTypeCompatibleBlock *big.Int `json:"ethTxTypeCompatibleBlock,omitempty"` // EthTxTypeCompatibleBlock switch block (nil = no fork, 0 = already on ethTxType)
 	MagmaCompatibleBlock     *big.Int `json:"magmaCompatibleBlock,omitempty"`     // MagmaCompatible switch block (nil = no fork, 0 already on Magma)
+	CancunCompatibleBlock    *big.Int `json:"cancunCompatibleBlock,omitempty"`    // CancunCompatible switch block (nil = no fork, 0 already on Cancun)
+	RIP7212CompatibleBlock   *big.Int `json:"rip7212CompatibleBlock,omitempty"`   // RIP7212Compatible switch block (nil = no fork, 0 already on RIP7212)
+	PragueCompatibleBlock    *big.Int `json:"pragueCompatibleBlock,omitempty"`    // PragueCompatible switch block (nil = no fork, 0 already on Prague)
 
 	// Various consensus engines
 	Gxhash   *GxhashConfig   `json:"gxhash,omitempty"` // (deprecated) not supported engine
@@ -261,24 +264,30 @@ func (c *ChainConfig) String() string {
 		engine = "unknown"
 	}
 	if c.Istanbul != nil {
-		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d Engine: %v}",
+		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v CancunCompatibleBlock: %v RIP7212CompatibleBlock: %v PragueCompatibleBlock: %v SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d Engine: %v}",
 			c.ChainID,
 			c.IstanbulCompatibleBlock,
 			c.LondonCompatibleBlock,
 			c.EthTxTypeCompatibleBlock,
 			c.MagmaCompatibleBlock,
+			c.CancunCompatibleBlock,
+			c.RIP7212CompatibleBlock,
+			c.PragueCompatibleBlock,
 			c.Istanbul.SubGroupSize,
 			c.UnitPrice,
 			c.DeriveShaImpl,
 			engine,
 		)
 	} else {
-		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v UnitPrice: %d DeriveShaImpl: %d Engine: %v }",
+		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v CancunCompatibleBlock: %v RIP7212CompatibleBlock: %v PragueCompatibleBlock: %v UnitPrice: %d DeriveShaImpl: %d Engine: %v }",
 			c.ChainID,
 			c.IstanbulCompatibleBlock,
 			c.LondonCompatibleBlock,
 			c.EthTxTypeCompatibleBlock,
 			c.MagmaCompatibleBlock,
+			c.CancunCompatibleBlock,
+			c.RIP7212CompatibleBlock,
+			c.PragueCompatibleBlock,
 			c.UnitPrice,
 			c.DeriveShaImpl,
 			engine,
@@ -313,6 +322,21 @@ func (c *ChainConfig) IsMagmaForkEnabled(num *big.Int) bool {
 	return isForked(c.MagmaCompatibleBlock, num)
 }
 
+// IsCancunForkEnabled returns whether num is either equal to the cancun block or greater.
+func (c *ChainConfig) IsCancunForkEnabled(num *big.Int) bool {
+	return isForked(c.CancunCompatibleBlock, num)
+}
+
+// IsRIP7212ForkEnabled returns whether num is either equal to the rip7212 block or greater.
+func (c *ChainConfig) IsRIP7212ForkEnabled(num *big.Int) bool {
+	return isForked(c.RIP7212CompatibleBlock, num)
+}
+
+// IsPragueForkEnabled returns whether num is either equal to the prague block or greater.
+func (c *ChainConfig) IsPragueForkEnabled(num *big.Int) bool {
+	return isForked(c.PragueCompatibleBlock, num)
+}
+
 // CheckCompatible checks whether scheduled fork transitions have been imported
 // with a mismatching chain configuration.
 func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *ConfigCompatError {
@@ -345,6 +369,9 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 		{name: "londonBlock", block: c.LondonCompatibleBlock},
 		{name: "ethTxTypeBlock", block: c.EthTxTypeCompatibleBlock},
 		{name: "magmaBlock", block: c.MagmaCompatibleBlock},
+		{name: "cancunBlock", block: c.CancunCompatibleBlock},
+		{name: "rip7212Block", block: c.RIP7212CompatibleBlock},
+		{name: "pragueBlock", block: c.PragueCompatibleBlock},
 	} {
 		if lastFork.name != "" {
 			// Next one must be higher number
@@ -380,6 +407,15 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.MagmaCompatibleBlock, newcfg.MagmaCompatibleBlock, head) {
 		return newCompatError("Magma Block", c.MagmaCompatibleBlock, newcfg.MagmaCompatibleBlock)
 	}
+	if isForkIncompatible(c.CancunCompatibleBlock, newcfg.CancunCompatibleBlock, head) {
+		return newCompatError("Cancun Block", c.CancunCompatibleBlock, newcfg.CancunCompatibleBlock)
+	}
+	if isForkIncompatible(c.RIP7212CompatibleBlock, newcfg.RIP7212CompatibleBlock, head) {
+		return newCompatError("RIP7212 Block", c.RIP7212CompatibleBlock, newcfg.RIP7212CompatibleBlock)
+	}
+	if isForkIncompatible(c.PragueCompatibleBlock, newcfg.PragueCompatibleBlock, head) {
+		return newCompatError("Prague Block", c.PragueCompatibleBlock, newcfg.PragueCompatibleBlock)
+	}
 	return nil
 }
 
@@ -483,6 +519,9 @@ type Rules struct {
 	IsIstanbul bool
 	IsLondon   bool
 	IsMagma    bool
+	IsCancun   bool
+	IsRIP7212  bool
+	IsPrague   bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -496,6 +535,9 @@ func (c *ChainConfig) Rules(num *big.Int) Rules {
 		IsIstanbul: c.IsIstanbulForkEnabled(num),
 		IsLondon:   c.IsLondonForkEnabled(num),
 		IsMagma:    c.IsMagmaForkEnabled(num),
+		IsCancun:   c.IsCancunForkEnabled(num),
+		IsRIP7212:  c.IsRIP7212ForkEnabled(num),
+		IsPrague:   c.IsPragueForkEnabled(num),
 	}
 }
 
@@ -77,6 +77,11 @@ const (
 	ExtcodeHashGasConstantinople uint64 = 400 // Cost of EXTCODEHASH before EIP 1884
 	ExtcodeHashGasEIP1884        uint64 = 700 // Cost of EXTCODEHASH after  EIP 1884 (part in Istanbul)
 
+	// Cancun version of the gas costs is added.
+	InitCodeWordGas uint64 = 2   // Once per word of the initcode for contract creation after EIP 3860 (part of Shanghai)
+	TloadGas        uint64 = 100 // Cost of TLOAD  after EIP 1153 (part of Cancun)
+	TstoreGas       uint64 = 100 // Cost of TSTORE after EIP 1153 (part of Cancun)
+
 	// EXP has a dynamic portion depending on the size of the exponent
 	// was set to 10 in Frontier, was raised to 50 during Eip158 (Spurious Dragon)
 	ExpByte uint64 = 50
@@ -119,6 +124,16 @@ const (
 	VMLogPerByteGas                       uint64 = 20     // Per-byte price for a VMLOG operation
 	FeePayerGas                           uint64 = 300    // Gas needed for calculating the fee payer of the transaction in a smart contract.
 	ValidateSenderGas                     uint64 = 5000   // Gas needed for validating the signature of a message.
+	P256VerifyGas                         uint64 = 3450   // Gas needed for verifying a secp256r1 signature (RIP-7212)
+	Bls12381G1AddGas                      uint64 = 375    // Price for BLS12-381 elliptic curve G1 point addition
+	Bls12381G1MulGas                      uint64 = 12000  // Price for BLS12-381 elliptic curve G1 point scalar multiplication
+	Bls12381G2AddGas                      uint64 = 600    // Price for BLS12-381 elliptic curve G2 point addition
+	Bls12381G2MulGas                      uint64 = 22500  // Price for BLS12-381 elliptic curve G2 point scalar multiplication
+	Bls12381PairingBaseGas                uint64 = 37700  // Base gas price for BLS12-381 elliptic curve pairing check
+	Bls12381PairingPerPairGas             uint64 = 32600  // Per-point pair gas price for BLS12-381 elliptic curve pairing check
+	Bls12381MapG1Gas                      uint64 = 5500   // Gas price for BLS12-381 mapping field element to G1 operation
+	Bls12381MapG2Gas                      uint64 = 23800  // Gas price for BLS12-381 mapping field element to G2 operation
+	Bls12381MultiExpDiscountMultiplier    uint64 = 1000   // Multiplier of the discount values of the BLS12-381 multi-scalar multiplication
 
 	GasLimitBoundDivisor uint64 = 1024    // The bound divisor of the gas limit, used in update calculations.
 	MinGasLimit          uint64 = 5000    // Minimum the gas limit may ever be.
@@ -130,7 +145,8 @@ const (
 	CallCreateDepth uint64 = 1024  // Maximum depth of call/create stack.
 	StackLimit      uint64 = 1024  // Maximum size of VM stack allowed.
 
-	MaxCodeSize = 24576 // Maximum bytecode to permit for a contract
+	MaxCodeSize     = 24576           // Maximum bytecode to permit for a contract
+	MaxInitCodeSize = 2 * MaxCodeSize // Maximum initcode to permit in a creation transaction and create instructions
 
 	// istanbul BFT
 	BFTMaximumExtraDataSize uint64 = 65 // Maximum size extra data may be after Genesis.
@@ -185,6 +201,13 @@ var (
 	DurationLimit          = big.NewInt(13)     // The decision boundary on the blocktime duration used to determine whether blockscore should go up or not.
 )
 
+// Gas discount tables for the BLS12-381 G1 and G2 multi-scalar multiplication operations (EIP-2537).
+// The k-th value is applied when k pairs are given, and the last one is applied to more pairs.
+var (
+	Bls12381G1MultiExpDiscountTable = [128]uint64{1000, 949, 848, 797, 764, 750, 738, 728, 719, 712, 705, 698, 692, 687, 682, 677, 673, 669, 665, 661, 658, 654, 651, 648, 645, 642, 640, 637, 635, 632, 630, 627, 625, 623, 621, 619, 617, 615, 613, 611, 609, 608, 606, 604, 603, 601, 599, 598, 596, 595, 593, 592, 591, 589, 588, 586, 585, 584, 582, 581, 580, 579, 577, 576, 575, 574, 573, 572, 570, 569, 568, 567, 566, 565, 564, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 551, 550, 549, 548, 547, 547, 546, 545, 544, 543, 542, 541, 540, 540, 539, 538, 537, 536, 536, 535, 534, 533, 532, 532, 531, 530, 529, 528, 528, 527, 526, 525, 525, 524, 523, 522, 522, 521, 520, 520, 519}
+	Bls12381G2MultiExpDiscountTable = [128]uint64{1000, 1000, 923, 884, 855, 832, 812, 796, 782, 770, 759, 749, 740, 732, 724, 717, 711, 704, 699, 693, 688, 683, 679, 674, 670, 666, 663, 659, 655, 652, 649, 646, 643, 640, 637, 634, 632, 629, 627, 624, 622, 620, 618, 615, 613, 611, 609, 607, 606, 604, 602, 600, 598, 597, 595, 593, 592, 590, 589, 587, 586, 584, 583, 582, 580, 579, 578, 576, 575, 574, 573, 571, 570, 569, 568, 567, 566, 565, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 552, 551, 550, 549, 548, 547, 546, 545, 545, 544, 543, 542, 541, 541, 540, 539, 538, 537, 537, 536, 535, 535, 534, 533, 532, 532, 531, 530, 530, 529, 528, 528, 527, 526, 526, 525, 524, 524}
+)
+
 // Parameters for execution time limit
 // These parameters will be re-assigned by init options
 var (
@@ -268,6 +291,12 @@ func NewCodeInfoWithRules(codeFormat CodeFormat, r Rules) CodeInfo {
 	switch {
 	// If new HF is added, please add new case below
 	// case r.IsNextHF:          // If this HF is backward compatible with vmVersion1.
+	case r.IsPrague:
+		fallthrough
+	case r.IsRIP7212:
+		fallthrough
+	case r.IsCancun:
+		fallthrough
 	case r.IsLondon:
 		fallthrough
 	case r.IsIstanbul:
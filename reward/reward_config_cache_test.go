@@ -34,6 +34,9 @@ type testGovernance struct {
 	policy          uint64
 	stakingInterval uint64
 	deferredTxFee   bool
+
+	// deferredTxFeeAt overrides deferredTxFee at the given block numbers
+	deferredTxFeeAt map[uint64]bool
 }
 
 func newDefaultTestGovernance() *testGovernance {
@@ -75,6 +78,11 @@ func (governance *testGovernance) GetItemAtNumberByIntKey(num uint64, key int) (
 		return governance.unitPrice, nil
 	case params.Epoch:
 		return governance.epoch, nil
+	case params.DeferredTxFee:
+		if deferredTxFee, ok := governance.deferredTxFeeAt[num]; ok {
+			return deferredTxFee, nil
+		}
+		return governance.deferredTxFee, nil
 	default:
 		return nil, errors.New("Unhandled key on testGovernance")
 	}
@@ -17,11 +17,14 @@
 package reward
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/params"
 )
 
 var logger = log.NewModuleLogger(log.Reward)
@@ -39,6 +42,40 @@ type governanceHelper interface {
 	GetMinimumStakingAtNumber(num uint64) (uint64, error)
 }
 
+// RewardConfigSpec represents the reward parameters used to calculate a block reward.
+type RewardConfigSpec struct {
+	BlockNumber   uint64       `json:"blockNumber"` // the governance block number of the parameters
+	MintingAmount *hexutil.Big `json:"mintingAmount"`
+	Ratio         string       `json:"ratio"`
+	UnitPrice     *hexutil.Big `json:"unitPrice"`
+	DeferredTxFee bool         `json:"deferredTxFee"`
+}
+
+// RewardSpec represents the breakdown of a block reward.
+// If the tx fee is not deferred, the fee is paid to the proposer during tx execution
+// and it is not included in the rewards.
+type RewardSpec struct {
+	Minted   *hexutil.Big                    `json:"minted"`   // the amount newly minted
+	TotalFee *hexutil.Big                    `json:"totalFee"` // the total tx fee spent in the block
+	BurntFee *hexutil.Big                    `json:"burntFee"` // the amount of the tx fee burnt
+	Proposer *hexutil.Big                    `json:"proposer"` // the amount allocated to the block proposer
+	PoC      *hexutil.Big                    `json:"poc"`      // the amount allocated to PoC
+	KIR      *hexutil.Big                    `json:"kir"`      // the amount allocated to KIR
+	Rewards  map[common.Address]*hexutil.Big `json:"rewards"`  // the amount given to each recipient
+	Config   *RewardConfigSpec               `json:"config"`
+}
+
+// rewardCollector is a BalanceAdder which records the rewards instead of applying them to a state.
+type rewardCollector map[common.Address]*hexutil.Big
+
+func (c rewardCollector) AddBalance(addr common.Address, v *big.Int) {
+	if balance, ok := c[addr]; ok {
+		c[addr] = (*hexutil.Big)(new(big.Int).Add(balance.ToInt(), v))
+	} else {
+		c[addr] = (*hexutil.Big)(new(big.Int).Set(v))
+	}
+}
+
 type RewardDistributor struct {
 	rcc *rewardConfigCache
 	gh  governanceHelper
@@ -106,12 +143,77 @@ func (rd *RewardDistributor) DistributeBlockReward(b BalanceAdder, header *types
 	return nil
 }
 
-// distributeBlockReward mints KLAY and distributes newly minted KLAY and transaction fee to proposer, kirAddr and pocAddr.
-func (rd *RewardDistributor) distributeBlockReward(b BalanceAdder, header *types.Header, totalTxFee *big.Int, rewardConfig *rewardConfig, pocAddr common.Address, kirAddr common.Address) {
-	proposer := header.Rewardbase
-	// Block reward
-	blockReward := big.NewInt(0).Add(rewardConfig.mintingAmount, totalTxFee)
+// GetBlockReward calculates the block reward of the given header without applying it to a state.
+// If distribute is true, the reward is calculated as DistributeBlockReward does, otherwise as MintKLAY does.
+func (rd *RewardDistributor) GetBlockReward(header *types.Header, distribute bool, pocAddr common.Address, kirAddr common.Address) (*RewardSpec, error) {
+	rewardConfig, err := rd.rcc.get(header.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
 
+	// The current value can differ from the one of the block since it can be changed by vote
+	deferredTxFee, err := rd.getDeferredTxFee(rewardConfig.blockNum)
+	if err != nil {
+		return nil, err
+	}
+	totalTxFee := rd.getTotalTxFee(header, rewardConfig)
+
+	// Calculate the tx fee to be distributed as DistributeBlockReward and MintKLAY do
+	txFee, burntFee := big.NewInt(0), big.NewInt(0)
+	if !distribute || deferredTxFee {
+		txFee.Set(totalTxFee)
+		// magma hardfork
+		if header.BaseFee != nil {
+			txFee = rd.txFeeBurning(txFee)
+			burntFee.Sub(totalTxFee, txFee)
+		}
+	}
+
+	spec := &RewardSpec{
+		Minted:   (*hexutil.Big)(new(big.Int).Set(rewardConfig.mintingAmount)),
+		TotalFee: (*hexutil.Big)(totalTxFee),
+		BurntFee: (*hexutil.Big)(burntFee),
+		Rewards:  make(map[common.Address]*hexutil.Big),
+		Config: &RewardConfigSpec{
+			BlockNumber:   rewardConfig.blockNum,
+			MintingAmount: (*hexutil.Big)(new(big.Int).Set(rewardConfig.mintingAmount)),
+			Ratio:         fmt.Sprintf("%v/%v/%v", rewardConfig.cnRatio, rewardConfig.pocRatio, rewardConfig.kirRatio),
+			UnitPrice:     (*hexutil.Big)(new(big.Int).Set(rewardConfig.unitPrice)),
+			DeferredTxFee: deferredTxFee,
+		},
+	}
+	collector := rewardCollector(spec.Rewards)
+	if distribute {
+		blockReward := big.NewInt(0).Add(rewardConfig.mintingAmount, txFee)
+		proposer, poc, kir := splitBlockReward(blockReward, rewardConfig)
+		spec.Proposer, spec.PoC, spec.KIR = (*hexutil.Big)(proposer), (*hexutil.Big)(poc), (*hexutil.Big)(kir)
+		rd.distributeBlockReward(collector, header, txFee, rewardConfig, pocAddr, kirAddr)
+	} else {
+		proposer := big.NewInt(0).Add(rewardConfig.mintingAmount, txFee)
+		spec.Proposer = (*hexutil.Big)(proposer)
+		spec.PoC, spec.KIR = (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))
+		collector.AddBalance(header.Rewardbase, proposer)
+	}
+	return spec, nil
+}
+
+// getDeferredTxFee returns whether the tx fee is deferred by the governance parameters at the given block number.
+func (rd *RewardDistributor) getDeferredTxFee(blockNumber uint64) (bool, error) {
+	result, err := rd.gh.GetItemAtNumberByIntKey(blockNumber, params.DeferredTxFee)
+	if err != nil {
+		logger.Error("Couldn't get DeferredTxFee from governance", "blockNumber", blockNumber, "err", err)
+		return false, errFailGettingConfigure
+	}
+	deferredTxFee, ok := result.(bool)
+	if !ok {
+		return false, errInvalidFormat
+	}
+	return deferredTxFee, nil
+}
+
+// splitBlockReward splits the block reward into the CN reward, PoC incentive and KIR incentive.
+// The remainder of the division is given to PoC.
+func splitBlockReward(blockReward *big.Int, rewardConfig *rewardConfig) (*big.Int, *big.Int, *big.Int) {
 	tmpInt := big.NewInt(0)
 
 	tmpInt = tmpInt.Mul(blockReward, rewardConfig.cnRatio)
@@ -128,6 +230,17 @@ func (rd *RewardDistributor) distributeBlockReward(b BalanceAdder, header *types
 	remaining = tmpInt.Sub(remaining, kirIncentive)
 	pocIncentive = pocIncentive.Add(pocIncentive, remaining)
 
+	return cnReward, pocIncentive, kirIncentive
+}
+
+// distributeBlockReward mints KLAY and distributes newly minted KLAY and transaction fee to proposer, kirAddr and pocAddr.
+func (rd *RewardDistributor) distributeBlockReward(b BalanceAdder, header *types.Header, totalTxFee *big.Int, rewardConfig *rewardConfig, pocAddr common.Address, kirAddr common.Address) {
+	proposer := header.Rewardbase
+	// Block reward
+	blockReward := big.NewInt(0).Add(rewardConfig.mintingAmount, totalTxFee)
+
+	cnReward, pocIncentive, kirIncentive := splitBlockReward(blockReward, rewardConfig)
+
 	// CN reward
 	b.AddBalance(proposer, cnReward)
 
@@ -323,3 +323,93 @@ func TestRewardDistributor_DistributeBlockReward(t *testing.T) {
 		assert.Equal(t, testCase.expectedKirBalance.Uint64(), BalanceAdder.GetBalance(kirAddress).Uint64())
 	}
 }
+
+func TestRewardDistributor_GetBlockReward(t *testing.T) {
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		GasUsed:    100,
+		BaseFee:    big.NewInt(500),
+		Rewardbase: common.StringToAddress("0x1552F52D459B713E0C4558e66C8c773a75615FA8"),
+	}
+	pocAddress := common.StringToAddress("0x4bCDd8E3F9776d16056815E189EcB5A8bF8E4CBb")
+	kirAddress := common.StringToAddress("0xd38A08AD21B44681f5e75D0a3CA4793f3E6c03e7")
+
+	testCases := []struct {
+		distribute       bool
+		deferredTxFee    bool
+		expectedBurnt    uint64
+		expectedProposer uint64
+		expectedPoc      uint64
+		expectedKir      uint64
+	}{
+		{true, true, 25000, 30000, 37500, 7500},
+		{true, false, 0, 20000, 25000, 5000},
+		{false, true, 25000, 75000, 0, 0},
+	}
+
+	for _, testCase := range testCases {
+		governance := newTestGovernance(30, "50000", "40/50/10", 25000000000, true, 86400, testCase.deferredTxFee)
+		rewardDistributor := NewRewardDistributor(governance)
+
+		spec, err := rewardDistributor.GetBlockReward(header, testCase.distribute, pocAddress, kirAddress)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		assert.Equal(t, uint64(50000), spec.Minted.ToInt().Uint64())
+		assert.Equal(t, uint64(50000), spec.TotalFee.ToInt().Uint64())
+		assert.Equal(t, testCase.expectedBurnt, spec.BurntFee.ToInt().Uint64())
+		assert.Equal(t, testCase.expectedProposer, spec.Proposer.ToInt().Uint64())
+		assert.Equal(t, testCase.expectedPoc, spec.PoC.ToInt().Uint64())
+		assert.Equal(t, testCase.expectedKir, spec.KIR.ToInt().Uint64())
+		assert.Equal(t, "40/50/10", spec.Config.Ratio)
+		assert.Equal(t, testCase.deferredTxFee, spec.Config.DeferredTxFee)
+
+		// The rewards must be the same with the ones applied by the distribution
+		balanceAdder := newTestBalanceAdder()
+		if testCase.distribute {
+			err = rewardDistributor.DistributeBlockReward(balanceAdder, header, pocAddress, kirAddress)
+		} else {
+			err = rewardDistributor.MintKLAY(balanceAdder, header)
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, len(balanceAdder.accounts), len(spec.Rewards))
+		for addr, amount := range balanceAdder.accounts {
+			assert.Equal(t, amount.Uint64(), spec.Rewards[addr].ToInt().Uint64())
+		}
+	}
+}
+
+func TestRewardDistributor_GetBlockReward_deferredTxFeeChanged(t *testing.T) {
+	pocAddress := common.StringToAddress("0x4bCDd8E3F9776d16056815E189EcB5A8bF8E4CBb")
+	kirAddress := common.StringToAddress("0xd38A08AD21B44681f5e75D0a3CA4793f3E6c03e7")
+
+	// The tx fee was deferred until the governance block 30, and the current value is not deferred
+	governance := newTestGovernance(30, "50000", "40/50/10", 25000000000, true, 86400, false)
+	governance.deferredTxFeeAt = map[uint64]bool{0: true}
+	rewardDistributor := NewRewardDistributor(governance)
+
+	testCases := []struct {
+		number           int64
+		deferredTxFee    bool
+		expectedBurnt    uint64
+		expectedProposer uint64
+	}{
+		{10, true, 25000, 30000},
+		{40, false, 0, 20000},
+	}
+	for _, testCase := range testCases {
+		header := &types.Header{
+			Number:     big.NewInt(testCase.number),
+			GasUsed:    100,
+			BaseFee:    big.NewInt(500),
+			Rewardbase: common.StringToAddress("0x1552F52D459B713E0C4558e66C8c773a75615FA8"),
+		}
+		spec, err := rewardDistributor.GetBlockReward(header, true, pocAddress, kirAddress)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		assert.Equal(t, testCase.deferredTxFee, spec.Config.DeferredTxFee)
+		assert.Equal(t, testCase.expectedBurnt, spec.BurntFee.ToInt().Uint64())
+		assert.Equal(t, testCase.expectedProposer, spec.Proposer.ToInt().Uint64())
+	}
+}
@@ -22,6 +22,7 @@ package snapshot
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
@@ -44,14 +45,59 @@ type trieKV struct {
 type (
 	// trieGeneratorFn is the interface of trie generation which can
 	// be implemented by different trie algorithm.
-	trieGeneratorFn func(in chan (trieKV), out chan (common.Hash))
+	trieGeneratorFn func(db database.KeyValueWriter, in chan (trieKV), out chan (common.Hash))
 
 	// leafCallbackFn is the callback invoked at the leaves of the trie,
 	// returns the subtrie root with the specified subtrie identifier.
-	leafCallbackFn func(accountHash, codeHash common.Hash, stat *generateStats) (common.Hash, error)
+	leafCallbackFn func(db database.KeyValueWriter, accountHash, codeHash common.Hash, stat *generateStats) (common.Hash, error)
 )
 
-// TODO-Klaytn-Snapshot port GenerateAccountTrieRoot/GenerateStorageTrieRoot/GenerateTrie
+// TODO-Klaytn-Snapshot port GenerateAccountTrieRoot/GenerateStorageTrieRoot
+
+// GenerateTrie takes the whole snapshot tree as the input, traverses all the
+// accounts as well as the corresponding storages and regenerate the whole state
+// (account trie + all storage tries). The regenerated trie nodes and the contract
+// codes are written into the given dst.
+func GenerateTrie(snaptree *Tree, root common.Hash, src database.DBManager, dst database.KeyValueWriter) error {
+	// Traverse all state by snapshot, re-generate the whole state trie
+	acctIt, err := snaptree.AccountIterator(root, common.Hash{})
+	if err != nil {
+		return err // The required snapshot might not exist.
+	}
+	defer acctIt.Release()
+
+	got, err := generateTrieRoot(dst, acctIt, common.Hash{}, stackTrieGenerate, func(dst database.KeyValueWriter, accountHash, codeHash common.Hash, stat *generateStats) (common.Hash, error) {
+		// Migrate the code first, commit the contract code into the tmp db.
+		if codeHash != emptyCode {
+			code := src.ReadCode(codeHash)
+			if len(code) == 0 {
+				return common.Hash{}, errors.New("failed to read contract code")
+			}
+			if err := dst.Put(database.CodeKey(codeHash), code); err != nil {
+				return common.Hash{}, err
+			}
+		}
+		// Then migrate all storage trie nodes into the tmp db.
+		storageIt, err := snaptree.StorageIterator(root, accountHash, common.Hash{})
+		if err != nil {
+			return common.Hash{}, err
+		}
+		defer storageIt.Release()
+
+		hash, err := generateTrieRoot(dst, storageIt, accountHash, stackTrieGenerate, nil, stat, false)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return hash, nil
+	}, newGenerateStats(), true)
+	if err != nil {
+		return err
+	}
+	if got != root {
+		return fmt.Errorf("state root hash mismatch: got %x, want %x", got, root)
+	}
+	return nil
+}
 
 // generateStats is a collection of statistics gathered by the trie generator
 // for logging purposes.
@@ -192,7 +238,7 @@ func runReport(stats *generateStats, stop chan bool) {
 // generateTrieRoot generates the trie hash based on the snapshot iterator.
 // It can be used for generating account trie, storage trie or even the
 // whole state which connects the accounts and the corresponding storages.
-func generateTrieRoot(it Iterator, accountHash common.Hash, generatorFn trieGeneratorFn, leafCallback leafCallbackFn, stats *generateStats, report bool) (common.Hash, error) {
+func generateTrieRoot(db database.KeyValueWriter, it Iterator, accountHash common.Hash, generatorFn trieGeneratorFn, leafCallback leafCallbackFn, stats *generateStats, report bool) (common.Hash, error) {
 	var (
 		in      = make(chan trieKV)         // chan to pass leaves
 		out     = make(chan common.Hash, 1) // chan to collect result
@@ -203,7 +249,7 @@ func generateTrieRoot(it Iterator, accountHash common.Hash, generatorFn trieGene
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		generatorFn(in, out)
+		generatorFn(db, in, out)
 	}()
 	// Spin up a go-routine for progress logging
 	if report && stats != nil {
@@ -270,7 +316,7 @@ func generateTrieRoot(it Iterator, accountHash common.Hash, generatorFn trieGene
 						results <- nil
 						return
 					}
-					subroot, err := leafCallback(hash, common.BytesToHash(contract.GetCodeHash()), stats)
+					subroot, err := leafCallback(db, hash, common.BytesToHash(contract.GetCodeHash()), stats)
 					if err != nil {
 						results <- err
 						return
@@ -315,7 +361,8 @@ func generateTrieRoot(it Iterator, accountHash common.Hash, generatorFn trieGene
 	return stop(nil)
 }
 
-func trieGenerate(in chan trieKV, out chan common.Hash) {
+// trieGenerate generates the trie in memory, so the given db is not used.
+func trieGenerate(_ database.KeyValueWriter, in chan trieKV, out chan common.Hash) {
 	db := statedb.NewDatabase(database.NewMemoryDBManager())
 	t, _ := statedb.NewTrie(common.Hash{}, db)
 	for leaf := range in {
@@ -329,3 +376,19 @@ func trieGenerate(in chan trieKV, out chan common.Hash) {
 	}
 	out <- root
 }
+
+// stackTrieGenerate generates the trie with the stack trie, which commits the
+// trie nodes into the given db as soon as they are complete.
+func stackTrieGenerate(db database.KeyValueWriter, in chan trieKV, out chan common.Hash) {
+	t := statedb.NewStackTrie(db)
+	for leaf := range in {
+		t.TryUpdate(leaf.key[:], leaf.value)
+	}
+	var root common.Hash
+	if db == nil {
+		root, _ = t.Hash()
+	} else {
+		root, _ = t.Commit()
+	}
+	out <- root
+}
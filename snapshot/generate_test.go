@@ -191,12 +191,12 @@ func checkSnapRoot(t *testing.T, snap *diskLayer, trieRoot common.Hash) {
 	t.Helper()
 	accIt := snap.AccountIterator(common.Hash{})
 	defer accIt.Release()
-	snapRoot, err := generateTrieRoot(accIt, common.Hash{}, trieGenerate,
-		func(accountHash, codeHash common.Hash, stat *generateStats) (common.Hash, error) {
+	snapRoot, err := generateTrieRoot(nil, accIt, common.Hash{}, trieGenerate,
+		func(db database.KeyValueWriter, accountHash, codeHash common.Hash, stat *generateStats) (common.Hash, error) {
 			storageIt, _ := snap.StorageIterator(accountHash, common.Hash{})
 			defer storageIt.Release()
 
-			hash, err := generateTrieRoot(storageIt, accountHash, trieGenerate, nil, stat, false)
+			hash, err := generateTrieRoot(nil, storageIt, accountHash, trieGenerate, nil, stat, false)
 			if err != nil {
 				return common.Hash{}, err
 			}
@@ -68,6 +68,22 @@ func newTestBadgerDB() (Database, func()) {
 	}
 }
 
+func newTestPebbleDB() (Database, func()) {
+	dirName, err := ioutil.TempDir(os.TempDir(), "klay_pebbledb_test_")
+	if err != nil {
+		panic("failed to create test file: " + err.Error())
+	}
+	db, err := NewPebbleDB(&DBConfig{Dir: dirName}, 0)
+	if err != nil {
+		panic("failed to create test database: " + err.Error())
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dirName)
+	}
+}
+
 func newTestMemDB() (Database, func()) {
 	return NewMemDB(), func() {}
 }
@@ -106,10 +122,10 @@ type commonDatabaseTestSuite struct {
 
 func TestDatabaseTestSuite(t *testing.T) {
 	// If you want to include dynamo test, use below line
-	// var testDatabases = []func() (Database, func()){newTestLDB, newTestBadgerDB, newTestMemDB, newTestDynamoS3DB}
+	// var testDatabases = []func() (Database, func()){newTestLDB, newTestBadgerDB, newTestPebbleDB, newTestMemDB, newTestDynamoS3DB}
 
 	// TODO-Klaytn-Database Need to add DynamoDB to the below list.
-	testDatabases := []func() (Database, func()){newTestLDB, newTestBadgerDB, newTestMemDB}
+	testDatabases := []func() (Database, func()){newTestLDB, newTestBadgerDB, newTestPebbleDB, newTestMemDB}
 	for _, newFn := range testDatabases {
 		db, remove := newFn()
 		suite.Run(t, &commonDatabaseTestSuite{database: db})
@@ -258,6 +274,27 @@ func (ts *commonDatabaseTestSuite) TestPutGet() {
 	}
 }
 
+func TestPebbleDB_Stat(t *testing.T) {
+	database, remove := newTestPebbleDB()
+	defer remove()
+	db := database.(*pebbleDB)
+
+	for _, property := range []string{"leveldb.stats", "stats"} {
+		stats, err := db.Stat(property)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, stats)
+	}
+
+	files, err := db.Stat("leveldb.num-files-at-level0")
+	assert.NoError(t, err)
+	assert.Equal(t, "0", files)
+
+	for _, property := range []string{"leveldb.iostats", "num-files-at-level7", "num-files-at-levelx", ""} {
+		_, err := db.Stat(property)
+		assert.Error(t, err, property)
+	}
+}
+
 func TestShardDB(t *testing.T) {
 	key := common.Hex2Bytes("0x91d6f7d2537d8a0bd7d487dcc59151ebc00da306")
 
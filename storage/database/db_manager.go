@@ -119,6 +119,11 @@ type DBManager interface {
 	WriteBlock(block *types.Block)
 	DeleteBlock(hash common.Hash, number uint64)
 
+	Ancients() uint64
+	FreezeAncientBlocks(threshold uint64) (uint64, error)
+
+	InspectDatabase() ([]InspectStat, error)
+
 	ReadBadBlock(hash common.Hash) *types.Block
 	WriteBadBlock(block *types.Block)
 	ReadAllBadBlocks() ([]*types.Block, error)
@@ -129,6 +134,9 @@ type DBManager interface {
 	ReadIstanbulSnapshot(hash common.Hash) ([]byte, error)
 	WriteIstanbulSnapshot(hash common.Hash, blob []byte) error
 
+	ReadValidatorStats(hash common.Hash) ([]byte, error)
+	WriteValidatorStats(hash common.Hash, blob []byte) error
+
 	WriteMerkleProof(key, value []byte)
 
 	// Bytecodes related operations
@@ -284,6 +292,10 @@ type DBManager interface {
 	// ChainDataFetcher checkpoint function
 	WriteChainDataFetcherCheckpoint(checkpoint uint64) error
 	ReadChainDataFetcherCheckpoint() (uint64, error)
+
+	// ChainDataFetcher job functions
+	WriteChainDataFetcherJobs(jobs []byte) error
+	ReadChainDataFetcherJobs() ([]byte, error)
 }
 
 type DBEntryType uint8
@@ -375,6 +387,10 @@ type databaseManager struct {
 	dbs    []Database
 	cm     *cacheManager
 
+	// ancients stores old canonical blocks moved out of the key-value databases.
+	// It is nil if no block has been frozen.
+	ancients *freezer
+
 	// TODO-Klaytn need to refine below.
 	// -merge status variable
 	lockInMigration      sync.RWMutex
@@ -497,6 +513,8 @@ func newDatabase(dbc *DBConfig, entryType DBEntryType) (Database, error) {
 		return NewLevelDB(dbc, entryType)
 	case BadgerDB:
 		return NewBadgerDB(dbc.Dir)
+	case PebbleDB:
+		return NewPebbleDB(dbc, entryType)
 	case MemoryDB:
 		return NewMemDB(), nil
 	case DynamoDB:
@@ -510,9 +528,10 @@ func newDatabase(dbc *DBConfig, entryType DBEntryType) (Database, error) {
 // newDatabaseManager returns the pointer of databaseManager with default configuration.
 func newDatabaseManager(dbc *DBConfig) *databaseManager {
 	return &databaseManager{
-		config: dbc,
-		dbs:    make([]Database, databaseEntryTypeSize),
-		cm:     newCacheManager(),
+		config:   dbc,
+		dbs:      make([]Database, databaseEntryTypeSize),
+		cm:       newCacheManager(),
+		ancients: openAncientFreezer(dbc),
 	}
 }
 
@@ -794,7 +813,7 @@ func removeDB(dbPath string, endCheck chan struct{}) {
 }
 
 func (dbm *databaseManager) GetStateTrieDB() Database {
-	return dbm.dbs[StateTrieDB]
+	return dbm.getDatabase(StateTrieDB)
 }
 
 func (dbm *databaseManager) GetStateTrieMigrationDB() Database {
@@ -836,6 +855,12 @@ func (dbm *databaseManager) getDatabase(dbEntryType DBEntryType) Database {
 }
 
 func (dbm *databaseManager) Close() {
+	if dbm.ancients != nil {
+		if err := dbm.ancients.Close(); err != nil {
+			logger.Error("Failed to close the ancient block freezer", "err", err)
+		}
+	}
+
 	// If single DB, only close the first database.
 	if dbm.config.SingleDB {
 		dbm.dbs[0].Close()
@@ -902,6 +927,10 @@ func (dbm *databaseManager) ReadAllHashes(number uint64) []common.Hash {
 			hashes = append(hashes, common.BytesToHash(key[len(key)-32:]))
 		}
 	}
+	// The header of the frozen canonical block is not in the database anymore.
+	if frozenHash := dbm.readAncientHash(number); !common.EmptyHash(frozenHash) {
+		hashes = append(hashes, frozenHash)
+	}
 	return hashes
 }
 
@@ -991,7 +1020,7 @@ func (dbm *databaseManager) HasHeader(hash common.Hash, number uint64) bool {
 
 	db := dbm.getDatabase(headerDB)
 	if has, err := db.Has(headerKey(number, hash)); !has || err != nil {
-		return false
+		return dbm.hasAncient(hash, number)
 	}
 	return true
 }
@@ -1021,6 +1050,9 @@ func (dbm *databaseManager) ReadHeader(hash common.Hash, number uint64) *types.H
 func (dbm *databaseManager) ReadHeaderRLP(hash common.Hash, number uint64) rlp.RawValue {
 	db := dbm.getDatabase(headerDB)
 	data, _ := db.Get(headerKey(number, hash))
+	if len(data) == 0 {
+		return dbm.readAncient(freezerHeaderTable, hash, number)
+	}
 	return data
 }
 
@@ -1092,7 +1124,7 @@ func (dbm *databaseManager) ReadHeaderNumber(hash common.Hash) *uint64 {
 func (dbm *databaseManager) HasBody(hash common.Hash, number uint64) bool {
 	db := dbm.getDatabase(BodyDB)
 	if has, err := db.Has(blockBodyKey(number, hash)); !has || err != nil {
-		return false
+		return dbm.hasAncient(hash, number)
 	}
 	return true
 }
@@ -1142,6 +1174,9 @@ func (dbm *databaseManager) ReadBodyRLP(hash common.Hash, number uint64) rlp.Raw
 	// not found in cache, find body in database
 	db := dbm.getDatabase(BodyDB)
 	data, _ := db.Get(blockBodyKey(number, hash))
+	if len(data) == 0 {
+		data = dbm.readAncient(freezerBodiesTable, hash, number)
+	}
 
 	// Write to cache at the end of successful read.
 	dbm.cm.writeBodyRLPCache(hash, data)
@@ -1171,6 +1206,9 @@ func (dbm *databaseManager) ReadBodyRLPByHash(hash common.Hash) rlp.RawValue {
 
 	db := dbm.getDatabase(BodyDB)
 	data, _ := db.Get(blockBodyKey(*number, hash))
+	if len(data) == 0 {
+		data = dbm.readAncient(freezerBodiesTable, hash, *number)
+	}
 
 	// Write to cache at the end of successful read.
 	dbm.cm.writeBodyRLPCache(hash, data)
@@ -1290,6 +1328,9 @@ func (dbm *databaseManager) ReadReceipts(blockHash common.Hash, number uint64) t
 	db := dbm.getDatabase(ReceiptsDB)
 	// Retrieve the flattened receipt slice
 	data, _ := db.Get(blockReceiptsKey(number, blockHash))
+	if len(data) == 0 {
+		data = dbm.readAncient(freezerReceiptsTable, blockHash, number)
+	}
 	if len(data) == 0 {
 		return nil
 	}
@@ -1600,6 +1641,18 @@ func (dbm *databaseManager) WriteIstanbulSnapshot(hash common.Hash, blob []byte)
 	return db.Put(snapshotKey(hash), blob)
 }
 
+// ReadValidatorStats retrieves the encoded consensus participation of the validators in the given block.
+func (dbm *databaseManager) ReadValidatorStats(hash common.Hash) ([]byte, error) {
+	db := dbm.getDatabase(MiscDB)
+	return db.Get(validatorStatsKey(hash))
+}
+
+// WriteValidatorStats stores the encoded consensus participation of the validators in the given block.
+func (dbm *databaseManager) WriteValidatorStats(hash common.Hash, blob []byte) error {
+	db := dbm.getDatabase(MiscDB)
+	return db.Put(validatorStatsKey(hash), blob)
+}
+
 // Merkle Proof operation.
 func (dbm *databaseManager) WriteMerkleProof(key, value []byte) {
 	db := dbm.getDatabase(MiscDB)
@@ -2600,6 +2653,27 @@ func (dbm *databaseManager) ReadChainDataFetcherCheckpoint() (uint64, error) {
 	return binary.BigEndian.Uint64(data), nil
 }
 
+// WriteChainDataFetcherJobs stores the encoded chaindatafetcher jobs.
+func (dbm *databaseManager) WriteChainDataFetcherJobs(jobs []byte) error {
+	db := dbm.getDatabase(MiscDB)
+	return db.Put(chaindatafetcherJobsKey, jobs)
+}
+
+// ReadChainDataFetcherJobs retrieves the encoded chaindatafetcher jobs.
+// If no job has been stored, nil is returned.
+func (dbm *databaseManager) ReadChainDataFetcherJobs() ([]byte, error) {
+	db := dbm.getDatabase(MiscDB)
+	data, err := db.Get(chaindatafetcherJobsKey)
+	if err != nil {
+		if err == leveldb.ErrNotFound || err == badger.ErrKeyNotFound ||
+			strings.Contains(err.Error(), "not found") { // memoryDB
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
 func (dbm *databaseManager) NewSnapshotDBBatch() SnapshotDBBatch {
 	return &snapshotDBBatch{dbm.NewBatch(SnapshotDB)}
 }
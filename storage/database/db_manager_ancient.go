@@ -0,0 +1,209 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+var (
+	errFreezerUnavailable      = errors.New("ancient block freezer is not available for the database")
+	errInvalidAncientThreshold = errors.New("ancient block threshold must be at least 1 to keep the head block")
+)
+
+// ancientDir returns the directory of the freezer. It returns an empty string
+// if the database is not stored in the local file system.
+func ancientDir(dbc *DBConfig) string {
+	if dbc.Dir == "" || dbc.DBType == MemoryDB || dbc.DBType == DynamoDB {
+		return ""
+	}
+	return filepath.Join(dbc.Dir, ancientDirName)
+}
+
+// openAncientFreezer opens the freezer of the database if it has been created
+// by FreezeAncientBlocks before. Otherwise, it returns nil.
+func openAncientFreezer(dbc *DBConfig) *freezer {
+	dir := ancientDir(dbc)
+	if dir == "" {
+		return nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil
+	}
+	f, err := newFreezer(dir)
+	if err != nil {
+		logger.Crit("Failed to open the ancient block freezer", "dir", dir, "err", err)
+	}
+	return f
+}
+
+// readAncient retrieves the given kind of data of the block from the freezer.
+// It returns nil if the block is not frozen or the frozen block has a different hash.
+func (dbm *databaseManager) readAncient(kind string, hash common.Hash, number uint64) []byte {
+	if dbm.ancients == nil || !dbm.ancients.HasAncient(number) {
+		return nil
+	}
+	frozenHash, err := dbm.ancients.Ancient(freezerHashTable, number)
+	if err != nil || !bytes.Equal(frozenHash, hash.Bytes()) {
+		return nil
+	}
+	data, err := dbm.ancients.Ancient(kind, number)
+	if err != nil {
+		logger.Error("Failed to read an ancient block", "kind", kind, "number", number, "hash", hash, "err", err)
+		return nil
+	}
+	return data
+}
+
+// readAncientHash returns the hash of the frozen block of the given number.
+// It returns an empty hash if the block is not frozen.
+func (dbm *databaseManager) readAncientHash(number uint64) common.Hash {
+	if dbm.ancients == nil || !dbm.ancients.HasAncient(number) {
+		return common.Hash{}
+	}
+	data, err := dbm.ancients.Ancient(freezerHashTable, number)
+	if err != nil {
+		return common.Hash{}
+	}
+	return common.BytesToHash(data)
+}
+
+// hasAncient returns true if the block of the given hash and number is frozen.
+func (dbm *databaseManager) hasAncient(hash common.Hash, number uint64) bool {
+	frozenHash := dbm.readAncientHash(number)
+	return !common.EmptyHash(frozenHash) && frozenHash == hash
+}
+
+// Ancients returns the number of blocks stored in the freezer.
+func (dbm *databaseManager) Ancients() uint64 {
+	if dbm.ancients == nil {
+		return 0
+	}
+	return dbm.ancients.Ancients()
+}
+
+// FreezeAncientBlocks moves the headers, bodies and receipts of the canonical
+// blocks older than the given threshold from the key-value databases into the
+// freezer, and returns the number of moved blocks. The most recent `threshold`
+// blocks from the head block are left in the key-value databases.
+// The canonical hashes, the hash-to-number mappings and the total blockscores
+// are left in the key-value databases. The threshold must be at least 1, so that
+// the head block is never frozen.
+//
+// The freezer is maintained offline only: a running node does not freeze blocks
+// by itself, and this must be called on a database which is not opened by a node.
+// A node reads the blocks frozen before it started.
+func (dbm *databaseManager) FreezeAncientBlocks(threshold uint64) (uint64, error) {
+	if threshold == 0 {
+		return 0, errInvalidAncientThreshold
+	}
+	if dbm.ancients == nil {
+		dir := ancientDir(dbm.config)
+		if dir == "" {
+			return 0, errFreezerUnavailable
+		}
+		f, err := newFreezer(dir)
+		if err != nil {
+			return 0, err
+		}
+		dbm.ancients = f
+	}
+
+	headNumber := dbm.ReadHeaderNumber(dbm.ReadHeadBlockHash())
+	if headNumber == nil || *headNumber < threshold {
+		return 0, nil
+	}
+	// Blocks of number in [first, limit) are frozen.
+	first, limit := dbm.ancients.Ancients(), *headNumber-threshold+1
+	if first >= limit {
+		return 0, nil
+	}
+
+	var (
+		headerBatch   = dbm.NewBatch(headerDB)
+		bodyBatch     = dbm.NewBatch(BodyDB)
+		receiptsBatch = dbm.NewBatch(ReceiptsDB)
+
+		start  = time.Now()
+		logged = time.Now()
+	)
+	// flush makes the appended blocks durable in the freezer before removing
+	// them from the key-value databases.
+	flush := func() error {
+		if err := dbm.ancients.Sync(); err != nil {
+			return err
+		}
+		for _, batch := range []Batch{headerBatch, bodyBatch, receiptsBatch} {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+		return nil
+	}
+
+	for number := first; number < limit; number++ {
+		hash := dbm.ReadCanonicalHash(number)
+		if common.EmptyHash(hash) {
+			flush()
+			return number - first, fmt.Errorf("%w: canonical hash of block %d", errMissingAncientItems, number)
+		}
+		header, _ := dbm.getDatabase(headerDB).Get(headerKey(number, hash))
+		body, _ := dbm.getDatabase(BodyDB).Get(blockBodyKey(number, hash))
+		if len(header) == 0 || len(body) == 0 {
+			flush()
+			return number - first, fmt.Errorf("%w: header or body of block %d (%x)", errMissingAncientItems, number, hash)
+		}
+		receipts, _ := dbm.getDatabase(ReceiptsDB).Get(blockReceiptsKey(number, hash))
+
+		if err := dbm.ancients.AppendAncient(number, hash.Bytes(), header, body, receipts); err != nil {
+			flush()
+			return number - first, err
+		}
+		if err := headerBatch.Delete(headerKey(number, hash)); err != nil {
+			return number - first, err
+		}
+		if err := bodyBatch.Delete(blockBodyKey(number, hash)); err != nil {
+			return number - first, err
+		}
+		if err := receiptsBatch.Delete(blockReceiptsKey(number, hash)); err != nil {
+			return number - first, err
+		}
+
+		if headerBatch.ValueSize()+bodyBatch.ValueSize()+receiptsBatch.ValueSize() > IdealBatchSize {
+			if err := flush(); err != nil {
+				return number + 1 - first, err
+			}
+		}
+		if time.Since(logged) > 8*time.Second {
+			logger.Info("Freezing ancient blocks", "number", number, "limit", limit, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if err := flush(); err != nil {
+		return limit - first, err
+	}
+	logger.Info("Froze ancient blocks", "from", first, "to", limit-1, "elapsed", common.PrettyDuration(time.Since(start)))
+	return limit - first, nil
+}
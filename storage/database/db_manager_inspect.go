@@ -0,0 +1,162 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+var errInspectUnsupported = errors.New("database inspection is not supported for DynamoDB")
+
+// InspectStat is the number and the total size of the entries of a key category
+// stored in a database.
+type InspectStat struct {
+	Database string
+	Category string
+	Count    uint64
+	Size     common.StorageSize
+}
+
+// keyCategory describes the keys of a category by the prefix and the length.
+// A zero length matches the keys of any length.
+type keyCategory struct {
+	name   string
+	prefix []byte
+	length int
+}
+
+// keyCategories is checked in order, so a longer prefix should precede a shorter one.
+var keyCategories = []keyCategory{
+	{"Headers", headerPrefix, len(headerPrefix) + 8 + common.HashLength},
+	{"Total scores", headerPrefix, len(headerPrefix) + 8 + common.HashLength + len(headerTDSuffix)},
+	{"Canonical hashes", headerPrefix, len(headerPrefix) + 8 + len(headerHashSuffix)},
+	{"Header numbers", headerNumberPrefix, len(headerNumberPrefix) + common.HashLength},
+	{"Bodies", blockBodyPrefix, len(blockBodyPrefix) + 8 + common.HashLength},
+	{"Receipts", blockReceiptsPrefix, len(blockReceiptsPrefix) + 8 + common.HashLength},
+	{"Tx lookup entries", txLookupPrefix, len(txLookupPrefix) + common.HashLength},
+	{"Contract codes", codePrefix, len(codePrefix) + common.HashLength},
+	{"Snapshot accounts", SnapshotAccountPrefix, len(SnapshotAccountPrefix) + common.HashLength},
+	{"Snapshot storages", SnapshotStoragePrefix, len(SnapshotStoragePrefix) + 2*common.HashLength},
+	{"Bloom bits index", BloomBitsIndexPrefix, 0},
+	{"Bloom bits", bloomBitsPrefix, len(bloomBitsPrefix) + 2 + 8 + common.HashLength},
+	{"Preimages", preimagePrefix, len(preimagePrefix) + common.HashLength},
+	{"Chain configs", configPrefix, len(configPrefix) + common.HashLength},
+	{"Sender tx hashes", senderTxHashToTxHashPrefix, len(senderTxHashToTxHashPrefix) + common.HashLength},
+	{"Governance", governancePrefix, 0},
+	{"Staking info", stakingInfoPrefix, 0},
+	{"Child chain txs", childChainTxHashPrefix, 0},
+	{"Value transfer txs", valueTransferTxHashPrefix, 0},
+	{"Parent chain receipts", receiptFromParentChainKeyPrefix, 0},
+	{"Trie nodes", nil, common.HashLength},
+}
+
+// categorize returns the name of the category of the key.
+func categorize(key []byte) string {
+	for _, c := range keyCategories {
+		if bytes.HasPrefix(key, c.prefix) && (c.length == 0 || len(key) == c.length) {
+			return c.name
+		}
+	}
+	return "Others"
+}
+
+// InspectDatabase iterates all entries of the partitioned databases and returns
+// the number and the size of the entries per database and key category.
+// The blocks moved into the ancient block freezer are counted per freezer table.
+func (dbm *databaseManager) InspectDatabase() ([]InspectStat, error) {
+	if dbm.config.DBType == DynamoDB {
+		return nil, errInspectUnsupported
+	}
+	var (
+		stats  []InspectStat
+		start  = time.Now()
+		logged = time.Now()
+		total  uint64
+	)
+	for et := MiscDB; et < databaseEntryTypeSize; et++ {
+		// A single database is shared by all entry types.
+		if (dbm.config.SingleDB || dbm.config.DBType == MemoryDB) && et != MiscDB {
+			break
+		}
+		db := dbm.getDatabase(et)
+		if db == nil {
+			continue
+		}
+		name := et.String()
+		if dbm.config.SingleDB || dbm.config.DBType == MemoryDB {
+			name = "single"
+		}
+
+		counts := make(map[string]*InspectStat)
+		var order []string
+
+		// The order of the keys does not matter, so the shards are iterated in parallel.
+		var it Iterator
+		if sdb, ok := db.(*shardedDB); ok {
+			it = sdb.NewIteratorUnsorted(nil, nil)
+		} else {
+			it = db.NewIterator(nil, nil)
+		}
+		for it.Next() {
+			key, value := it.Key(), it.Value()
+
+			category := categorize(key)
+			stat, ok := counts[category]
+			if !ok {
+				stat = &InspectStat{Database: name, Category: category}
+				counts[category] = stat
+				order = append(order, category)
+			}
+			stat.Count++
+			stat.Size += common.StorageSize(len(key) + len(value))
+
+			total++
+			if time.Since(logged) > 8*time.Second {
+				logger.Info("Inspecting database", "database", name, "count", total, "elapsed", common.PrettyDuration(time.Since(start)))
+				logged = time.Now()
+			}
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, category := range order {
+			stats = append(stats, *counts[category])
+		}
+	}
+
+	if dbm.ancients != nil {
+		dbm.ancients.mu.RLock()
+		for _, name := range freezerTables {
+			table := dbm.ancients.tables[name]
+			stats = append(stats, InspectStat{
+				Database: ancientDirName,
+				Category: name,
+				Count:    table.items,
+				Size:     common.StorageSize(table.dataSize + table.items*indexEntrySize),
+			})
+		}
+		dbm.ancients.mu.RUnlock()
+	}
+	return stats, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBManager_InspectDatabase(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlTrace)
+
+	for _, single := range []bool{true, false} {
+		dir, err := ioutil.TempDir("", "test-db-manager-inspect")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		dbc := &DBConfig{Dir: dir, DBType: LevelDB, SingleDB: single, NumStateTrieShards: 1}
+		dbm := NewDBManager(dbc)
+
+		parentHash := common.Hash{}
+		for i := int64(0); i < 5; i++ {
+			header := &types.Header{Number: big.NewInt(i), ParentHash: parentHash, BlockScore: big.NewInt(1)}
+			block := types.NewBlockWithHeader(header)
+			dbm.WriteBlock(block)
+			dbm.WriteCanonicalHash(block.Hash(), block.NumberU64())
+			parentHash = block.Hash()
+		}
+		dbm.WriteHeadBlockHash(parentHash)
+		require.NoError(t, dbm.GetStateTrieDB().Put(hash1.Bytes(), []byte{0x01, 0x02}))
+
+		frozen, err := dbm.FreezeAncientBlocks(3)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), frozen)
+
+		stats, err := dbm.InspectDatabase()
+		require.NoError(t, err)
+
+		counts := make(map[string]uint64)
+		for _, stat := range stats {
+			if single {
+				assert.Contains(t, []string{"single", ancientDirName}, stat.Database)
+			}
+			counts[stat.Database+"/"+stat.Category] = stat.Count
+		}
+		headerDB, bodyDB, stateDB := headerDB.String(), BodyDB.String(), StateTrieDB.String()
+		if single {
+			headerDB, bodyDB, stateDB = "single", "single", "single"
+		}
+		assert.Equal(t, uint64(3), counts[headerDB+"/Headers"])
+		assert.Equal(t, uint64(5), counts[headerDB+"/Canonical hashes"])
+		assert.Equal(t, uint64(3), counts[bodyDB+"/Bodies"])
+		assert.Equal(t, uint64(1), counts[stateDB+"/Trie nodes"])
+		assert.NotZero(t, counts[headerDB+"/Others"]) // the head block hash
+		assert.Equal(t, uint64(2), counts[ancientDirName+"/"+freezerHeaderTable])
+		dbm.Close()
+	}
+}
@@ -40,7 +40,7 @@ import (
 
 var (
 	dbManagers  []DBManager
-	dbConfigs   = make([]*DBConfig, 0, len(baseConfigs)*3)
+	dbConfigs   = make([]*DBConfig, 0, len(baseConfigs)*4)
 	baseConfigs = []*DBConfig{
 		{DBType: LevelDB, SingleDB: false, NumStateTrieShards: 1, ParallelDBWrite: false},
 		{DBType: LevelDB, SingleDB: false, NumStateTrieShards: 1, ParallelDBWrite: true},
@@ -84,11 +84,14 @@ func init() {
 	for _, bc := range baseConfigs {
 		badgerConfig := *bc
 		badgerConfig.DBType = BadgerDB
+		pebbleConfig := *bc
+		pebbleConfig.DBType = PebbleDB
 		memoryConfig := *bc
 		memoryConfig.DBType = MemoryDB
 
 		dbConfigs = append(dbConfigs, bc)
 		dbConfigs = append(dbConfigs, &badgerConfig)
+		dbConfigs = append(dbConfigs, &pebbleConfig)
 		dbConfigs = append(dbConfigs, &memoryConfig)
 	}
 
@@ -1243,6 +1246,41 @@ func TestDBManager_WriteCode(t *testing.T) {
 	}
 }
 
+func TestDBManager_ChainDataFetcherJobs(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlTrace)
+	for _, dbm := range dbManagers {
+		jobs, err := dbm.ReadChainDataFetcherJobs()
+		assert.NoError(t, err)
+		assert.Nil(t, jobs)
+
+		assert.NoError(t, dbm.WriteChainDataFetcherJobs([]byte("jobs1")))
+		assert.NoError(t, dbm.WriteChainDataFetcherCheckpoint(10))
+		jobs, err = dbm.ReadChainDataFetcherJobs()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("jobs1"), jobs)
+
+		assert.NoError(t, dbm.WriteChainDataFetcherJobs([]byte("jobs2")))
+		jobs, err = dbm.ReadChainDataFetcherJobs()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("jobs2"), jobs)
+	}
+}
+
+func TestDBManager_ValidatorStats(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlTrace)
+	for _, dbm := range dbManagers {
+		hash, data := genRandomData()
+
+		_, err := dbm.ReadValidatorStats(hash)
+		assert.Error(t, err)
+
+		assert.NoError(t, dbm.WriteValidatorStats(hash, data))
+		stats, err := dbm.ReadValidatorStats(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, data, stats)
+	}
+}
+
 func genRandomData() (common.Hash, []byte) {
 	rb := common.MakeRandomBytes(common.HashLength)
 	hash := common.BytesToHash(rb)
@@ -23,18 +23,23 @@ Overview of database package
 DBManager is the interface used by the consumers of database package.
 databaseManager is the implementation of DBManager interface. It contains cacheManager and a list of Database interfaces.
 cacheManager caches data stored in the persistent layer, to decrease the direct access to the persistent layer.
-Database is the interface for persistent layer implementation. Currently there are 4 implementations, levelDB, memDB,
-badgerDB, dynamoDB and shardedDB.
+Database is the interface for persistent layer implementation. Currently there are 6 implementations, levelDB, memDB,
+badgerDB, pebbleDB, dynamoDB and shardedDB.
+freezer is an append-only flat-file store of old canonical blocks. The headers, bodies and receipts of the frozen blocks
+are removed from Database, and databaseManager reads them from freezer instead.
 
 Source Files
 
   - badger_database.go       : implementation of badgerDB, which wraps github.com/dgraph-io/badger
   - cache_manager.go         : implementation of cacheManager, which manages cache layer over persistent layer
   - db_manager.go            : contains DBManager and databaseManager
+  - db_manager_ancient.go    : databaseManager operations to freeze old blocks and to read frozen blocks
   - dynamodb.go              : implementation of dynamoDB, which wraps github.com/aws/aws-sdk-go/service/dynamodb
+  - freezer.go               : implementation of freezer, which stores old canonical blocks in flat files
   - interface.go             : interfaces used outside database package
   - leveldb_database.go      : implementation of levelDB, which wraps github.com/syndtr/goleveldb
   - memory_database.go       : implementation of MemDB, which wraps go native map structure
+  - pebble_database.go       : implementation of pebbleDB, which wraps github.com/cockroachdb/pebble
   - metrics.go               : metrics used in database package, mostly related to cacheManager
   - sharded_database.go      : implementation of shardedDB, which wraps a list of Database interface
   - schema.go                : prefixes and suffixes for database keys and database key generating functions
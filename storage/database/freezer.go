@@ -0,0 +1,358 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// The kinds of data stored in the freezer. Every kind has its own table and
+// the n-th item of each table belongs to the block of number n.
+const (
+	freezerHashTable     = "hashes"
+	freezerHeaderTable   = "headers"
+	freezerBodiesTable   = "bodies"
+	freezerReceiptsTable = "receipts"
+)
+
+var freezerTables = []string{freezerHashTable, freezerHeaderTable, freezerBodiesTable, freezerReceiptsTable}
+
+// ancientDirName is the name of the directory, under the chain database
+// directory, where the freezer keeps its flat files.
+const ancientDirName = "ancient"
+
+// indexEntrySize is the size of an entry in the index file of a freezer table.
+const indexEntrySize = 8
+
+var (
+	errUnknownTable        = errors.New("unknown freezer table")
+	errOutOfBounds         = errors.New("out of bounds")
+	errOutOrderInsertion   = errors.New("the append operation is out-order")
+	errMissingAncientItems = errors.New("missing items to append to the freezer")
+)
+
+// freezerTable is an append-only flat file table. The items are concatenated
+// in the data file, and the index file holds the end offset of every item as
+// an 8-byte big endian integer, so the n-th item is read from the data file
+// between the (n-1)-th and n-th offsets.
+type freezerTable struct {
+	data  *os.File
+	index *os.File
+
+	items    uint64 // the number of stored items
+	dataSize uint64 // the size of the data file, i.e. the end offset of the last item
+}
+
+// newFreezerTable opens the table of the given name in the given directory,
+// creating it if it does not exist. The table is repaired if the index and
+// the data file are inconsistent, which can happen after a crash.
+func newFreezerTable(dir, name string) (*freezerTable, error) {
+	data, err := os.OpenFile(filepath.Join(dir, name+".dat"), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	index, err := os.OpenFile(filepath.Join(dir, name+".idx"), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	t := &freezerTable{data: data, index: index}
+	if err := t.repair(); err != nil {
+		t.close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// repair drops a partially written index entry and the index entries pointing
+// beyond the data file, and truncates the data file to the end of the last item.
+func (t *freezerTable) repair() error {
+	stat, err := t.index.Stat()
+	if err != nil {
+		return err
+	}
+	items := uint64(stat.Size()) / indexEntrySize
+
+	stat, err = t.data.Stat()
+	if err != nil {
+		return err
+	}
+	dataSize := uint64(stat.Size())
+
+	var end uint64
+	for ; items > 0; items-- {
+		if end, err = t.readOffset(items - 1); err != nil {
+			return err
+		}
+		if end <= dataSize {
+			break
+		}
+	}
+	if items == 0 {
+		end = 0
+	}
+	return t.truncate(items, end)
+}
+
+// readOffset returns the end offset of the n-th item.
+func (t *freezerTable) readOffset(n uint64) (uint64, error) {
+	buf := make([]byte, indexEntrySize)
+	if _, err := t.index.ReadAt(buf, int64(n*indexEntrySize)); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf), nil
+}
+
+// truncate discards all items from the given number on.
+func (t *freezerTable) truncate(items, dataSize uint64) error {
+	if err := t.index.Truncate(int64(items * indexEntrySize)); err != nil {
+		return err
+	}
+	if err := t.data.Truncate(int64(dataSize)); err != nil {
+		return err
+	}
+	t.items, t.dataSize = items, dataSize
+	return nil
+}
+
+// truncateItems discards all items from the given number on.
+func (t *freezerTable) truncateItems(items uint64) error {
+	if items >= t.items {
+		return nil
+	}
+	var end uint64
+	if items > 0 {
+		var err error
+		if end, err = t.readOffset(items - 1); err != nil {
+			return err
+		}
+	}
+	return t.truncate(items, end)
+}
+
+// retrieve returns the n-th item of the table.
+func (t *freezerTable) retrieve(n uint64) ([]byte, error) {
+	if n >= t.items {
+		return nil, errOutOfBounds
+	}
+	var start uint64
+	if n > 0 {
+		var err error
+		if start, err = t.readOffset(n - 1); err != nil {
+			return nil, err
+		}
+	}
+	end, err := t.readOffset(n)
+	if err != nil {
+		return nil, err
+	}
+	if end < start {
+		return nil, fmt.Errorf("corrupted freezer index: item %d ends at %d before it starts at %d", n, end, start)
+	}
+	blob := make([]byte, end-start)
+	if _, err := t.data.ReadAt(blob, int64(start)); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// append writes the given item at the end of the table.
+func (t *freezerTable) append(item []byte) error {
+	if _, err := t.data.WriteAt(item, int64(t.dataSize)); err != nil {
+		return err
+	}
+	end := t.dataSize + uint64(len(item))
+
+	buf := make([]byte, indexEntrySize)
+	binary.BigEndian.PutUint64(buf, end)
+	if _, err := t.index.WriteAt(buf, int64(t.items*indexEntrySize)); err != nil {
+		return err
+	}
+	t.items++
+	t.dataSize = end
+	return nil
+}
+
+// sync flushes the data file before the index file, so that the index never
+// points to unwritten data.
+func (t *freezerTable) sync() error {
+	if err := t.data.Sync(); err != nil {
+		return err
+	}
+	return t.index.Sync()
+}
+
+func (t *freezerTable) close() error {
+	var errs []error
+	if err := t.data.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := t.index.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+// freezer is an append-only store for the canonical blocks which are old
+// enough not to be reorganized anymore. The hashes, headers, bodies and
+// receipts of a block are appended to their own tables at the same position,
+// which is the block number.
+type freezer struct {
+	dir    string
+	tables map[string]*freezerTable
+	frozen uint64 // the number of blocks stored in all tables
+
+	mu sync.RWMutex
+}
+
+// newFreezer opens the freezer in the given directory, creating it if it does
+// not exist. Items which are not stored in every table are discarded.
+func newFreezer(dir string) (*freezer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	f := &freezer{dir: dir, tables: make(map[string]*freezerTable)}
+	for _, name := range freezerTables {
+		table, err := newFreezerTable(dir, name)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		f.tables[name] = table
+	}
+	if err := f.repair(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	logger.Info("Opened ancient block freezer", "dir", dir, "frozen", f.frozen)
+	return f, nil
+}
+
+// repair truncates all tables to the number of items of the shortest one.
+func (f *freezer) repair() error {
+	min := uint64(0)
+	for i, name := range freezerTables {
+		if items := f.tables[name].items; i == 0 || items < min {
+			min = items
+		}
+	}
+	for _, table := range f.tables {
+		if err := table.truncateItems(min); err != nil {
+			return err
+		}
+	}
+	f.frozen = min
+	return nil
+}
+
+// Ancients returns the number of frozen blocks.
+func (f *freezer) Ancients() uint64 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.frozen
+}
+
+// HasAncient returns true if the block of the given number is frozen.
+func (f *freezer) HasAncient(number uint64) bool {
+	return number < f.Ancients()
+}
+
+// Ancient retrieves the item of the given kind of the block of the given number.
+func (f *freezer) Ancient(kind string, number uint64) ([]byte, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	table, ok := f.tables[kind]
+	if !ok {
+		return nil, errUnknownTable
+	}
+	if number >= f.frozen {
+		return nil, errOutOfBounds
+	}
+	return table.retrieve(number)
+}
+
+// AppendAncient appends the given block data at the end of the freezer.
+// The number must be the number of frozen blocks.
+func (f *freezer) AppendAncient(number uint64, hash, header, body, receipts []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if number != f.frozen {
+		return errOutOrderInsertion
+	}
+	items := map[string][]byte{
+		freezerHashTable:     hash,
+		freezerHeaderTable:   header,
+		freezerBodiesTable:   body,
+		freezerReceiptsTable: receipts,
+	}
+	for _, name := range freezerTables {
+		if err := f.tables[name].append(items[name]); err != nil {
+			// Roll back the tables which have been already appended.
+			for _, table := range f.tables {
+				table.truncateItems(f.frozen)
+			}
+			return err
+		}
+	}
+	f.frozen++
+	return nil
+}
+
+// Sync flushes all the tables into the disk.
+func (f *freezer) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, table := range f.tables {
+		if err := table.sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close flushes and closes all the tables.
+func (f *freezer) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var errs []error
+	for _, table := range f.tables {
+		if err := table.sync(); err != nil {
+			errs = append(errs, err)
+		}
+		if err := table.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	f.tables = nil
+	if len(errs) != 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreezer_AppendAndRepair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-freezer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	f, err := newFreezer(dir)
+	require.NoError(t, err)
+	for i := uint64(0); i < 10; i++ {
+		b := []byte{byte(i)}
+		require.NoError(t, f.AppendAncient(i, b, b, b, nil))
+	}
+	assert.Equal(t, errOutOrderInsertion, f.AppendAncient(5, nil, nil, nil, nil))
+	assert.Equal(t, uint64(10), f.Ancients())
+
+	for i := uint64(0); i < 10; i++ {
+		data, err := f.Ancient(freezerBodiesTable, i)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, data)
+
+		data, err = f.Ancient(freezerReceiptsTable, i)
+		require.NoError(t, err)
+		assert.Empty(t, data)
+	}
+	_, err = f.Ancient(freezerHeaderTable, 10)
+	assert.Equal(t, errOutOfBounds, err)
+	_, err = f.Ancient("unknown", 0)
+	assert.Equal(t, errUnknownTable, err)
+	require.NoError(t, f.Close())
+
+	// Simulate a crash in the middle of appending the 10th block: the body is
+	// written partially, and the receipts are not written at all.
+	require.NoError(t, os.Truncate(filepath.Join(dir, freezerBodiesTable+".idx"), 9*indexEntrySize+3))
+	require.NoError(t, os.Truncate(filepath.Join(dir, freezerReceiptsTable+".idx"), 9*indexEntrySize))
+
+	f, err = newFreezer(dir)
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.Equal(t, uint64(9), f.Ancients())
+	for _, kind := range freezerTables {
+		_, err = f.Ancient(kind, 9)
+		assert.Equal(t, errOutOfBounds, err)
+	}
+	require.NoError(t, f.AppendAncient(9, []byte{9}, []byte{9}, []byte{9}, nil))
+	data, err := f.Ancient(freezerHeaderTable, 9)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{9}, data)
+}
+
+func TestDBManager_FreezeAncientBlocks(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlTrace)
+
+	for _, single := range []bool{true, false} {
+		dir, err := ioutil.TempDir("", "test-db-manager-freezer")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		dbc := &DBConfig{Dir: dir, DBType: LevelDB, SingleDB: single, NumStateTrieShards: 1}
+		dbm := NewDBManager(dbc)
+
+		var blocks []*types.Block
+		parentHash := common.Hash{}
+		for i := int64(0); i < 10; i++ {
+			header := &types.Header{Number: big.NewInt(i), ParentHash: parentHash, BlockScore: big.NewInt(1)}
+			block := types.NewBlockWithHeader(header)
+			dbm.WriteBlock(block)
+			dbm.WriteCanonicalHash(block.Hash(), block.NumberU64())
+			dbm.WriteReceipts(block.Hash(), block.NumberU64(), types.Receipts{genReceipt(int(i))})
+			blocks = append(blocks, block)
+			parentHash = block.Hash()
+		}
+		dbm.WriteHeadBlockHash(parentHash)
+
+		frozen, err := dbm.FreezeAncientBlocks(3)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), frozen)
+		assert.Equal(t, uint64(7), dbm.Ancients())
+
+		// Freezing again does nothing until the head moves forward.
+		frozen, err = dbm.FreezeAncientBlocks(3)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), frozen)
+		dbm.Close()
+
+		// Reopen the database so that nothing is served from the caches.
+		dbm = NewDBManager(dbc)
+		assert.Equal(t, uint64(7), dbm.Ancients())
+		for _, block := range blocks {
+			hash, number := block.Hash(), block.NumberU64()
+
+			has, err := dbm.(*databaseManager).getDatabase(BodyDB).Has(blockBodyKey(number, hash))
+			require.NoError(t, err)
+			assert.Equal(t, number >= 7, has)
+
+			assert.True(t, dbm.HasHeader(hash, number))
+			assert.True(t, dbm.HasBlock(hash, number))
+			assert.Equal(t, hash, dbm.ReadBlock(hash, number).Hash())
+			assert.Equal(t, hash, dbm.ReadBlockByHash(hash).Hash())
+			assert.Equal(t, hash, dbm.ReadBlockByNumber(number).Hash())
+			assert.Equal(t, []common.Hash{hash}, dbm.ReadAllHashes(number))
+
+			receipts := dbm.ReadReceipts(hash, number)
+			require.Len(t, receipts, 1)
+			assert.Equal(t, number, receipts[0].GasUsed)
+		}
+		// A block of a frozen number but of a different hash is not found.
+		assert.False(t, dbm.HasHeader(hash1, 1))
+		assert.Nil(t, dbm.ReadBlock(hash1, 1))
+		assert.Nil(t, dbm.ReadReceipts(hash1, 1))
+		dbm.Close()
+	}
+}
+
+func TestDBManager_FreezeAncientBlocksUnavailable(t *testing.T) {
+	_, err := NewMemoryDBManager().FreezeAncientBlocks(1)
+	assert.Equal(t, errFreezerUnavailable, err)
+}
+
+func TestDBManager_FreezeAncientBlocksZeroThreshold(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-db-manager-freezer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dbm := NewDBManager(&DBConfig{Dir: dir, DBType: LevelDB, SingleDB: true, NumStateTrieShards: 1})
+	defer dbm.Close()
+
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0), BlockScore: big.NewInt(1)})
+	dbm.WriteBlock(block)
+	dbm.WriteCanonicalHash(block.Hash(), block.NumberU64())
+	dbm.WriteHeadBlockHash(block.Hash())
+
+	// The head block is not frozen
+	_, err = dbm.FreezeAncientBlocks(0)
+	assert.Equal(t, errInvalidAncientThreshold, err)
+	assert.Equal(t, uint64(0), dbm.Ancients())
+	assert.NotNil(t, dbm.ReadBlock(block.Hash(), 0))
+}
@@ -30,6 +30,7 @@ type DBType string
 const (
 	LevelDB   DBType = "LevelDB"
 	BadgerDB         = "BadgerDB"
+	PebbleDB         = "PebbleDB"
 	MemoryDB         = "MemoryDB"
 	DynamoDB         = "DynamoDBS3"
 	ShardedDB        = "ShardedDB"
@@ -38,7 +39,7 @@ const (
 // ToValid converts DBType to a valid one.
 // If it is unable to convert, "" is returned.
 func (db DBType) ToValid() DBType {
-	validDBType := []DBType{LevelDB, BadgerDB, MemoryDB, DynamoDB}
+	validDBType := []DBType{LevelDB, BadgerDB, PebbleDB, MemoryDB, DynamoDB}
 
 	for _, vdb := range validDBType {
 		if strings.ToLower(string(vdb)) == strings.ToLower(string(db)) {
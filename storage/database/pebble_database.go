@@ -0,0 +1,566 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	metricutils "github.com/klaytn/klaytn/metrics/utils"
+	"github.com/rcrowley/go-metrics"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	// minPebbleDBCacheSize is the minimum size of the pebbleDB cache and memory tables in MB.
+	minPebbleDBCacheSize = 16
+
+	// minPebbleDBOpenFilesLimit is the minimum number of files to be opened by pebbleDB.
+	minPebbleDBOpenFilesLimit = 16
+
+	// numPebbleDBLevels is the number of levels of the pebbleDB LSM tree.
+	numPebbleDBLevels = 7
+)
+
+type pebbleDB struct {
+	fn string     // filename for reporting
+	db *pebble.DB // PebbleDB instance
+
+	compTimeMeter       metrics.Meter // Meter for measuring the total time spent in database compaction
+	compReadMeter       metrics.Meter // Meter for measuring the data read during compaction
+	compWriteMeter      metrics.Meter // Meter for measuring the data written during compaction
+	writeDelayNMeter    metrics.Meter // Meter for measuring the write delay number due to database compaction
+	writeDelayMeter     metrics.Meter // Meter for measuring the write delay duration due to database compaction
+	diskSizeGauge       metrics.Gauge // Gauge for tracking the size of all the levels in the database
+	diskReadMeter       metrics.Meter // Meter for measuring the effective amount of data read
+	diskWriteMeter      metrics.Meter // Meter for measuring the effective amount of data written
+	memCompGauge        metrics.Gauge // Gauge for tracking the number of memory compaction
+	level0CompGauge     metrics.Gauge // Gauge for tracking the number of table compaction in level0
+	nonlevel0CompGauge  metrics.Gauge // Gauge for tracking the number of table compaction in non0 level
+	seekCompGauge       metrics.Gauge // Gauge for tracking the number of table compaction caused by read opt
+	manualMemAllocGauge metrics.Gauge // Gauge for tracking amount of non-managed memory currently allocated
+
+	levelsGauge []metrics.Gauge // Gauge for tracking the number of tables in levels
+
+	quitLock sync.Mutex      // Mutex protecting the quit channel access
+	quitChan chan chan error // Quit channel to stop the metrics collection before closing the database
+
+	compTime            int64 // Total time spent in compactions
+	writeStalled        int32 // Flag whether the write is stalled
+	writeDelayStartTime int64 // The start time of the latest write stall
+	writeDelayCount     int64 // Total number of write stall counts
+	writeDelayTime      int64 // Total time spent in write stalls
+
+	prefix string     // prefix used for metrics
+	logger log.Logger // Contextual logger tracking the database path
+}
+
+func (db *pebbleDB) onCompactionEnd(info pebble.CompactionInfo) {
+	atomic.AddInt64(&db.compTime, int64(info.Duration))
+}
+
+func (db *pebbleDB) onWriteStallBegin(b pebble.WriteStallBeginInfo) {
+	atomic.StoreInt64(&db.writeDelayStartTime, time.Now().UnixNano())
+	atomic.AddInt64(&db.writeDelayCount, 1)
+	atomic.StoreInt32(&db.writeStalled, 1)
+}
+
+func (db *pebbleDB) onWriteStallEnd() {
+	atomic.AddInt64(&db.writeDelayTime, time.Now().UnixNano()-atomic.LoadInt64(&db.writeDelayStartTime))
+	atomic.StoreInt32(&db.writeStalled, 0)
+}
+
+// pebbleLogger is a wrapper around the contextual logger to satisfy the logging
+// interface of pebble.
+type pebbleLogger struct {
+	logger log.Logger
+}
+
+func (l pebbleLogger) Infof(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l pebbleLogger) Fatalf(format string, args ...interface{}) {
+	l.logger.Crit(fmt.Sprintf(format, args...))
+}
+
+func getPebbleDBCompression(dbc *DBConfig, entryType DBEntryType) pebble.Compression {
+	if getCompressionType(dbc.LevelDBCompression, entryType) == opt.SnappyCompression {
+		return pebble.SnappyCompression
+	}
+	return pebble.NoCompression
+}
+
+// NewPebbleDB returns a pebble-backed Database. The cache size, the open files limit
+// and the compression type are shared with the LevelDB related configurations.
+func NewPebbleDB(dbc *DBConfig, entryType DBEntryType) (*pebbleDB, error) {
+	localLogger := logger.NewWith("path", dbc.Dir)
+
+	// Ensure we have some minimal caching and file guarantees
+	cacheSize := dbc.LevelDBCacheSize
+	if cacheSize < minPebbleDBCacheSize {
+		cacheSize = minPebbleDBCacheSize
+	}
+	openFilesLimit := dbc.OpenFilesLimit
+	if openFilesLimit < minPebbleDBOpenFilesLimit {
+		openFilesLimit = minPebbleDBOpenFilesLimit
+	}
+
+	// Two memory tables is configured which is identical to leveldb,
+	// including a frozen memory table and another live one.
+	memTableLimit := 2
+	memTableSize := cacheSize * 1024 * 1024 / 2 / memTableLimit
+	compression := getPebbleDBCompression(dbc, entryType)
+
+	localLogger.Info("PebbleDB configurations", "cacheSize", cacheSize, "openFilesLimit", openFilesLimit,
+		"memTableSize(MB)", memTableSize/1024/1024, "usePerfCheck", dbc.EnableDBPerfMetrics, "compressionType", compression)
+
+	db := &pebbleDB{
+		fn:     dbc.Dir,
+		logger: localLogger,
+	}
+	opts := &pebble.Options{
+		// Pebble has a single combined cache area and the write
+		// buffers are taken from this too. Assign all available
+		// memory allowance for cache.
+		Cache:        pebble.NewCache(int64(cacheSize * 1024 * 1024)),
+		MaxOpenFiles: openFilesLimit,
+
+		// The size of memory table(as well as the write buffer).
+		// Note, there may have more than two memory tables in the system.
+		MemTableSize: memTableSize,
+
+		// MemTableStopWritesThreshold places a hard limit on the size
+		// of the existent MemTables(including the frozen one).
+		MemTableStopWritesThreshold: memTableLimit,
+
+		// The default compaction concurrency(1 thread),
+		// Here use all available CPUs for faster compaction.
+		MaxConcurrentCompactions: runtime.NumCPU(),
+
+		// Per-level options. Options for at least one level must be specified. The
+		// options for the last level are used for all subsequent levels.
+		Levels: make([]pebble.LevelOptions, numPebbleDBLevels),
+
+		Logger: pebbleLogger{logger: localLogger},
+
+		EventListener: pebble.EventListener{
+			CompactionEnd:   db.onCompactionEnd,
+			WriteStallBegin: db.onWriteStallBegin,
+			WriteStallEnd:   db.onWriteStallEnd,
+		},
+	}
+	for i := range opts.Levels {
+		opts.Levels[i] = pebble.LevelOptions{
+			TargetFileSize: 2 * 1024 * 1024 << i,
+			FilterPolicy:   bloom.FilterPolicy(10),
+			Compression:    compression,
+		}
+	}
+	defer opts.Cache.Unref()
+
+	innerDB, err := pebble.Open(dbc.Dir, opts)
+	if err != nil {
+		return nil, err
+	}
+	db.db = innerDB
+	return db, nil
+}
+
+func (db *pebbleDB) Type() DBType {
+	return PebbleDB
+}
+
+// Path returns the path to the database directory.
+func (db *pebbleDB) Path() string {
+	return db.fn
+}
+
+// Put inserts the given key and value pair to the database.
+func (db *pebbleDB) Put(key []byte, value []byte) error {
+	return db.db.Set(key, value, pebble.NoSync)
+}
+
+// Has returns true if the corresponding value to the given key exists.
+func (db *pebbleDB) Has(key []byte) (bool, error) {
+	_, closer, err := db.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	closer.Close()
+	return true, nil
+}
+
+// Get returns the corresponding value to the given key if exists.
+func (db *pebbleDB) Get(key []byte) ([]byte, error) {
+	dat, closer, err := db.db.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, dataNotFoundErr
+		}
+		return nil, err
+	}
+	ret := make([]byte, len(dat))
+	copy(ret, dat)
+	closer.Close()
+	return ret, nil
+}
+
+// Delete deletes the key from the database.
+func (db *pebbleDB) Delete(key []byte) error {
+	return db.db.Delete(key, pebble.NoSync)
+}
+
+// NewIterator creates a binary-alphabetical iterator over a subset
+// of database content with a particular key prefix, starting at a particular
+// initial key (or after, if it does not exist).
+func (db *pebbleDB) NewIterator(prefix []byte, start []byte) Iterator {
+	iter := db.db.NewIter(&pebble.IterOptions{
+		LowerBound: append(append([]byte{}, prefix...), start...),
+		UpperBound: upperBound(prefix),
+	})
+	iter.First()
+	return &pebbleIterator{iter: iter, moved: true}
+}
+
+// Stat returns a particular internal stat of the database.
+// The leveldb properties are supported where pebble has an equivalent: "leveldb.stats"
+// returns the metrics of pebble, and "leveldb.num-files-at-level{n}" returns the number
+// of the files at level n. The "leveldb." prefix can be omitted.
+func (db *pebbleDB) Stat(property string) (string, error) {
+	property = strings.TrimPrefix(property, "leveldb.")
+	metrics := db.db.Metrics()
+	switch {
+	case property == "stats":
+		return metrics.String(), nil
+	case strings.HasPrefix(property, "num-files-at-level"):
+		level, err := strconv.Atoi(strings.TrimPrefix(property, "num-files-at-level"))
+		if err != nil || level < 0 || level >= len(metrics.Levels) {
+			break
+		}
+		return strconv.FormatInt(metrics.Levels[level].NumFiles, 10), nil
+	}
+	return "", fmt.Errorf("unknown property: %s", property)
+}
+
+// Compact flattens the underlying data store for the given key range. In essence,
+// deleted and overwritten versions are discarded, and the data is rearranged to
+// reduce the cost of operations needed to access them.
+//
+// A nil start is treated as a key before all keys in the data store; a nil limit
+// is treated as a key after all keys in the data store. If both is nil then it
+// will compact entire data store.
+func (db *pebbleDB) Compact(start []byte, limit []byte) error {
+	// There is no special flag to represent the end of key range
+	// in pebble(nil in leveldb). Use an ugly hack to construct a
+	// large key to represent it.
+	// Note any prefixed database entry will be smaller than this
+	// flag, as for trie nodes we need the 32 byte 0xff because
+	// there might be a shared prefix starting with a number of
+	// 0xff-s, so 32 ensures than only a hash collision could touch it.
+	// https://github.com/cockroachdb/pebble/issues/2359#issuecomment-1443995833
+	if limit == nil {
+		limit = common.CopyBytes(maxPebbleDBKey)
+	}
+	return db.db.Compact(start, limit)
+}
+
+// maxPebbleDBKey is used to represent the end of the key range in the compaction.
+var maxPebbleDBKey = func() []byte {
+	key := make([]byte, common.HashLength)
+	for i := range key {
+		key[i] = 0xff
+	}
+	return key
+}()
+
+func (db *pebbleDB) Close() {
+	// Stop the metrics collection to avoid internal database races
+	db.quitLock.Lock()
+	defer db.quitLock.Unlock()
+
+	if db.quitChan != nil {
+		errc := make(chan error)
+		db.quitChan <- errc
+		if err := <-errc; err != nil {
+			db.logger.Error("Metrics collection failed", "err", err)
+		}
+		db.quitChan = nil
+	}
+	err := db.db.Close()
+	if err == nil {
+		db.logger.Info("Database closed")
+	} else {
+		db.logger.Error("Failed to close database", "err", err)
+	}
+}
+
+// Meter configures the database metrics collectors.
+func (db *pebbleDB) Meter(prefix string) {
+	db.prefix = prefix
+
+	// Initialize all the metrics collector at the requested prefix
+	db.compTimeMeter = metrics.NewRegisteredMeter(prefix+"compaction/time", nil)
+	db.compReadMeter = metrics.NewRegisteredMeter(prefix+"compaction/read", nil)
+	db.compWriteMeter = metrics.NewRegisteredMeter(prefix+"compaction/write", nil)
+	db.diskSizeGauge = metrics.NewRegisteredGauge(prefix+"disk/size", nil)
+	db.diskReadMeter = metrics.NewRegisteredMeter(prefix+"disk/read", nil)
+	db.diskWriteMeter = metrics.NewRegisteredMeter(prefix+"disk/write", nil)
+	db.writeDelayMeter = metrics.NewRegisteredMeter(prefix+"writedelay/duration", nil)
+	db.writeDelayNMeter = metrics.NewRegisteredMeter(prefix+"writedelay/count", nil)
+	db.memCompGauge = metrics.NewRegisteredGauge(prefix+"compact/memory", nil)
+	db.level0CompGauge = metrics.NewRegisteredGauge(prefix+"compact/level0", nil)
+	db.nonlevel0CompGauge = metrics.NewRegisteredGauge(prefix+"compact/nonlevel0", nil)
+	db.seekCompGauge = metrics.NewRegisteredGauge(prefix+"compact/seek", nil)
+	db.manualMemAllocGauge = metrics.NewRegisteredGauge(prefix+"memory/manualalloc", nil)
+
+	// Short circuit metering if the metrics system is disabled
+	// Above meters are initialized by NilMeter if metricutils.Enabled == false
+	if !metricutils.Enabled {
+		return
+	}
+
+	// Create a quit channel for the periodic collector and run it
+	db.quitLock.Lock()
+	db.quitChan = make(chan chan error)
+	db.quitLock.Unlock()
+
+	go db.meter(3 * time.Second)
+}
+
+// meter periodically retrieves internal pebble counters and reports them to
+// the metrics subsystem.
+func (db *pebbleDB) meter(refresh time.Duration) {
+	var errc chan error
+	timer := time.NewTimer(refresh)
+	defer timer.Stop()
+
+	// Keep the previous and the current counters to report the differences.
+	var (
+		compTimes        [2]int64
+		writeDelayTimes  [2]int64
+		writeDelayCounts [2]int64
+		compWrites       [2]int64
+		compReads        [2]int64
+		nWrites          [2]int64
+	)
+
+	// Iterate ad infinitum and collect the stats
+	for i := 1; errc == nil; i++ {
+		var (
+			compWrite int64
+			compRead  int64
+			nWrite    int64
+
+			stats              = db.db.Metrics()
+			compTime           = atomic.LoadInt64(&db.compTime)
+			writeDelayCount    = atomic.LoadInt64(&db.writeDelayCount)
+			writeDelayTime     = atomic.LoadInt64(&db.writeDelayTime)
+			lastWriteStallTime = atomic.LoadInt64(&db.writeDelayStartTime)
+		)
+		if atomic.LoadInt32(&db.writeStalled) == 1 {
+			writeDelayTime += time.Now().UnixNano() - lastWriteStallTime
+		}
+		writeDelayTimes[i%2] = writeDelayTime
+		writeDelayCounts[i%2] = writeDelayCount
+		compTimes[i%2] = compTime
+
+		for _, levelMetrics := range stats.Levels {
+			nWrite += int64(levelMetrics.BytesCompacted)
+			nWrite += int64(levelMetrics.BytesFlushed)
+			compWrite += int64(levelMetrics.BytesCompacted)
+			compRead += int64(levelMetrics.BytesRead)
+		}
+
+		nWrite += int64(stats.WAL.BytesWritten)
+
+		compWrites[i%2] = compWrite
+		compReads[i%2] = compRead
+		nWrites[i%2] = nWrite
+
+		db.writeDelayNMeter.Mark(writeDelayCounts[i%2] - writeDelayCounts[(i-1)%2])
+		db.writeDelayMeter.Mark(writeDelayTimes[i%2] - writeDelayTimes[(i-1)%2])
+		db.compTimeMeter.Mark(compTimes[i%2] - compTimes[(i-1)%2])
+		db.compReadMeter.Mark(compReads[i%2] - compReads[(i-1)%2])
+		db.compWriteMeter.Mark(compWrites[i%2] - compWrites[(i-1)%2])
+		db.diskSizeGauge.Update(int64(stats.DiskSpaceUsage()))
+		db.diskReadMeter.Mark(0) // pebble doesn't track non-compaction reads
+		db.diskWriteMeter.Mark(nWrites[i%2] - nWrites[(i-1)%2])
+
+		// See https://github.com/cockroachdb/pebble/pull/1628#pullrequestreview-1026664054
+		manuallyAllocated := stats.BlockCache.Size + int64(stats.MemTable.Size) + int64(stats.MemTable.ZombieSize)
+		db.manualMemAllocGauge.Update(manuallyAllocated)
+		db.memCompGauge.Update(stats.Flush.Count)
+		db.nonlevel0CompGauge.Update(stats.Compact.Count)
+		db.level0CompGauge.Update(stats.Compact.DefaultCount)
+		db.seekCompGauge.Update(stats.Compact.ReadCount)
+
+		for i, level := range stats.Levels {
+			// Append metrics for additional layers
+			if i >= len(db.levelsGauge) {
+				db.levelsGauge = append(db.levelsGauge, metrics.NewRegisteredGauge(db.prefix+fmt.Sprintf("tables/level%v", i), nil))
+			}
+			db.levelsGauge[i].Update(level.NumFiles)
+		}
+
+		// Sleep a bit, then repeat the stats collection
+		select {
+		case errc = <-db.quitChan:
+			// Quit requesting, stop hammering the database
+		case <-timer.C:
+			timer.Reset(refresh)
+			// Timeout, gather a new set of stats
+		}
+	}
+	errc <- nil
+}
+
+func (db *pebbleDB) NewBatch() Batch {
+	return &pebbleBatch{b: db.db.NewBatch(), db: db}
+}
+
+// pebbleBatch is a write-only batch that commits changes to its host database
+// when Write is called. A batch cannot be used concurrently.
+type pebbleBatch struct {
+	b    *pebble.Batch
+	db   *pebbleDB
+	size int
+}
+
+// Put inserts the given value into the batch for later committing.
+func (b *pebbleBatch) Put(key, value []byte) error {
+	b.b.Set(key, value, nil)
+	b.size += len(value)
+	return nil
+}
+
+// Delete inserts the a key removal into the batch for later committing.
+func (b *pebbleBatch) Delete(key []byte) error {
+	b.b.Delete(key, nil)
+	b.size++
+	return nil
+}
+
+// ValueSize retrieves the amount of data queued up for writing.
+func (b *pebbleBatch) ValueSize() int {
+	return b.size
+}
+
+// Write flushes any accumulated data to disk.
+func (b *pebbleBatch) Write() error {
+	return b.b.Commit(pebble.NoSync)
+}
+
+// Reset resets the batch for reuse.
+func (b *pebbleBatch) Reset() {
+	b.b.Reset()
+	b.size = 0
+}
+
+// Replay replays the batch contents.
+func (b *pebbleBatch) Replay(w KeyValueWriter) error {
+	reader := b.b.Reader()
+	for {
+		kind, k, v, ok := reader.Next()
+		if !ok {
+			break
+		}
+		// The (k,v) slices might be overwritten if the batch is reset/reused,
+		// and the receiver should copy them if they are to be retained long-term.
+		if kind == pebble.InternalKeyKindSet {
+			w.Put(k, v)
+		} else if kind == pebble.InternalKeyKindDelete {
+			w.Delete(k)
+		} else {
+			return fmt.Errorf("unhandled operation, keytype: %v", kind)
+		}
+	}
+	return nil
+}
+
+// pebbleIterator is a wrapper of underlying iterator in storage engine.
+// The purpose of this structure is to implement the missing APIs.
+type pebbleIterator struct {
+	iter     *pebble.Iterator
+	moved    bool
+	released bool
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted.
+func (iter *pebbleIterator) Next() bool {
+	if iter.moved {
+		iter.moved = false
+		return iter.iter.Valid()
+	}
+	return iter.iter.Next()
+}
+
+// Error returns any accumulated error. Exhausting all the key/value pairs
+// is not considered to be an error.
+func (iter *pebbleIterator) Error() error {
+	return iter.iter.Error()
+}
+
+// Key returns the key of the current key/value pair, or nil if done. The caller
+// should not modify the contents of the returned slice, and its contents may
+// change on the next call to Next.
+func (iter *pebbleIterator) Key() []byte {
+	return iter.iter.Key()
+}
+
+// Value returns the value of the current key/value pair, or nil if done. The
+// caller should not modify the contents of the returned slice, and its contents
+// may change on the next call to Next.
+func (iter *pebbleIterator) Value() []byte {
+	return iter.iter.Value()
+}
+
+// Release releases associated resources. Release should always succeed and can
+// be called multiple times without causing error.
+func (iter *pebbleIterator) Release() {
+	if !iter.released {
+		iter.iter.Close()
+		iter.released = true
+	}
+}
+
+// upperBound returns the upper bound for the given prefix
+func upperBound(prefix []byte) (limit []byte) {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		c := prefix[i]
+		if c == 0xff {
+			continue
+		}
+		limit = make([]byte, i+1)
+		copy(limit, prefix)
+		limit[i] = c + 1
+		break
+	}
+	return limit
+}
@@ -52,6 +52,9 @@ var (
 	// snapshotKeyPrefix is a governance snapshot prefix
 	snapshotKeyPrefix = []byte("snapshot")
 
+	// validatorStatsPrefix is a prefix of the consensus participation of the validators in a block
+	validatorStatsPrefix = []byte("validatorStats")
+
 	// snapshotJournalKey tracks the in-memory diff layers across restarts.
 	snapshotJournalKey = []byte("SnapshotJournal")
 
@@ -121,6 +124,7 @@ var (
 	stakingInfoPrefix = []byte("stakingInfo")
 
 	chaindatafetcherCheckpointKey = []byte("chaindatafetcherCheckpoint")
+	chaindatafetcherJobsKey       = []byte("chaindatafetcherJobs")
 )
 
 // TxLookupEntry is a positional metadata to help looking up the data content of
@@ -229,6 +233,10 @@ func snapshotKey(hash common.Hash) []byte {
 	return append(snapshotKeyPrefix, hash[:]...)
 }
 
+func validatorStatsKey(hash common.Hash) []byte {
+	return append(validatorStatsPrefix, hash[:]...)
+}
+
 func childChainTxHashKey(ccBlockHash common.Hash) []byte {
 	return append(append(childChainTxHashPrefix, ccBlockHash.Bytes()...))
 }
@@ -50,6 +50,7 @@ Related functions and variables are defined in the files listed below
   - node.go         : Implementation of 4 types of nodes, used in Merkle Patricia Trie
   - proof.go        : Functions which construct a Merkle Patricia Proof for the given key
   - secure_trie.go  : Implementation of Merkle Patricia Trie with key hashing
+  - stack_trie.go   : Implementation of a trie which hashes and commits the nodes as the sorted keys are inserted
   - sync.go         : Implementation of state trie sync
   - trie.go         : Implementation of Merkle Patricia Trie
 */
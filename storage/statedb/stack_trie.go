@@ -0,0 +1,354 @@
+// Modifications Copyright 2022 The klaytn Authors
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This file is derived from trie/stacktrie.go (2021/10/21).
+// Modified and improved for the klaytn development.
+
+package statedb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+)
+
+var ErrCommitDisabled = errors.New("no database for committing")
+
+const (
+	emptyNode = iota
+	branchNode
+	extNode
+	leafNode
+	hashedNode
+)
+
+// StackTrie is a trie implementation that expects keys to be inserted
+// in order. Once it determines that a subtree will no longer be inserted
+// into, it will hash it and free up the memory it uses. The hashed nodes
+// are written into the given database if it is not nil.
+type StackTrie struct {
+	nodeType uint8                   // node type (as in branch, ext, leaf)
+	val      []byte                  // value contained by this node if it's a leaf, or the encoding/hash if it's hashed
+	key      []byte                  // key chunk covered by this (full|ext) node
+	children [16]*StackTrie          // list of children (for fullnodes and exts)
+	db       database.KeyValueWriter // Pointer to the commit db, can be nil
+}
+
+// NewStackTrie allocates and initializes an empty trie.
+func NewStackTrie(db database.KeyValueWriter) *StackTrie {
+	return &StackTrie{nodeType: emptyNode, db: db}
+}
+
+func newLeaf(key, val []byte, db database.KeyValueWriter) *StackTrie {
+	return &StackTrie{nodeType: leafNode, key: append([]byte{}, key...), val: val, db: db}
+}
+
+func newExt(key []byte, child *StackTrie, db database.KeyValueWriter) *StackTrie {
+	st := &StackTrie{nodeType: extNode, key: append([]byte{}, key...), db: db}
+	st.children[0] = child
+	return st
+}
+
+// Update inserts a (key, value) pair into the stack trie.
+func (st *StackTrie) Update(key, value []byte) {
+	if err := st.TryUpdate(key, value); err != nil {
+		logger.Error(fmt.Sprintf("Unhandled trie error: %v", err))
+	}
+}
+
+// TryUpdate inserts a (key, value) pair into the stack trie. The keys must be
+// inserted in the ascending order and must not be inserted twice.
+func (st *StackTrie) TryUpdate(key, value []byte) error {
+	if len(value) == 0 {
+		return errors.New("deletion is not supported by stack trie")
+	}
+	k := keybytesToHex(key)
+	return st.insert(k[:len(k)-1], value)
+}
+
+// Reset clears the stack trie to be reused.
+func (st *StackTrie) Reset() {
+	st.key = st.key[:0]
+	st.val = nil
+	for i := range st.children {
+		st.children[i] = nil
+	}
+	st.nodeType = emptyNode
+}
+
+// getDiffIndex returns the index at which the chunk pointed by st.key
+// differs from the given key.
+func (st *StackTrie) getDiffIndex(key []byte) int {
+	diffindex := 0
+	for ; diffindex < len(st.key) && st.key[diffindex] == key[diffindex]; diffindex++ {
+	}
+	return diffindex
+}
+
+// insert inserts a (key, value) pair into the stack trie. The helper is
+// called recursively.
+func (st *StackTrie) insert(key, value []byte) error {
+	switch st.nodeType {
+	case branchNode: /* Branch */
+		idx := int(key[0])
+		// Unresolve elder siblings
+		for i := idx - 1; i >= 0; i-- {
+			if st.children[i] != nil {
+				if st.children[i].nodeType != hashedNode {
+					if err := st.children[i].hash(); err != nil {
+						return err
+					}
+				}
+				break
+			}
+		}
+		// Add new child
+		if st.children[idx] == nil {
+			st.children[idx] = newLeaf(key[1:], value, st.db)
+			return nil
+		}
+		return st.children[idx].insert(key[1:], value)
+
+	case extNode: /* Ext */
+		// Compare both key chunks and see where they differ
+		diffidx := st.getDiffIndex(key)
+
+		// Check if chunks are identical. If so, recurse into the child
+		// node. Otherwise, the key has to be split into 1) an optional
+		// common prefix, 2) the fullnode representing the two differing
+		// path, and 3) a leaf for each of the differentiated subtrees.
+		if diffidx == len(st.key) {
+			// Ext key and key segment are identical, recurse into the child node.
+			return st.children[0].insert(key[diffidx:], value)
+		}
+		// Save the original part. Depending if the break is at the extension's
+		// last byte or not, create an intermediate extension or use the extension's
+		// child node directly.
+		var n *StackTrie
+		if diffidx < len(st.key)-1 {
+			n = newExt(st.key[diffidx+1:], st.children[0], st.db)
+		} else {
+			// Break on the last byte, no need to insert an extension node:
+			// reuse the current node
+			n = st.children[0]
+		}
+		// Convert to hash
+		if err := n.hash(); err != nil {
+			return err
+		}
+		var p *StackTrie
+		if diffidx == 0 {
+			// the break is on the first byte, so the current node is converted
+			// into a branch node.
+			st.children[0] = nil
+			p = st
+			st.nodeType = branchNode
+		} else {
+			// the common prefix is at least one byte long, insert a new
+			// intermediate branch node.
+			st.children[0] = &StackTrie{nodeType: branchNode, db: st.db}
+			p = st.children[0]
+		}
+		// Create a leaf for the inserted part
+		o := newLeaf(key[diffidx+1:], value, st.db)
+
+		// Insert both child leaves where they belong:
+		origIdx := st.key[diffidx]
+		newIdx := key[diffidx]
+		p.children[origIdx] = n
+		p.children[newIdx] = o
+		st.key = st.key[:diffidx]
+
+	case leafNode: /* Leaf */
+		// Compare both key chunks and see where they differ
+		diffidx := st.getDiffIndex(key)
+
+		// Overwriting a key isn't supported, which means that the current leaf
+		// is expected to be split into 1) an optional extension for the common
+		// prefix of these 2 keys, 2) a fullnode selecting the path on which the
+		// keys differ, and 3) one leaf for the differentiated component of each key.
+		if diffidx >= len(st.key) {
+			return errors.New("trying to insert into existing key of stack trie")
+		}
+		// Check if the split occurs at the first nibble of the chunk. In that
+		// case, no prefix extnode is necessary. Otherwise, create that
+		var p *StackTrie
+		if diffidx == 0 {
+			// Convert current leaf into a branch
+			st.nodeType = branchNode
+			p = st
+			st.children[0] = nil
+		} else {
+			// Convert current node into an ext, and insert a child branch node.
+			st.nodeType = extNode
+			st.children[0] = &StackTrie{nodeType: branchNode, db: st.db}
+			p = st.children[0]
+		}
+		// Create the two child leaves: one containing the original value and
+		// one containing the new value. The child leaf is hashed directly in
+		// order to free up some memory.
+		origIdx := st.key[diffidx]
+		p.children[origIdx] = newLeaf(st.key[diffidx+1:], st.val, st.db)
+		if err := p.children[origIdx].hash(); err != nil {
+			return err
+		}
+		newIdx := key[diffidx]
+		p.children[newIdx] = newLeaf(key[diffidx+1:], value, st.db)
+
+		// Finally, cut off the key part that has been passed over to the children.
+		st.key = st.key[:diffidx]
+		st.val = nil
+
+	case emptyNode: /* Empty */
+		st.nodeType = leafNode
+		st.key = append(st.key[:0], key...)
+		st.val = value
+
+	case hashedNode:
+		return errors.New("trying to insert into a hashed node of stack trie")
+
+	default:
+		return fmt.Errorf("invalid stack trie node type %d", st.nodeType)
+	}
+	return nil
+}
+
+// childRef returns the reference of the hashed child used in the encoding of
+// the parent: the encoding itself if it is shorter than 32 bytes, otherwise the hash.
+func childRef(child *StackTrie) interface{} {
+	if len(child.val) < 32 {
+		return rlp.RawValue(child.val)
+	}
+	return child.val
+}
+
+// hash converts st into a 'hashedNode', if possible. Possible outcomes:
+//
+// 1. The rlp-encoded value was >= 32 bytes:
+//   - Then the 32-byte `hash` will be accessible in `st.val`.
+//   - And the 'st.type' will be 'hashedNode'
+//
+// 2. The rlp-encoded value was < 32 bytes
+//   - Then the <32 byte rlp-encoded value will be accessible in 'st.val'.
+//   - And the 'st.type' will be 'hashedNode' AGAIN
+//
+// This method will also:
+// set 'st.type' to hashedNode
+// clear 'st.key'
+func (st *StackTrie) hash() error {
+	var n interface{}
+
+	switch st.nodeType {
+	case hashedNode:
+		return nil
+
+	case branchNode:
+		var nodes [17]interface{}
+		for i, child := range st.children {
+			if child == nil {
+				nodes[i] = []byte{}
+				continue
+			}
+			if err := child.hash(); err != nil {
+				return err
+			}
+			nodes[i] = childRef(child)
+			st.children[i] = nil // Release child back to GC
+		}
+		nodes[16] = []byte{}
+		n = nodes[:]
+
+	case extNode:
+		if err := st.children[0].hash(); err != nil {
+			return err
+		}
+		n = []interface{}{hexToCompact(st.key), childRef(st.children[0])}
+		st.children[0] = nil // Release child back to GC
+
+	case leafNode:
+		n = []interface{}{hexToCompact(append(append([]byte{}, st.key...), 16)), st.val}
+
+	case emptyNode:
+		st.val = emptyRoot.Bytes()
+		st.key = st.key[:0]
+		st.nodeType = hashedNode
+		return nil
+
+	default:
+		return fmt.Errorf("invalid stack trie node type %d", st.nodeType)
+	}
+
+	enc, err := rlp.EncodeToBytes(n)
+	if err != nil {
+		return err
+	}
+	st.key = st.key[:0]
+	st.nodeType = hashedNode
+	if len(enc) < 32 {
+		st.val = enc
+		return nil
+	}
+	// Write the hash to the 'val'. We allocate a new val here to not mutate
+	// input values
+	st.val = crypto.Keccak256(enc)
+	if st.db != nil {
+		return st.db.Put(st.val, enc)
+	}
+	return nil
+}
+
+// Hash returns the hash of the current node.
+func (st *StackTrie) Hash() (common.Hash, error) {
+	if err := st.hash(); err != nil {
+		return common.Hash{}, err
+	}
+	if len(st.val) == 32 {
+		return common.BytesToHash(st.val), nil
+	}
+	// If the node's RLP isn't 32 bytes long, the node will not
+	// be hashed, and instead contain the  rlp-encoding of the
+	// node. For the top level node, we need to force the hashing.
+	return crypto.Keccak256Hash(st.val), nil
+}
+
+// Commit will firstly hash the entire trie if it's still not hashed
+// and then commit all nodes to the associated database. Actually most
+// of the trie nodes MAY have been committed already. The main purpose
+// here is to commit the root node.
+//
+// The associated database is expected, otherwise the whole commit
+// functionality should be disabled.
+func (st *StackTrie) Commit() (common.Hash, error) {
+	if st.db == nil {
+		return common.Hash{}, ErrCommitDisabled
+	}
+	if err := st.hash(); err != nil {
+		return common.Hash{}, err
+	}
+	if len(st.val) == 32 {
+		return common.BytesToHash(st.val), nil
+	}
+	// If the node's RLP isn't 32 bytes long, the node will not
+	// be hashed (and committed), and instead contain the  rlp-encoding of the
+	// node. For the top level node, we need to force the hashing+commit.
+	h := crypto.Keccak256Hash(st.val)
+	return h, st.db.Put(h.Bytes(), st.val)
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackTrie_Empty(t *testing.T) {
+	st := NewStackTrie(nil)
+	root, err := st.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, emptyRoot, root)
+}
+
+// TestStackTrie_Compare checks that the stack trie produces the same root and
+// the same nodes as the trie for various sets of keys and values.
+func TestStackTrie_Compare(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 16, 100, 1000} {
+		for _, valueSize := range []int{1, 8, 32, 100} {
+			keys := make([][]byte, 0, n)
+			seen := make(map[string]bool)
+			for len(keys) < n {
+				key := make([]byte, 32)
+				rand.Read(key)
+				if n < 16 {
+					// Make short keys to produce embedded nodes. The stack trie
+					// requires the keys of the same length like the secure trie.
+					key = key[:2]
+				}
+				if seen[string(key)] {
+					continue
+				}
+				seen[string(key)] = true
+				keys = append(keys, key)
+			}
+			sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+
+			memDBM := database.NewMemoryDBManager()
+			triedb := NewDatabase(memDBM)
+			trie, _ := NewTrie(common.Hash{}, triedb)
+
+			stackDB := database.NewMemDB()
+			st := NewStackTrie(stackDB)
+			for _, key := range keys {
+				value := make([]byte, valueSize)
+				rand.Read(value)
+				trie.Update(key, value)
+				require.NoError(t, st.TryUpdate(key, value))
+			}
+			want, err := trie.Commit(nil)
+			require.NoError(t, err)
+			require.NoError(t, triedb.Commit(want, false, 0))
+
+			have, err := st.Commit()
+			require.NoError(t, err)
+			assert.Equal(t, want, have, "keys: %d, value size: %d", n, valueSize)
+
+			// Every committed node of the stack trie must be a trie node.
+			it := stackDB.NewIterator(nil, nil)
+			for it.Next() {
+				assert.Equal(t, it.Key(), crypto.Keccak256(it.Value()))
+				blob, err := memDBM.ReadStateTrieNode(it.Key())
+				require.NoError(t, err)
+				assert.Equal(t, blob, it.Value())
+			}
+			it.Release()
+		}
+	}
+}
+
+func TestStackTrie_InvalidInsertion(t *testing.T) {
+	st := NewStackTrie(nil)
+	require.NoError(t, st.TryUpdate([]byte{0x01, 0x02}, []byte{0x01}))
+	assert.Error(t, st.TryUpdate([]byte{0x01, 0x02}, []byte{0x02}))
+	assert.Error(t, st.TryUpdate([]byte{0x01, 0x03}, nil))
+}
@@ -124,15 +124,29 @@ func (mr *MockTxPoolMockRecorder) GetPendingNonce(arg0 interface{}) *gomock.Call
 }
 
 // HandleTxMsg mocks base method
-func (m *MockTxPool) HandleTxMsg(arg0 types.Transactions) {
+func (m *MockTxPool) HandleTxMsg(arg0 string, arg1 types.Transactions) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "HandleTxMsg", arg0)
+	m.ctrl.Call(m, "HandleTxMsg", arg0, arg1)
 }
 
 // HandleTxMsg indicates an expected call of HandleTxMsg
-func (mr *MockTxPoolMockRecorder) HandleTxMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockTxPoolMockRecorder) HandleTxMsg(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTxMsg", reflect.TypeOf((*MockTxPool)(nil).HandleTxMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTxMsg", reflect.TypeOf((*MockTxPool)(nil).HandleTxMsg), arg0, arg1)
+}
+
+// Parked mocks base method
+func (m *MockTxPool) Parked() map[common.Address]types.Transactions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parked")
+	ret0, _ := ret[0].(map[common.Address]types.Transactions)
+	return ret0
+}
+
+// Parked indicates an expected call of Parked
+func (mr *MockTxPoolMockRecorder) Parked() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parked", reflect.TypeOf((*MockTxPool)(nil).Parked))
 }
 
 // Pending mocks base method
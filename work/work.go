@@ -47,8 +47,8 @@ var logger = log.NewModuleLogger(log.Work)
 //go:generate mockgen -destination=work/mocks/txpool_mock.go -package=mocks github.com/klaytn/klaytn/work TxPool
 // TxPool is an interface of blockchain.TxPool used by ProtocolManager and Backend.
 type TxPool interface {
-	// HandleTxMsg should add the given transactions to the pool.
-	HandleTxMsg(types.Transactions)
+	// HandleTxMsg should add the given transactions received from the peer to the pool.
+	HandleTxMsg(string, types.Transactions)
 
 	// Pending should return pending transactions.
 	// The slice should be modifiable by the caller.
@@ -68,6 +68,7 @@ type TxPool interface {
 	Get(hash common.Hash) *types.Transaction
 	Stats() (int, int)
 	Content() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	Parked() map[common.Address]types.Transactions
 	StartSpamThrottler(conf *blockchain.ThrottlerConfig) error
 	StopSpamThrottler()
 }